@@ -1,6 +1,9 @@
 package blockchain
 
 import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 )
 
@@ -11,6 +14,17 @@ func (s *Service) saveInitSyncBlock(r [32]byte, b *ethpb.SignedBeaconBlock) {
 	s.initSyncBlocks[r] = b
 }
 
+// This saves the attester indices of a verified beacon block of the initial sync blocks cache,
+// to save them with the block.
+func (s *Service) saveInitSyncBlockAttesters(r [32]byte, attesters []types.ValidatorIndex) {
+	if attesters == nil {
+		return
+	}
+	s.initSyncBlocksLock.Lock()
+	defer s.initSyncBlocksLock.Unlock()
+	s.initSyncAttesters[r] = attesters
+}
+
 // This checks if a beacon block exists in the initial sync blocks cache using the root
 // of the block.
 func (s *Service) hasInitSyncBlock(r [32]byte) bool {
@@ -42,9 +56,24 @@ func (s *Service) getInitSyncBlocks() []*ethpb.SignedBeaconBlock {
 	return blks
 }
 
+// This saves all the beacon blocks from the initial sync blocks cache to the DB, along with
+// their attester indices.
+func (s *Service) saveInitSyncBlocksToDB(ctx context.Context) error {
+	s.initSyncBlocksLock.RLock()
+	blks := make([]*ethpb.SignedBeaconBlock, 0, len(s.initSyncBlocks))
+	attesters := make([][]types.ValidatorIndex, 0, len(s.initSyncBlocks))
+	for r, b := range s.initSyncBlocks {
+		blks = append(blks, b)
+		attesters = append(attesters, s.initSyncAttesters[r])
+	}
+	s.initSyncBlocksLock.RUnlock()
+	return s.beaconDB.SaveBlocksWithAttesterIndices(ctx, blks, attesters)
+}
+
 // This clears out the initial sync blocks cache.
 func (s *Service) clearInitSyncBlocks() {
 	s.initSyncBlocksLock.Lock()
 	defer s.initSyncBlocksLock.Unlock()
 	s.initSyncBlocks = make(map[[32]byte]*ethpb.SignedBeaconBlock)
+	s.initSyncAttesters = make(map[[32]byte][]types.ValidatorIndex)
 }
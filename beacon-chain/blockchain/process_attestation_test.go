@@ -260,7 +260,7 @@ func TestStore_UpdateCheckpointState(t *testing.T) {
 
 	cached, err = service.checkpointStateCache.StateByCheckpoint(newCheckpoint)
 	require.NoError(t, err)
-	if !proto.Equal(returned.InnerStateUnsafe(), cached.InnerStateUnsafe()) {
+	if !proto.Equal(returned.ToProtoUnsafe(), cached.ToProtoUnsafe()) {
 		t.Error("Incorrectly cached base state")
 	}
 }
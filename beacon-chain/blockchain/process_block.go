@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
@@ -222,6 +223,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	}
 	var set *bls.SignatureSet
 	boundaries := make(map[[32]byte]*stateTrie.BeaconState)
+	attesters := make([][]types.ValidatorIndex, len(blks))
 	for i, b := range blks {
 		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
@@ -234,6 +236,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 				return nil, nil, errors.Wrap(err, "could not handle epoch boundary state")
 			}
 		}
+		attesters[i], err = blockAttesterIndices(b.Block, preState)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get attesters of block %d", b.Block.Slot)
+		}
 		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
 		fCheckpoints[i] = preState.FinalizedCheckpoint()
 		sigSet.Join(set)
@@ -245,6 +251,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	if !verify {
 		return nil, nil, errors.New("batch block signature verification failed")
 	}
+	for i, r := range blockRoots {
+		s.saveInitSyncBlockAttesters(r, attesters[i])
+	}
 	for r, st := range boundaries {
 		if err := s.stateGen.SaveState(ctx, r, st); err != nil {
 			return nil, nil, err
@@ -281,7 +290,7 @@ func (s *Service) handleBlockAfterBatchVerify(ctx context.Context, signed *ethpb
 
 	// Rate limit how many blocks (2 epochs worth of blocks) a node keeps in the memory.
 	if uint64(len(s.getInitSyncBlocks())) > initialSyncBlockCacheSize {
-		if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+		if err := s.saveInitSyncBlocksToDB(ctx); err != nil {
 			return err
 		}
 		s.clearInitSyncBlocks()
@@ -384,9 +393,14 @@ func (s *Service) insertBlockToForkChoiceStore(ctx context.Context, blk *ethpb.B
 func (s *Service) savePostStateInfo(ctx context.Context, r [32]byte, b *ethpb.SignedBeaconBlock, st *stateTrie.BeaconState, initSync bool) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.savePostStateInfo")
 	defer span.End()
+	attesters, err := blockAttesterIndices(b.Block, st)
+	if err != nil {
+		return errors.Wrapf(err, "could not get attesters of block %d", b.Block.Slot)
+	}
 	if initSync {
 		s.saveInitSyncBlock(r, b)
-	} else if err := s.beaconDB.SaveBlock(ctx, b); err != nil {
+		s.saveInitSyncBlockAttesters(r, attesters)
+	} else if err := s.beaconDB.SaveBlocksWithAttesterIndices(ctx, []*ethpb.SignedBeaconBlock{b}, [][]types.ValidatorIndex{attesters}); err != nil {
 		return errors.Wrapf(err, "could not save block from slot %d", b.Block.Slot)
 	}
 	if err := s.stateGen.SaveState(ctx, r, st); err != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -14,13 +15,22 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
 
+// now returns the current time of the service's clock.
+func (s *Service) now() time.Time {
+	if s.clock == nil {
+		return timeutils.Now()
+	}
+	return s.clock()
+}
+
 // CurrentSlot returns the current slot based on time.
 func (s *Service) CurrentSlot() types.Slot {
-	return helpers.CurrentSlot(uint64(s.genesisTime.Unix()))
+	return helpers.CurrentSlotAt(s.now(), uint64(s.genesisTime.Unix()))
 }
 
 // getBlockPreState returns the pre state of an incoming block. It uses the parent root of the block
@@ -44,7 +54,7 @@ func (s *Service) getBlockPreState(ctx context.Context, b *ethpb.BeaconBlock) (*
 	}
 
 	// Verify block slot time is not from the future.
-	if err := helpers.VerifySlotTime(preState.GenesisTime(), b.Slot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+	if err := helpers.VerifySlotTimeAt(s.now(), preState.GenesisTime(), b.Slot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
 		return nil, err
 	}
 
@@ -78,7 +88,7 @@ func (s *Service) verifyBlkPreState(ctx context.Context, b *ethpb.BeaconBlock) e
 		return err
 	}
 	if !has {
-		if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+		if err := s.saveInitSyncBlocksToDB(ctx); err != nil {
 			return errors.Wrap(err, "could not save initial sync blocks")
 		}
 		s.clearInitSyncBlocks()
@@ -224,7 +234,7 @@ func (s *Service) updateJustifiedInitSync(ctx context.Context, cp *ethpb.Checkpo
 func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) error {
 	// Blocks need to be saved so that we can retrieve finalized block from
 	// DB when migrating states.
-	if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+	if err := s.saveInitSyncBlocksToDB(ctx); err != nil {
 		return err
 	}
 	s.clearInitSyncBlocks()
@@ -446,6 +456,34 @@ func (s *Service) deletePoolAtts(atts []*ethpb.Attestation) error {
 	return nil
 }
 
+// This returns the validators attesting in the attestations of a block, to index the block by attester
+// when block indices are enabled. The post state of the block holds the committees of the attestations,
+// which are at most an epoch old.
+func blockAttesterIndices(blk *ethpb.BeaconBlock, st *stateTrie.BeaconState) ([]types.ValidatorIndex, error) {
+	if !featureconfig.Get().EnableBlockIndices {
+		return nil, nil
+	}
+	seen := make(map[uint64]bool)
+	var attesters []types.ValidatorIndex
+	for _, a := range blk.Body.Attestations {
+		committee, err := helpers.BeaconCommitteeFromState(st, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := attestationutil.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indices {
+			if !seen[idx] {
+				seen[idx] = true
+				attesters = append(attesters, types.ValidatorIndex(idx))
+			}
+		}
+	}
+	return attesters, nil
+}
+
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
@@ -13,8 +13,10 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -22,6 +24,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -176,6 +179,129 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSavePostStateInfo_IndexesAttesters(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	b, err := testutil.GenerateFullBlock(st.Copy(), keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(b.Block.Body.Attestations))
+	postState, err := state.ExecuteStateTransition(ctx, st, b)
+	require.NoError(t, err)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: b.Block.ParentRoot}
+	require.NoError(t, service.savePostStateInfo(ctx, root, b, postState, false))
+
+	att := b.Block.Body.Attestations[0]
+	committee, err := helpers.BeaconCommitteeFromState(postState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	for _, idx := range committee {
+		roots, err := beaconDB.BlockRoots(ctx, filters.NewFilter().SetAttesterIndex(idx))
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][32]byte{root}, roots)
+	}
+}
+
+func TestStore_OnBlockBatch_IndexesAttestersOfVerifiedBlocks(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	ctx := context.Background()
+	service, blks, blkRoots, attesters := setupBatchWithAttesters(t)
+
+	_, _, err := service.onBlockBatch(ctx, blks[1:], blkRoots[1:])
+	require.NoError(t, err)
+	require.NoError(t, service.saveInitSyncBlocksToDB(ctx))
+	for i := 1; i < len(blks); i++ {
+		roots, err := service.beaconDB.BlockRoots(ctx, filters.NewFilter().SetAttesterIndex(attesters[i][0]))
+		require.NoError(t, err)
+		assert.Equal(t, true, containsRoot(roots, blkRoots[i]), "Block %d not indexed by attester", i)
+	}
+}
+
+func TestStore_OnBlockBatch_DoesNotIndexAttestersOfInvalidBatch(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	ctx := context.Background()
+	service, blks, blkRoots, attesters := setupBatchWithAttesters(t)
+
+	blks[2].Signature = blks[3].Signature
+	_, _, err := service.onBlockBatch(ctx, blks[1:], blkRoots[1:])
+	require.ErrorContains(t, "batch block signature verification failed", err)
+	require.NoError(t, service.saveInitSyncBlocksToDB(ctx))
+	for i := 1; i < len(blks); i++ {
+		roots, err := service.beaconDB.BlockRoots(ctx, filters.NewFilter().SetAttesterIndex(attesters[i][0]))
+		require.NoError(t, err)
+		assert.Equal(t, false, containsRoot(roots, blkRoots[i]), "Block %d indexed by attester", i)
+	}
+}
+
+// setupBatchWithAttesters returns a service with a chain of blocks in its initial sync blocks
+// cache, the first of which has its state saved, and the attesters of the blocks.
+func setupBatchWithAttesters(t *testing.T) (*Service, []*ethpb.SignedBeaconBlock, [][32]byte, [][]types.ValidatorIndex) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	})
+	require.NoError(t, err)
+
+	genesis := blocks.NewGenesisBlock(make([]byte, 32))
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: gRoot[:]}
+	service.saveInitSyncBlock(gRoot, genesis)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	var blks []*ethpb.SignedBeaconBlock
+	var blkRoots [][32]byte
+	var attesters [][]types.ValidatorIndex
+	var firstState *stateTrie.BeaconState
+	for i := 1; i < 5; i++ {
+		b, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), types.Slot(i))
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		if i == 1 {
+			firstState = st.Copy()
+		}
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		indices, err := blockAttesterIndices(b.Block, st)
+		require.NoError(t, err)
+		require.NotEqual(t, 0, len(indices))
+		service.saveInitSyncBlock(root, b)
+		blks = append(blks, b)
+		blkRoots = append(blkRoots, root)
+		attesters = append(attesters, indices)
+	}
+	blks[0].Block.ParentRoot = gRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, blks[0]))
+	require.NoError(t, service.stateGen.SaveState(ctx, blkRoots[0], firstState))
+	return service, blks, blkRoots, attesters
+}
+
+func containsRoot(roots [][32]byte, root [32]byte) bool {
+	for _, r := range roots {
+		if r == root {
+			return true
+		}
+	}
+	return false
+}
+
 func TestRemoveStateSinceLastFinalized_EmptyStartSlot(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -160,6 +160,7 @@ func (s *Service) handlePostBlockOperations(b *ethpb.BeaconBlock) error {
 
 // This checks whether it's time to start saving hot state to DB.
 // It's time when there's `epochsSinceFinalitySaveHotStateDB` epochs of non-finality.
+// While saving, the interval between the saved hot states is adjusted to the epochs since finality.
 func (s *Service) checkSaveHotStateDB(ctx context.Context) error {
 	currentEpoch := helpers.SlotToEpoch(s.CurrentSlot())
 	// Prevent `sinceFinality` going underflow.
@@ -170,7 +171,7 @@ func (s *Service) checkSaveHotStateDB(ctx context.Context) error {
 
 	if sinceFinality >= epochsSinceFinalitySaveHotStateDB {
 		s.stateGen.EnableSaveHotStateToDB(ctx)
-		return nil
+		return s.stateGen.AdjustSaveHotStateToDB(ctx, sinceFinality)
 	}
 
 	return s.stateGen.DisableSaveHotStateToDB(ctx)
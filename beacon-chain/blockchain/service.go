@@ -55,6 +55,7 @@ type Service struct {
 	slashingPool          slashings.PoolManager
 	exitPool              voluntaryexits.PoolManager
 	genesisTime           time.Time
+	clock                 func() time.Time
 	p2p                   p2p.Broadcaster
 	maxRoutines           int
 	head                  *head
@@ -73,6 +74,7 @@ type Service struct {
 	stateGen              *stategen.State
 	opsService            *attestations.Service
 	initSyncBlocks        map[[32]byte]*ethpb.SignedBeaconBlock
+	initSyncAttesters     map[[32]byte][]types.ValidatorIndex
 	initSyncBlocksLock    sync.RWMutex
 	justifiedBalances     []uint64
 	justifiedBalancesLock sync.RWMutex
@@ -98,6 +100,8 @@ type Config struct {
 	StateGen          *stategen.State
 	WspBlockRoot      []byte
 	WspEpoch          types.Epoch
+	// Clock returns the current time of the service, the local clock if not set.
+	Clock func() time.Time
 }
 
 // NewService instantiates a new block service instance that will
@@ -108,6 +112,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		ctx:                  ctx,
 		cancel:               cancel,
 		beaconDB:             cfg.BeaconDB,
+		clock:                cfg.Clock,
 		depositCache:         cfg.DepositCache,
 		chainStartFetcher:    cfg.ChainStartFetcher,
 		attPool:              cfg.AttPool,
@@ -122,6 +127,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		opsService:           cfg.OpsService,
 		stateGen:             cfg.StateGen,
 		initSyncBlocks:       make(map[[32]byte]*ethpb.SignedBeaconBlock),
+		initSyncAttesters:    make(map[[32]byte][]types.ValidatorIndex),
 		justifiedBalances:    make([]uint64, 0),
 		wsEpoch:              cfg.WspEpoch,
 		wsRoot:               cfg.WspBlockRoot,
@@ -344,7 +350,7 @@ func (s *Service) Stop() error {
 	}
 
 	// Save initial sync cached blocks to the DB before stop.
-	return s.beaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	return s.saveInitSyncBlocksToDB(s.ctx)
 }
 
 // Status always returns nil unless there is an error condition that causes
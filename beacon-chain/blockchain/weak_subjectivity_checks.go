@@ -30,7 +30,7 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 	r := bytesutil.ToBytes32(s.wsRoot)
 	log.Infof("Performing weak subjectivity check for root %#x in epoch %d", r, s.wsEpoch)
 	// Save initial sync cached blocks to DB.
-	if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+	if err := s.saveInitSyncBlocksToDB(ctx); err != nil {
 		return err
 	}
 	// A node should have the weak subjectivity block in the DB.
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"sync"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// maxAttestationPackingReports is the number of block proposal reports kept in memory.
+// One epoch worth of reports is enough to inspect the most recent proposals of a node.
+const maxAttestationPackingReports = 32
+
+// AttestationPackingCache keeps the attestation packing quality reports of the most
+// recent block proposals produced by this node.
+type AttestationPackingCache struct {
+	reports []*pbrpc.AttestationPackingReport
+	lock    sync.RWMutex
+}
+
+// NewAttestationPackingCache creates a new attestation packing report cache.
+func NewAttestationPackingCache() *AttestationPackingCache {
+	return &AttestationPackingCache{
+		reports: make([]*pbrpc.AttestationPackingReport, 0, maxAttestationPackingReports),
+	}
+}
+
+// Add inserts a packing report into the cache, evicting the oldest report if
+// the cache is full.
+func (c *AttestationPackingCache) Add(report *pbrpc.AttestationPackingReport) {
+	if report == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.reports) == maxAttestationPackingReports {
+		c.reports = append(c.reports[:0], c.reports[1:]...)
+	}
+	c.reports = append(c.reports, report)
+}
+
+// Reports returns a copy of the cached packing reports, ordered from the most
+// recent to the oldest proposal.
+func (c *AttestationPackingCache) Reports() []*pbrpc.AttestationPackingReport {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	reports := make([]*pbrpc.AttestationPackingReport, len(c.reports))
+	for i, r := range c.reports {
+		reports[len(c.reports)-1-i] = r
+	}
+	return reports
+}
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttestationPackingCache_AddAndReports(t *testing.T) {
+	c := NewAttestationPackingCache()
+	assert.Equal(t, 0, len(c.Reports()))
+
+	c.Add(nil)
+	assert.Equal(t, 0, len(c.Reports()))
+
+	for i := 0; i < maxAttestationPackingReports+5; i++ {
+		c.Add(&pbrpc.AttestationPackingReport{Slot: types.Slot(i)})
+	}
+	reports := c.Reports()
+	require.Equal(t, maxAttestationPackingReports, len(reports))
+	// Most recent report comes first and the oldest ones were evicted.
+	assert.Equal(t, types.Slot(maxAttestationPackingReports+4), reports[0].Slot)
+	assert.Equal(t, types.Slot(5), reports[len(reports)-1].Slot)
+}
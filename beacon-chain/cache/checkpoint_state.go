@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -30,19 +31,29 @@ var (
 
 // CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint.
 type CheckpointStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache  *lru.Cache
+	lock   sync.RWMutex
+	budget *BudgetTracker
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
+// Its states are tracked in the state memory budget.
 func NewCheckpointStateCache() *CheckpointStateCache {
-	cache, err := lru.New(maxCheckpointStateSize)
+	c := &CheckpointStateCache{}
+	c.budget = StateMemoryBudget.Track("checkpoint_state", CheckpointStateCacheWeight, func(key interface{}) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.cache.Remove(key)
+	})
+	size := ConfiguredSize(flags.Get().CheckpointStateCacheSize, maxCheckpointStateSize)
+	cache, err := lru.NewWithEvict(size, func(key interface{}, _ interface{}) {
+		c.budget.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &CheckpointStateCache{
-		cache: cache,
-	}
+	c.cache = cache
+	return c
 }
 
 // StateByCheckpoint fetches state by checkpoint. Returns true with a
@@ -59,23 +70,27 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (*stateTr
 
 	if exists && item != nil {
 		checkpointStateHit.Inc()
+		c.budget.Hit(h)
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
 		return item.(*stateTrie.BeaconState), nil
 	}
 
 	checkpointStateMiss.Inc()
+	c.budget.Miss()
 	return nil, nil
 }
 
 // AddCheckpointState adds CheckpointState object to the cache. This method also trims the least
 // recently added CheckpointState object if the cache size has ready the max cache size limit.
 func (c *CheckpointStateCache) AddCheckpointState(cp *ethpb.Checkpoint, s *stateTrie.BeaconState) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
 	h, err := hashutil.HashProto(cp)
 	if err != nil {
 		return err
 	}
+	c.lock.Lock()
 	c.cache.Add(h, s)
+	evicted := c.budget.Add(h, s.SizeEstimate())
+	c.lock.Unlock()
+	evicted.Evict()
 	return nil
 }
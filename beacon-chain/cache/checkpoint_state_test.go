@@ -33,7 +33,7 @@ func TestCheckpointStateCache_StateByCheckpoint(t *testing.T) {
 	state, err = cache.StateByCheckpoint(cp1)
 	require.NoError(t, err)
 
-	if !proto.Equal(state.InnerStateUnsafe(), st.InnerStateUnsafe()) {
+	if !proto.Equal(state.ToProtoUnsafe(), st.ToProtoUnsafe()) {
 		t.Error("incorrectly cached state")
 	}
 
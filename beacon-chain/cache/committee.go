@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"k8s.io/client-go/tools/cache"
@@ -35,6 +36,8 @@ var (
 type CommitteeCache struct {
 	CommitteeCache *cache.FIFO
 	lock           sync.RWMutex
+	size           uint64
+	budget         *BudgetTracker
 }
 
 // committeeKeyFn takes the seed as the key to retrieve shuffled indices of a committee in a given epoch.
@@ -48,10 +51,25 @@ func committeeKeyFn(obj interface{}) (string, error) {
 }
 
 // NewCommitteesCache creates a new committee cache for storing/accessing shuffled indices of a committee.
+// Its committees are tracked in the state memory budget.
 func NewCommitteesCache() *CommitteeCache {
-	return &CommitteeCache{
+	c := &CommitteeCache{
 		CommitteeCache: cache.NewFIFO(committeeKeyFn),
+		size:           uint64(ConfiguredSize(flags.Get().CommitteeCacheSize, int(maxCommitteesCacheSize))),
 	}
+	c.budget = StateMemoryBudget.Track("committee", CommitteeCacheWeight, func(k interface{}) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		obj, exists, err := c.CommitteeCache.GetByKey(k.(string))
+		if err != nil || !exists {
+			return
+		}
+		// Deleting only fails if the key of the object can't be computed, which was just done.
+		if err := c.CommitteeCache.Delete(obj); err != nil {
+			return
+		}
+	})
+	return c
 }
 
 // Committee fetches the shuffled indices by slot and committee index. Every list of indices
@@ -67,8 +85,10 @@ func (c *CommitteeCache) Committee(slot types.Slot, seed [32]byte, index types.C
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.budget.Hit(key(seed))
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.budget.Miss()
 		return nil, nil
 	}
 
@@ -96,12 +116,19 @@ func (c *CommitteeCache) Committee(slot types.Slot, seed [32]byte, index types.C
 // his method also trims the least recently list if the cache size has ready the max cache size limit.
 func (c *CommitteeCache) AddCommitteeShuffledList(committees *Committees) error {
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	if err := c.CommitteeCache.AddIfNotPresent(committees); err != nil {
+		c.lock.Unlock()
 		return err
 	}
-	trim(c.CommitteeCache, maxCommitteesCacheSize)
+	trimWithFunc(c.CommitteeCache, c.size, func(obj interface{}) error {
+		if item, ok := obj.(*Committees); ok {
+			c.budget.Remove(key(item.Seed))
+		}
+		return nil
+	})
+	evicted := c.budget.Add(key(committees.Seed), committees.sizeEstimate())
+	c.lock.Unlock()
+	evicted.Evict()
 	return nil
 }
 
@@ -116,8 +143,10 @@ func (c *CommitteeCache) ActiveIndices(seed [32]byte) ([]types.ValidatorIndex, e
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.budget.Hit(key(seed))
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.budget.Miss()
 		return nil, nil
 	}
 
@@ -140,8 +169,10 @@ func (c *CommitteeCache) ActiveIndicesCount(seed [32]byte) (int, error) {
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.budget.Hit(key(seed))
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.budget.Miss()
 		return 0, nil
 	}
 
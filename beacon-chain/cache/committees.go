@@ -17,3 +17,8 @@ type Committees struct {
 	ShuffledIndices []types.ValidatorIndex
 	SortedIndices   []types.ValidatorIndex
 }
+
+// sizeEstimate returns an estimate of the number of bytes of memory held by the committees.
+func (c *Committees) sizeEstimate() uint64 {
+	return uint64(64 + 8*(len(c.ShuffledIndices)+len(c.SortedIndices)))
+}
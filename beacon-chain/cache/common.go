@@ -13,8 +13,14 @@ var (
 
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize uint64) {
+	trimWithFunc(queue, maxSize, popProcessNoopFunc)
+}
+
+// trimWithFunc trims the FIFO queue to the maxSize, processing the popped items with the input
+// function, which must never return an error.
+func trimWithFunc(queue *cache.FIFO, maxSize uint64, process cache.PopProcessFunc) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
-		_, err := queue.Pop(popProcessNoopFunc)
+		_, err := queue.Pop(process)
 		if err != nil {
 			// popProcessNoopFunc never returns an error, but we handle this anyway to make linter
 			// happy.
@@ -27,3 +33,12 @@ func trim(queue *cache.FIFO, maxSize uint64) {
 func popProcessNoopFunc(_ interface{}) error {
 	return nil
 }
+
+// ConfiguredSize returns the cache size configured with the beacon node flags, or the default
+// size if none is configured.
+func ConfiguredSize(configured, defaultSize int) int {
+	if configured > 0 {
+		return configured
+	}
+	return defaultSize
+}
@@ -0,0 +1,224 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reuse weights of the entries of the caches tracked by the state memory budget. An entry of a
+// cache with a higher weight is expected to be reused longer after its last access, so it is
+// evicted later than an entry of a cache with a lower weight accessed at the same time.
+const (
+	SkipSlotCacheWeight           = 1
+	HotStateCacheWeight           = 2
+	EpochBoundaryStateCacheWeight = 3
+	CheckpointStateCacheWeight    = 4
+	CommitteeCacheWeight          = 4
+)
+
+var (
+	// StateMemoryBudget is the memory budget shared by the caches of beacon states and committees.
+	// It is unlimited until a limit is set.
+	StateMemoryBudget = NewMemoryBudget(0)
+
+	// Metrics.
+	memoryBudgetLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "state_cache_memory_budget_bytes",
+		Help: "The memory budget of the state caches, in bytes. Unlimited if 0.",
+	})
+	memoryBudgetResidentBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "state_cache_resident_bytes",
+		Help: "The estimated memory held by the entries of a state cache, in bytes.",
+	}, []string{"cache"})
+	memoryBudgetEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "state_cache_entries",
+		Help: "The number of entries of a state cache.",
+	}, []string{"cache"})
+	memoryBudgetHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_hits_total",
+		Help: "The number of requests to a state cache which are present in the cache.",
+	}, []string{"cache"})
+	memoryBudgetMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_misses_total",
+		Help: "The number of requests to a state cache which aren't present in the cache.",
+	}, []string{"cache"})
+	memoryBudgetEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_budget_evictions_total",
+		Help: "The number of entries of a state cache evicted to stay within the memory budget.",
+	}, []string{"cache"})
+)
+
+// MemoryBudget bounds the estimated memory held by the entries of several caches. When the budget
+// is exceeded, entries are evicted across the caches in least recently used order, where the time
+// since the last access of an entry is divided by the reuse weight of its cache.
+type MemoryBudget struct {
+	limit   uint64
+	used    uint64
+	tick    uint64
+	entries map[budgetKey]*budgetEntry
+	lock    sync.Mutex
+}
+
+// BudgetTracker tracks the entries of a cache in a memory budget.
+type BudgetTracker struct {
+	budget *MemoryBudget
+	name   string
+	weight uint64
+	evict  func(key interface{})
+}
+
+type budgetKey struct {
+	tracker *BudgetTracker
+	key     interface{}
+}
+
+type budgetEntry struct {
+	size       uint64
+	lastAccess uint64
+}
+
+// Evictions are the entries no longer tracked by a memory budget, which are yet to be evicted from
+// their caches.
+type Evictions []budgetKey
+
+// NewMemoryBudget creates a memory budget of the input number of bytes, unlimited if 0.
+func NewMemoryBudget(limit uint64) *MemoryBudget {
+	return &MemoryBudget{
+		limit:   limit,
+		entries: make(map[budgetKey]*budgetEntry),
+	}
+}
+
+// SetLimit sets the number of bytes of the budget, unlimited if 0, and evicts entries as needed.
+func (b *MemoryBudget) SetLimit(limit uint64) {
+	b.lock.Lock()
+	b.limit = limit
+	memoryBudgetLimit.Set(float64(limit))
+	evicted := b.evictOverLimit()
+	b.lock.Unlock()
+	evicted.Evict()
+}
+
+// Limit returns the number of bytes of the budget, unlimited if 0.
+func (b *MemoryBudget) Limit() uint64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.limit
+}
+
+// Used returns the estimated number of bytes held by the tracked entries.
+func (b *MemoryBudget) Used() uint64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.used
+}
+
+// Track registers a cache in the budget. The input function is called to evict an entry from the
+// cache, it must not call back into the budget tracker while holding a lock needed to track an
+// entry.
+func (b *MemoryBudget) Track(name string, weight uint64, evict func(key interface{})) *BudgetTracker {
+	if weight == 0 {
+		weight = 1
+	}
+	return &BudgetTracker{
+		budget: b,
+		name:   name,
+		weight: weight,
+		evict:  evict,
+	}
+}
+
+// Add tracks a new or replaced cache entry of the input estimated size, and stops tracking entries
+// across the caches of the budget until it is no longer exceeded. It is called with the lock of the
+// cache held, so that the budget is updated along with the cache, and the returned entries must be
+// evicted from their caches once that lock is released.
+func (t *BudgetTracker) Add(key interface{}, size uint64) Evictions {
+	b := t.budget
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.tick++
+	k := budgetKey{tracker: t, key: key}
+	if e, ok := b.entries[k]; ok {
+		b.used -= e.size
+		t.updateMetrics(-float64(e.size), -1)
+	}
+	b.entries[k] = &budgetEntry{size: size, lastAccess: b.tick}
+	b.used += size
+	t.updateMetrics(float64(size), 1)
+	return b.evictOverLimit()
+}
+
+// Hit records a cache hit on the entry, marking it as recently used.
+func (t *BudgetTracker) Hit(key interface{}) {
+	memoryBudgetHits.WithLabelValues(t.name).Inc()
+	b := t.budget
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.tick++
+	if e, ok := b.entries[budgetKey{tracker: t, key: key}]; ok {
+		e.lastAccess = b.tick
+	}
+}
+
+// Miss records a cache miss.
+func (t *BudgetTracker) Miss() {
+	memoryBudgetMisses.WithLabelValues(t.name).Inc()
+}
+
+// Remove stops tracking an entry removed from the cache.
+func (t *BudgetTracker) Remove(key interface{}) {
+	b := t.budget
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	k := budgetKey{tracker: t, key: key}
+	e, ok := b.entries[k]
+	if !ok {
+		return
+	}
+	delete(b.entries, k)
+	b.used -= e.size
+	t.updateMetrics(-float64(e.size), -1)
+}
+
+func (t *BudgetTracker) updateMetrics(bytes, entries float64) {
+	memoryBudgetResidentBytes.WithLabelValues(t.name).Add(bytes)
+	memoryBudgetEntries.WithLabelValues(t.name).Add(entries)
+}
+
+// evictOverLimit stops tracking entries until the budget is no longer exceeded, and returns them to
+// be evicted from their caches once the budget lock is released. It is called with the lock held.
+func (b *MemoryBudget) evictOverLimit() Evictions {
+	if b.limit == 0 {
+		return nil
+	}
+	var evicted Evictions
+	for b.used > b.limit && len(b.entries) > 0 {
+		var victim budgetKey
+		var victimEntry *budgetEntry
+		var victimAge float64
+		for k, e := range b.entries {
+			age := float64(b.tick-e.lastAccess) / float64(k.tracker.weight)
+			if victimEntry == nil || age > victimAge || (age == victimAge && e.size > victimEntry.size) {
+				victim, victimEntry, victimAge = k, e, age
+			}
+		}
+		delete(b.entries, victim)
+		b.used -= victimEntry.size
+		victim.tracker.updateMetrics(-float64(victimEntry.size), -1)
+		memoryBudgetEvictions.WithLabelValues(victim.tracker.name).Inc()
+		evicted = append(evicted, victim)
+	}
+	return evicted
+}
+
+// Evict removes the entries from their caches. It must be called without holding the lock of any
+// tracked cache.
+func (e Evictions) Evict() {
+	for _, k := range e {
+		if k.tracker.evict != nil {
+			k.tracker.evict(k.key)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// evictions records the keys evicted from a cache by a memory budget.
+type evictions struct {
+	keys []interface{}
+	lock sync.Mutex
+}
+
+func (e *evictions) evict(key interface{}) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.keys = append(e.keys, key)
+}
+
+func TestMemoryBudget_Unlimited(t *testing.T) {
+	b := NewMemoryBudget(0)
+	evicted := &evictions{}
+	tracker := b.Track("test", 1, evicted.evict)
+	for i := 0; i < 100; i++ {
+		tracker.Add(i, 1<<20).Evict()
+	}
+	assert.Equal(t, uint64(100<<20), b.Used())
+	assert.Equal(t, 0, len(evicted.keys))
+
+	tracker.Add(0, 1).Evict()
+	assert.Equal(t, uint64(99<<20+1), b.Used(), "Replacing an entry did not update its size")
+	tracker.Remove(0)
+	tracker.Remove(0)
+	assert.Equal(t, uint64(99<<20), b.Used())
+}
+
+func TestMemoryBudget_EvictsLeastRecentlyUsed(t *testing.T) {
+	b := NewMemoryBudget(30)
+	evicted := &evictions{}
+	tracker := b.Track("test", 1, evicted.evict)
+	tracker.Add("a", 10).Evict()
+	tracker.Add("b", 10).Evict()
+	tracker.Add("c", 10).Evict()
+	tracker.Hit("a")
+	tracker.Add("d", 10).Evict()
+	assert.DeepEqual(t, []interface{}{"b"}, evicted.keys)
+	assert.Equal(t, uint64(30), b.Used())
+
+	// An entry larger than the budget evicts every other entry, then itself.
+	tracker.Add("e", 40).Evict()
+	assert.DeepEqual(t, []interface{}{"b", "c", "a", "d", "e"}, evicted.keys)
+	assert.Equal(t, uint64(0), b.Used())
+}
+
+func TestMemoryBudget_WeightsReuse(t *testing.T) {
+	b := NewMemoryBudget(40)
+	heavyEvicted, lightEvicted := &evictions{}, &evictions{}
+	heavy := b.Track("heavy", 4, heavyEvicted.evict)
+	light := b.Track("light", 1, lightEvicted.evict)
+
+	// The heavy entry is the least recently used, but expected to be reused later.
+	heavy.Add("h", 10).Evict()
+	light.Add("l1", 10).Evict()
+	light.Add("l2", 10).Evict()
+	light.Add("l3", 10).Evict()
+	light.Add("l4", 10).Evict()
+	assert.Equal(t, 0, len(heavyEvicted.keys))
+	assert.DeepEqual(t, []interface{}{"l1"}, lightEvicted.keys)
+
+	// It is evicted once it aged more than four times the light entries.
+	for i := 0; i < 20; i++ {
+		light.Hit("l2")
+		light.Hit("l3")
+		light.Hit("l4")
+	}
+	light.Add("l5", 10).Evict()
+	assert.DeepEqual(t, []interface{}{"h"}, heavyEvicted.keys)
+}
+
+func TestMemoryBudget_SetLimit(t *testing.T) {
+	b := NewMemoryBudget(0)
+	evicted := &evictions{}
+	tracker := b.Track("test", 1, evicted.evict)
+	for i := 0; i < 10; i++ {
+		tracker.Add(i, 10).Evict()
+	}
+	b.SetLimit(50)
+	assert.Equal(t, uint64(50), b.Limit())
+	assert.Equal(t, uint64(50), b.Used())
+	assert.DeepEqual(t, []interface{}{0, 1, 2, 3, 4}, evicted.keys)
+}
+
+func TestMemoryBudget_EvictsFromCaches(t *testing.T) {
+	prevBudget := StateMemoryBudget
+	StateMemoryBudget = NewMemoryBudget(0)
+	defer func() {
+		StateMemoryBudget = prevBudget
+	}()
+
+	c := NewCheckpointStateCache()
+	cps := make([]*ethpb.Checkpoint, 3)
+	var size uint64
+	for i := range cps {
+		cps[i] = &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{byte(i)}, 32)}
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 64, Balances: make([]uint64, 1000)})
+		require.NoError(t, err)
+		size = st.SizeEstimate()
+		require.NoError(t, c.AddCheckpointState(cps[i], st))
+	}
+	assert.Equal(t, 3*size, StateMemoryBudget.Used())
+
+	st, err := c.StateByCheckpoint(cps[0])
+	require.NoError(t, err)
+	require.NotNil(t, st)
+	StateMemoryBudget.SetLimit(2 * size)
+	st, err = c.StateByCheckpoint(cps[1])
+	require.NoError(t, err)
+	assert.Equal(t, (*stateTrie.BeaconState)(nil), st, "Expected the least recently used state to be evicted")
+	st, err = c.StateByCheckpoint(cps[0])
+	require.NoError(t, err)
+	require.NotNil(t, st)
+
+	// The entries evicted by the cache itself are no longer tracked.
+	c.cache.Purge()
+	assert.Equal(t, uint64(0), StateMemoryBudget.Used())
+}
+
+func TestCheckpointStateCache_ConfiguredSize(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{CheckpointStateCacheSize: 2})
+	defer flags.Init(resetFlags)
+
+	c := NewCheckpointStateCache()
+	for i := byte(0); i < 3; i++ {
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 64})
+		require.NoError(t, err)
+		require.NoError(t, c.AddCheckpointState(&ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{i}, 32)}, st))
+	}
+	assert.Equal(t, 2, c.cache.Len())
+}
@@ -9,11 +9,15 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"go.opencensus.io/trace"
 )
 
 var (
+	// maxSkipSlotCacheSize defines the max number of skip slot states the cache can contain.
+	maxSkipSlotCacheSize = 8
+
 	// Metrics
 	skipSlotCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "skip_slot_cache_hit",
@@ -31,18 +35,27 @@ type SkipSlotCache struct {
 	lock       sync.RWMutex
 	disabled   bool // Allow for programmatic toggling of the cache, useful during initial sync.
 	inProgress map[[32]byte]bool
+	budget     *BudgetTracker
 }
 
-// NewSkipSlotCache initializes the map and underlying cache.
+// NewSkipSlotCache initializes the map and underlying cache. Its states are tracked in the state
+// memory budget.
 func NewSkipSlotCache() *SkipSlotCache {
-	cache, err := lru.New(8)
+	c := &SkipSlotCache{
+		inProgress: make(map[[32]byte]bool),
+	}
+	c.budget = StateMemoryBudget.Track("skip_slot", SkipSlotCacheWeight, func(key interface{}) {
+		c.cache.Remove(key)
+	})
+	size := ConfiguredSize(flags.Get().SkipSlotCacheSize, maxSkipSlotCacheSize)
+	cache, err := lru.NewWithEvict(size, func(key interface{}, _ interface{}) {
+		c.budget.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &SkipSlotCache{
-		cache:      cache,
-		inProgress: make(map[[32]byte]bool),
-	}
+	c.cache = cache
+	return c
 }
 
 // Enable the skip slot cache.
@@ -96,10 +109,12 @@ func (c *SkipSlotCache) Get(ctx context.Context, r [32]byte) (*stateTrie.BeaconS
 
 	if exists && item != nil {
 		skipSlotCacheHit.Inc()
+		c.budget.Hit(r)
 		span.AddAttributes(trace.BoolAttribute("hit", true))
 		return item.(*stateTrie.BeaconState).Copy(), nil
 	}
 	skipSlotCacheMiss.Inc()
+	c.budget.Miss()
 	span.AddAttributes(trace.BoolAttribute("hit", false))
 	return nil, nil
 }
@@ -142,7 +157,12 @@ func (c *SkipSlotCache) Put(_ context.Context, r [32]byte, state *stateTrie.Beac
 	}
 
 	// Copy state so cached value is not mutated.
-	c.cache.Add(r, state.Copy())
+	cp := state.Copy()
+	c.lock.Lock()
+	c.cache.Add(r, cp)
+	evicted := c.budget.Add(r, cp.SizeEstimate())
+	c.lock.Unlock()
+	evicted.Evict()
 
 	return nil
 }
@@ -80,8 +80,8 @@ func runBlockProcessingTest(t *testing.T, config string) {
 				postBeaconState := &pb.BeaconState{}
 				require.NoError(t, postBeaconState.UnmarshalSSZ(postBeaconStateFile), "Failed to unmarshal")
 
-				if !proto.Equal(beaconState.InnerStateUnsafe(), postBeaconState) {
-					diff, _ := messagediff.PrettyDiff(beaconState.InnerStateUnsafe(), postBeaconState)
+				if !proto.Equal(beaconState.ToProtoUnsafe(), postBeaconState) {
+					diff, _ := messagediff.PrettyDiff(beaconState.ToProtoUnsafe(), postBeaconState)
 					t.Log(diff)
 					t.Fatal("Post state does not match expected")
 				}
@@ -137,14 +137,20 @@ func ComputeSubnetFromCommitteeAndSlot(activeValCount uint64, comIdx types.Commi
 //   valid_attestation_slot = 98
 // In the attestation must be within the range of 95 to 100 in the example above.
 func ValidateAttestationTime(attSlot types.Slot, genesisTime time.Time) error {
-	if err := ValidateSlotClock(attSlot, uint64(genesisTime.Unix())); err != nil {
+	return ValidateAttestationTimeAt(timeutils.Now(), attSlot, genesisTime)
+}
+
+// ValidateAttestationTimeAt validates the input attestation is within the propagation
+// range of the provided current time, as ValidateAttestationTime does with the local clock.
+func ValidateAttestationTimeAt(currentTime time.Time, attSlot types.Slot, genesisTime time.Time) error {
+	if err := ValidateSlotClockAt(currentTime, attSlot, uint64(genesisTime.Unix())); err != nil {
 		return err
 	}
 	attTime, err := SlotToTime(uint64(genesisTime.Unix()), attSlot)
 	if err != nil {
 		return err
 	}
-	currentSlot := SlotsSince(genesisTime)
+	currentSlot := CurrentSlotAt(currentTime, uint64(genesisTime.Unix()))
 
 	// A clock disparity allows for minor tolerances outside of the expected range. This value is
 	// usually small, less than 1 second.
@@ -152,7 +158,7 @@ func ValidateAttestationTime(attSlot types.Slot, genesisTime time.Time) error {
 
 	// An attestation cannot be from the future, so the upper bounds is set to now, with a minor
 	// tolerance for peer clock disparity.
-	upperBounds := timeutils.Now().Add(clockDisparity)
+	upperBounds := currentTime.Add(clockDisparity)
 
 	// An attestation cannot be older than the current slot - attestation propagation slot range
 	// with a minor tolerance for peer clock disparity.
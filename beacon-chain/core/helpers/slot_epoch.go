@@ -116,6 +116,12 @@ func SlotsSinceEpochStarts(slot types.Slot) types.Slot {
 
 // VerifySlotTime validates the input slot is not from the future.
 func VerifySlotTime(genesisTime uint64, slot types.Slot, timeTolerance time.Duration) error {
+	return VerifySlotTimeAt(timeutils.Now(), genesisTime, slot, timeTolerance)
+}
+
+// VerifySlotTimeAt validates the input slot is not from the future, relative to the
+// provided current time.
+func VerifySlotTimeAt(currentTime time.Time, genesisTime uint64, slot types.Slot, timeTolerance time.Duration) error {
 	slotTime, err := SlotToTime(genesisTime, slot)
 	if err != nil {
 		return err
@@ -123,11 +129,10 @@ func VerifySlotTime(genesisTime uint64, slot types.Slot, timeTolerance time.Dura
 
 	// Defensive check to ensure unreasonable slots are rejected
 	// straight away.
-	if err := ValidateSlotClock(slot, genesisTime); err != nil {
+	if err := ValidateSlotClockAt(currentTime, slot, genesisTime); err != nil {
 		return err
 	}
 
-	currentTime := timeutils.Now()
 	diff := slotTime.Sub(currentTime)
 
 	if diff > timeTolerance {
@@ -157,7 +162,12 @@ func SlotsSince(time time.Time) types.Slot {
 // CurrentSlot returns the current slot as determined by the local clock and
 // provided genesis time.
 func CurrentSlot(genesisTimeSec uint64) types.Slot {
-	now := timeutils.Now().Unix()
+	return CurrentSlotAt(timeutils.Now(), genesisTimeSec)
+}
+
+// CurrentSlotAt returns the slot of the provided current time, given the genesis time.
+func CurrentSlotAt(currentTime time.Time, genesisTimeSec uint64) types.Slot {
+	now := currentTime.Unix()
 	genesis := int64(genesisTimeSec)
 	if now < genesis {
 		return 0
@@ -169,7 +179,13 @@ func CurrentSlot(genesisTimeSec uint64) types.Slot {
 // clock to ensure slots that are unreasonable are returned with
 // an error.
 func ValidateSlotClock(slot types.Slot, genesisTimeSec uint64) error {
-	maxPossibleSlot := CurrentSlot(genesisTimeSec).Add(MaxSlotBuffer)
+	return ValidateSlotClockAt(timeutils.Now(), slot, genesisTimeSec)
+}
+
+// ValidateSlotClockAt validates a provided slot against the provided
+// current time, as ValidateSlotClock does with the local clock.
+func ValidateSlotClockAt(currentTime time.Time, slot types.Slot, genesisTimeSec uint64) error {
+	maxPossibleSlot := CurrentSlotAt(currentTime, genesisTimeSec).Add(MaxSlotBuffer)
 	// Defensive check to ensure that we only process slots up to a hard limit
 	// from our local clock.
 	if slot > maxPossibleSlot {
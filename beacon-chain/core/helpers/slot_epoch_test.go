@@ -337,6 +337,18 @@ func TestVerifySlotTime(t *testing.T) {
 	}
 }
 
+func TestVerifySlotTimeAt(t *testing.T) {
+	genesisTime := uint64(1606824023)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	now := time.Unix(int64(genesisTime), 0).Add(5 * slotDuration)
+
+	assert.NoError(t, VerifySlotTimeAt(now, genesisTime, 5, 0))
+	assert.ErrorContains(t, "could not process slot from the future", VerifySlotTimeAt(now, genesisTime, 6, 0))
+	assert.NoError(t, VerifySlotTimeAt(now.Add(slotDuration), genesisTime, 6, 0))
+	assert.Equal(t, types.Slot(5), CurrentSlotAt(now, genesisTime))
+	assert.Equal(t, types.Slot(0), CurrentSlotAt(time.Unix(int64(genesisTime)-1, 0), genesisTime))
+}
+
 func TestValidateSlotClock_HandlesBadSlot(t *testing.T) {
 	genTime := timeutils.Now().Add(-1 * time.Duration(MaxSlotBuffer) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second).Unix()
 
@@ -109,7 +109,7 @@ func BenchmarkHashTreeRootState_FullState(b *testing.B) {
 func BenchmarkMarshalState_FullState(b *testing.B) {
 	beaconState, err := benchutil.PreGenState2FullEpochs()
 	require.NoError(b, err)
-	natState := beaconState.InnerStateUnsafe()
+	natState := beaconState.ToProtoUnsafe()
 
 	b.Run("Proto_Marshal", func(b *testing.B) {
 		b.ResetTimer()
@@ -133,7 +133,7 @@ func BenchmarkMarshalState_FullState(b *testing.B) {
 func BenchmarkUnmarshalState_FullState(b *testing.B) {
 	beaconState, err := benchutil.PreGenState2FullEpochs()
 	require.NoError(b, err)
-	natState := beaconState.InnerStateUnsafe()
+	natState := beaconState.ToProtoUnsafe()
 	protoObject, err := proto.Marshal(natState)
 	require.NoError(b, err)
 	sszObject, err := natState.MarshalSSZ()
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli/v2"
+)
+
+// exportArchive writes the finalized chain of the database in the data directory to a new
+// archive file.
+func exportArchive(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	archiveFile := cliCtx.String(flags.ArchiveFileFlag.Name)
+	if archiveFile == "" {
+		return errors.New("no archive file specified")
+	}
+	if !fileutil.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return errors.New("no beacon node database found at path, nothing to export")
+	}
+
+	store, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	f, err := os.OpenFile(archiveFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not create archive file")
+	}
+	interval := types.Slot(cliCtx.Uint64(flags.ArchiveStateIntervalFlag.Name))
+	if err := store.ExportArchive(ctx, f, interval); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close archive file")
+		}
+		// A partial archive cannot be imported.
+		if rmErr := os.Remove(archiveFile); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove partial archive file")
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.WithField("file", archiveFile).Info("Export completed successfully")
+	return nil
+}
+
+// importArchive rebuilds a new database in the data directory from an archive file.
+func importArchive(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	archiveFile := cliCtx.String(flags.ArchiveFileFlag.Name)
+	if !fileutil.FileExists(archiveFile) {
+		return errors.New("archive file not found")
+	}
+	if fileutil.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return errors.New("a database file already exists in the data directory, import requires a fresh database")
+	}
+
+	f, err := os.Open(archiveFile)
+	if err != nil {
+		return errors.Wrap(err, "could not open archive file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close archive file")
+		}
+	}()
+	store, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	if err := store.ImportArchive(ctx, f); err != nil {
+		if closeErr := store.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		// Leave no partially imported database behind to be mistaken for a complete one.
+		if rmErr := os.Remove(path.Join(dbDir, kv.DatabaseFileName)); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove partially imported database")
+		}
+		return err
+	}
+	if err := store.Close(); err != nil {
+		return err
+	}
+	log.Info("Import completed successfully")
+	return nil
+}
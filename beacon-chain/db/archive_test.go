@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func archiveCliContext(t *testing.T, dataDir, archiveFile string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(flags.ArchiveFileFlag.Name, "", "")
+	set.Uint64(flags.ArchiveStateIntervalFlag.Name, 0, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	require.NoError(t, set.Set(flags.ArchiveFileFlag.Name, archiveFile))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestExportImportArchive(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	exportDir := t.TempDir()
+	exportDb, err := kv.NewKVStore(ctx, path.Join(exportDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, exportDb.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, exportDb.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, exportDb.Close())
+
+	archiveFile := filepath.Join(t.TempDir(), "beacon.archive")
+	require.NoError(t, exportArchive(archiveCliContext(t, exportDir, archiveFile)))
+	assert.LogsContain(t, logHook, "Export completed successfully")
+	assert.ErrorContains(t, "could not create archive file", exportArchive(archiveCliContext(t, exportDir, archiveFile)))
+
+	importDir := t.TempDir()
+	require.NoError(t, importArchive(archiveCliContext(t, importDir, archiveFile)))
+	assert.LogsContain(t, logHook, "Import completed successfully")
+	assert.ErrorContains(t, "already exists", importArchive(archiveCliContext(t, importDir, archiveFile)))
+
+	importedDb, err := kv.NewKVStore(ctx, path.Join(importDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, importedDb.Close())
+	}()
+	head, err := importedDb.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), head.Block.Slot)
+	assert.Equal(t, true, importedDb.HasBlock(ctx, genesisRoot))
+}
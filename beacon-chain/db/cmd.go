@@ -1,6 +1,7 @@
 package db
 
 import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/urfave/cli/v2"
@@ -27,5 +28,36 @@ var DatabaseCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "export",
+			Description: `exports the finalized chain of the database to a checksummed archive file`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveFileFlag,
+				flags.ArchiveStateIntervalFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportArchive(cliCtx); err != nil {
+					log.Fatalf("Could not export database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import",
+			Description: `rebuilds a fresh database from an archive file, verifying its blocks and states`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := importArchive(cliCtx); err != nil {
+					log.Fatalf("Could not import database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
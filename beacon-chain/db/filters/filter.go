@@ -43,6 +43,12 @@ const (
 	TargetRoot
 	// SlotStep is used for range filters of objects by their slot in step increments.
 	SlotStep
+	// ProposerIndex defines a filter for the proposer index attribute of blocks.
+	ProposerIndex
+	// GraffitiPrefix defines a filter for blocks whose graffiti starts with a prefix.
+	GraffitiPrefix
+	// AttesterIndex defines a filter for blocks including an attestation of a validator.
+	AttesterIndex
 )
 
 // QueryFilter defines a generic interface for type-asserting
@@ -132,3 +138,21 @@ func (q *QueryFilter) SetSlotStep(val uint64) *QueryFilter {
 	q.queries[SlotStep] = val
 	return q
 }
+
+// SetProposerIndex enables filtering by the proposer index attribute of an object.
+func (q *QueryFilter) SetProposerIndex(val types.ValidatorIndex) *QueryFilter {
+	q.queries[ProposerIndex] = val
+	return q
+}
+
+// SetGraffitiPrefix enables filtering by the objects whose graffiti starts with the prefix.
+func (q *QueryFilter) SetGraffitiPrefix(val []byte) *QueryFilter {
+	q.queries[GraffitiPrefix] = val
+	return q
+}
+
+// SetAttesterIndex enables filtering by the objects including an attestation of the validator index.
+func (q *QueryFilter) SetAttesterIndex(val types.ValidatorIndex) *QueryFilter {
+	q.queries[AttesterIndex] = val
+	return q
+}
@@ -137,6 +137,11 @@ func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte)
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
+// SaveBlocksWithAttesterIndices -- passthrough.
+func (e Exporter) SaveBlocksWithAttesterIndices(ctx context.Context, blocks []*eth.SignedBeaconBlock, attesters [][]types.ValidatorIndex) error {
+	return e.db.SaveBlocksWithAttesterIndices(ctx, blocks, attesters)
+}
+
 // SaveState -- passthrough.
 func (e Exporter) SaveState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
 	return e.db.SaveState(ctx, st, blockRoot)
@@ -207,6 +212,21 @@ func (e Exporter) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	return e.db.HasStateSummary(ctx, blockRoot)
 }
 
+// StateDiff -- passthrough.
+func (e Exporter) StateDiff(ctx context.Context, blockRoot [32]byte) (*db.StateDiff, error) {
+	return e.db.StateDiff(ctx, blockRoot)
+}
+
+// HasStateDiff -- passthrough.
+func (e Exporter) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	return e.db.HasStateDiff(ctx, blockRoot)
+}
+
+// SaveStateDiff -- passthrough.
+func (e Exporter) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *db.StateDiff) error {
+	return e.db.SaveStateDiff(ctx, blockRoot, diff)
+}
+
 // IsFinalizedBlock -- passthrough.
 func (e Exporter) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.IsFinalizedBlock(ctx, blockRoot)
@@ -227,6 +247,16 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// OperationPool -- passthrough
+func (e Exporter) OperationPool(ctx context.Context) (*db.OperationPool, error) {
+	return e.db.OperationPool(ctx)
+}
+
+// SaveOperationPool -- passthrough
+func (e Exporter) SaveOperationPool(ctx context.Context, pool *db.OperationPool) error {
+	return e.db.SaveOperationPool(ctx, pool)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index types.Slot) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
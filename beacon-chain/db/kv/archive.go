@@ -0,0 +1,526 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// An archive holds the finalized chain of a database, independently of the layout of the bolt
+// file, to rebuild a database elsewhere. It is a snappy framed stream of:
+//   - a header: the archive magic and the archive format version,
+//   - the blocks of the finalized chain from genesis to the finalized checkpoint, each followed
+//     by the archived state saved with its root, if any,
+//   - the finalized checkpoint, the deposit contract address and the powchain data,
+//   - an end record holding the sha256 checksum of everything before it.
+// Records are a kind byte, a big endian uint64 length, and the SSZ or proto encoding of the object.
+
+// archiveMagic starts every archive.
+var archiveMagic = []byte("prysm-beacon-archive")
+
+// archiveVersion is the version of the archive format written by ExportArchive.
+const archiveVersion = byte(1)
+
+const (
+	archiveBlockRecord = byte(iota + 1)
+	archiveStateRecord
+	archiveFinalizedCheckpointRecord
+	archiveDepositContractRecord
+	archivePowchainDataRecord
+	archiveEndRecord
+)
+
+// maxArchiveRecordSize bounds the size of a record read from an archive.
+const maxArchiveRecordSize = 1 << 32
+
+// archiveBatchSize is the number of blocks saved at once by ImportArchive.
+const archiveBatchSize = 256
+
+// archiveWriter writes the records of an archive, and checksums them.
+type archiveWriter struct {
+	w        io.Writer
+	checksum hash.Hash
+}
+
+func (w *archiveWriter) write(b []byte) error {
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	_, err := w.checksum.Write(b)
+	return err
+}
+
+func (w *archiveWriter) writeRecord(kind byte, payload []byte) error {
+	if err := w.write([]byte{kind}); err != nil {
+		return err
+	}
+	if err := w.write(bytesutil.Uint64ToBytesBigEndian(uint64(len(payload)))); err != nil {
+		return err
+	}
+	return w.write(payload)
+}
+
+// archiveReader reads the records of an archive, and checksums them.
+type archiveReader struct {
+	r        io.Reader
+	checksum hash.Hash
+}
+
+func (r *archiveReader) read(n uint64) ([]byte, error) {
+	// The buffer grows as the data is read, rather than trusting the length of a corrupted record.
+	buf := &bytes.Buffer{}
+	if _, err := io.CopyN(io.MultiWriter(buf, r.checksum), r.r, int64(n)); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *archiveReader) readRecord() (byte, []byte, error) {
+	header, err := r.read(9)
+	if err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint64(header[1:])
+	if length > maxArchiveRecordSize {
+		return 0, nil, fmt.Errorf("record size %d exceeds maximum %d", length, uint64(maxArchiveRecordSize))
+	}
+	payload, err := r.read(length)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header[0], payload, nil
+}
+
+// ExportArchive writes the finalized chain of the database to an archive: the blocks from
+// genesis to the finalized checkpoint, the genesis and finalized states, the archived states
+// of the finalized chain with a slot multiple of the state interval, or all of them if the
+// interval is 0, the finalized checkpoint and the powchain data.
+func (s *Store) ExportArchive(ctx context.Context, w io.Writer, stateInterval types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExportArchive")
+	defer span.End()
+
+	sw := snappy.NewBufferedWriter(w)
+	aw := &archiveWriter{w: sw, checksum: sha256.New()}
+	if err := aw.write(append(append([]byte{}, archiveMagic...), archiveVersion)); err != nil {
+		return err
+	}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		if genesisRoot == nil {
+			return errors.New("no genesis block root in db")
+		}
+		checkpoint := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+		if enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey); enc != nil {
+			if err := decode(ctx, enc, checkpoint); err != nil {
+				return err
+			}
+		}
+		// Before the first finalized epoch, the finalized root is zero and the chain is genesis.
+		finalizedRoot := checkpoint.Root
+		if bytesutil.ToBytes32(finalizedRoot) == params.BeaconConfig().ZeroHash {
+			finalizedRoot = genesisRoot
+		}
+		roots, err := finalizedChainRoots(ctx, tx, genesisRoot, finalizedRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not walk finalized chain")
+		}
+		finalizedSlot, err := helpers.StartSlot(checkpoint.Epoch)
+		if err != nil {
+			return err
+		}
+		states := archivedStateRoots(tx, finalizedSlot, stateInterval)
+		states[bytesutil.ToBytes32(genesisRoot)] = true
+		states[bytesutil.ToBytes32(finalizedRoot)] = true
+
+		var stateCount int
+		for _, root := range roots {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, tx.Bucket(blocksBucket).Get(root[:]), blk); err != nil {
+				return err
+			}
+			enc, err := blk.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			if err := aw.writeRecord(archiveBlockRecord, enc); err != nil {
+				return err
+			}
+			if !states[root] {
+				continue
+			}
+			st, err := stateByRoot(ctx, tx, root[:])
+			if err != nil {
+				return err
+			}
+			if st == nil {
+				continue
+			}
+			enc, err = st.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			if err := aw.writeRecord(archiveStateRecord, append(root[:], enc...)); err != nil {
+				return err
+			}
+			stateCount++
+		}
+
+		enc, err := checkpoint.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err := aw.writeRecord(archiveFinalizedCheckpointRecord, enc); err != nil {
+			return err
+		}
+		if addr := tx.Bucket(chainMetadataBucket).Get(depositContractAddressKey); addr != nil {
+			if err := aw.writeRecord(archiveDepositContractRecord, addr); err != nil {
+				return err
+			}
+		}
+		if enc := tx.Bucket(powchainBucket).Get(powchainDataKey); len(enc) > 0 {
+			if err := aw.writeRecord(archivePowchainDataRecord, enc); err != nil {
+				return err
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"blocks": len(roots),
+			"states": stateCount,
+		}).Info("Exported finalized chain")
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := aw.writeRecord(archiveEndRecord, aw.checksum.Sum(nil)); err != nil {
+		return err
+	}
+	return sw.Close()
+}
+
+// finalizedChainRoots returns the roots of the blocks from genesis to the finalized root, in
+// slot order.
+func finalizedChainRoots(ctx context.Context, tx *bolt.Tx, genesisRoot, finalizedRoot []byte) ([][32]byte, error) {
+	bkt := tx.Bucket(blocksBucket)
+	var roots [][32]byte
+	root := finalizedRoot
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		roots = append(roots, bytesutil.ToBytes32(root))
+		if bytes.Equal(root, genesisRoot) {
+			break
+		}
+		enc := bkt.Get(root)
+		if enc == nil {
+			return nil, fmt.Errorf("missing block in database: block root=%#x", root)
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, enc, blk); err != nil {
+			return nil, err
+		}
+		if blk.Block.Slot == 0 {
+			return nil, fmt.Errorf("block %#x at slot 0 is not genesis", root)
+		}
+		root = blk.Block.ParentRoot
+	}
+	return bytesutil.ReverseBytes32Slice(roots), nil
+}
+
+// archivedStateRoots returns the block roots of the states saved at or below the slot, which is a
+// multiple of the interval if it is not 0.
+func archivedStateRoots(tx *bolt.Tx, maxSlot, interval types.Slot) map[[32]byte]bool {
+	roots := make(map[[32]byte]bool)
+	c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot > maxSlot {
+			break
+		}
+		if interval == 0 || slot%interval == 0 {
+			roots[bytesutil.ToBytes32(v)] = true
+		}
+	}
+	return roots
+}
+
+// ImportArchive rebuilds the finalized chain of an archive into the database, which must be
+// empty. The blocks are verified to form a chain from genesis, and the states to match the state
+// roots of the blocks they are saved with. The finalized block becomes the head block.
+func (s *Store) ImportArchive(ctx context.Context, r io.Reader) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ImportArchive")
+	defer span.End()
+
+	genesisBlk, err := s.GenesisBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if genesisBlk != nil {
+		return errors.New("cannot import archive into a non-empty database")
+	}
+
+	ar := &archiveReader{r: snappy.NewReader(r), checksum: sha256.New()}
+	header, err := ar.read(uint64(len(archiveMagic) + 1))
+	if err != nil {
+		return errors.Wrap(err, "could not read archive header")
+	}
+	if !bytes.Equal(header[:len(archiveMagic)], archiveMagic) {
+		return errors.New("not a beacon database archive")
+	}
+	if header[len(archiveMagic)] != archiveVersion {
+		return fmt.Errorf("unsupported archive version %d", header[len(archiveMagic)])
+	}
+
+	imp := &archiveImporter{store: s}
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// The checksum covers the records before the end record.
+		checksum := ar.checksum.Sum(nil)
+		kind, payload, err := ar.readRecord()
+		if err != nil {
+			return errors.Wrap(err, "could not read archive record")
+		}
+		if kind == archiveEndRecord {
+			if !bytes.Equal(payload, checksum) {
+				return errors.New("archive checksum mismatch")
+			}
+			break
+		}
+		if err := imp.importRecord(ctx, kind, payload); err != nil {
+			return err
+		}
+	}
+	if n, err := ar.r.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		return errors.New("unexpected data after archive end")
+	}
+	return imp.finish(ctx)
+}
+
+// archiveImporter saves the records of an archive as they are read.
+type archiveImporter struct {
+	store      *Store
+	blocks     []*ethpb.SignedBeaconBlock
+	summaries  []*pb.StateSummary
+	lastBlock  *ethpb.BeaconBlock
+	lastRoot   [32]byte
+	checkpoint *ethpb.Checkpoint
+	// verified is the last saved state, to replay the following blocks and skipped slots from.
+	verified   *state.BeaconState
+	stateCount int
+}
+
+func (imp *archiveImporter) importRecord(ctx context.Context, kind byte, payload []byte) error {
+	switch kind {
+	case archiveBlockRecord:
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := blk.UnmarshalSSZ(payload); err != nil {
+			return errors.Wrap(err, "could not decode block")
+		}
+		return imp.importBlock(ctx, blk)
+	case archiveStateRecord:
+		if len(payload) < 32 {
+			return errors.New("state record too short")
+		}
+		st := &pb.BeaconState{}
+		if err := st.UnmarshalSSZ(payload[32:]); err != nil {
+			return errors.Wrap(err, "could not decode state")
+		}
+		return imp.importState(ctx, bytesutil.ToBytes32(payload[:32]), st)
+	case archiveFinalizedCheckpointRecord:
+		imp.checkpoint = &ethpb.Checkpoint{}
+		return imp.checkpoint.UnmarshalSSZ(payload)
+	case archiveDepositContractRecord:
+		return imp.store.SaveDepositContractAddress(ctx, common.BytesToAddress(payload))
+	case archivePowchainDataRecord:
+		data := &db.ETH1ChainData{}
+		if err := proto.Unmarshal(payload, data); err != nil {
+			return errors.Wrap(err, "could not decode powchain data")
+		}
+		return imp.store.SavePowchainData(ctx, data)
+	default:
+		return fmt.Errorf("unknown archive record kind %d", kind)
+	}
+}
+
+func (imp *archiveImporter) importBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) error {
+	if blk.Block == nil {
+		return errors.New("nil block")
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if imp.lastBlock == nil {
+		if blk.Block.Slot != 0 {
+			return fmt.Errorf("first block at slot %d is not genesis", blk.Block.Slot)
+		}
+	} else if bytesutil.ToBytes32(blk.Block.ParentRoot) != imp.lastRoot || blk.Block.Slot <= imp.lastBlock.Slot {
+		return fmt.Errorf("block %#x at slot %d does not follow block %#x", root, blk.Block.Slot, imp.lastRoot)
+	}
+	imp.blocks = append(imp.blocks, blk)
+	imp.summaries = append(imp.summaries, &pb.StateSummary{Slot: blk.Block.Slot, Root: root[:]})
+	if imp.lastBlock == nil {
+		if err := imp.flushBlocks(ctx); err != nil {
+			return err
+		}
+		if err := imp.store.SaveGenesisBlockRoot(ctx, root); err != nil {
+			return err
+		}
+	} else if len(imp.blocks) >= archiveBatchSize {
+		if err := imp.flushBlocks(ctx); err != nil {
+			return err
+		}
+	}
+	imp.lastBlock = blk.Block
+	imp.lastRoot = root
+	return nil
+}
+
+func (imp *archiveImporter) flushBlocks(ctx context.Context) error {
+	if err := imp.store.SaveBlocks(ctx, imp.blocks); err != nil {
+		return err
+	}
+	if err := imp.store.SaveStateSummaries(ctx, imp.summaries); err != nil {
+		return err
+	}
+	imp.blocks = imp.blocks[:0]
+	imp.summaries = imp.summaries[:0]
+	return nil
+}
+
+// importState verifies the state against the block it is saved with, which is the last block
+// read. A state at the slot of its block must have the state root of the block. A state at a
+// later slot, after skipped slots, must match the last verified state advanced through the
+// following blocks and the skipped slots.
+func (imp *archiveImporter) importState(ctx context.Context, blockRoot [32]byte, st *pb.BeaconState) error {
+	if imp.lastBlock == nil || blockRoot != imp.lastRoot {
+		return fmt.Errorf("state of block %#x does not follow its block", blockRoot)
+	}
+	blk := imp.lastBlock
+	if st.Slot < blk.Slot {
+		return fmt.Errorf("state at slot %d does not match block at slot %d", st.Slot, blk.Slot)
+	}
+	bs, err := state.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return err
+	}
+	stateRoot, err := bs.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	wantRoot := bytesutil.ToBytes32(blk.StateRoot)
+	if st.Slot > blk.Slot {
+		if wantRoot, err = imp.replayedStateRoot(ctx, st.Slot); err != nil {
+			return errors.Wrapf(err, "could not verify state at slot %d", st.Slot)
+		}
+	}
+	if stateRoot != wantRoot {
+		return fmt.Errorf("state root %#x at slot %d does not match state root %#x of the chain", stateRoot, st.Slot, wantRoot)
+	}
+
+	if err := imp.store.SaveState(ctx, bs, blockRoot); err != nil {
+		return err
+	}
+	imp.verified = bs.Copy()
+	imp.stateCount++
+	return nil
+}
+
+// replayedStateRoot returns the root of the last verified state advanced through the blocks read
+// since, which are saved first, and through the skipped slots up to the slot.
+func (imp *archiveImporter) replayedStateRoot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	if imp.verified == nil {
+		return [32]byte{}, errors.New("no verified state to replay from")
+	}
+	st := imp.verified.Copy()
+	if imp.lastBlock.Slot > st.Slot() {
+		if err := imp.flushBlocks(ctx); err != nil {
+			return [32]byte{}, err
+		}
+		blks, _, err := imp.store.Blocks(ctx, filters.NewFilter().SetStartSlot(st.Slot()+1).SetEndSlot(imp.lastBlock.Slot))
+		if err != nil {
+			return [32]byte{}, err
+		}
+		sort.Slice(blks, func(i, j int) bool {
+			return blks[i].Block.Slot < blks[j].Block.Slot
+		})
+		for _, blk := range blks {
+			if _, st, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, st, blk); err != nil {
+				return [32]byte{}, errors.Wrapf(err, "could not replay block at slot %d", blk.Block.Slot)
+			}
+		}
+	}
+	st, err := transition.ProcessSlots(ctx, st, slot)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not process skipped slots")
+	}
+	return st.HashTreeRoot(ctx)
+}
+
+// finish saves the remaining blocks, the finalized checkpoint, and makes the finalized block
+// the head block.
+func (imp *archiveImporter) finish(ctx context.Context) error {
+	if imp.lastBlock == nil {
+		return errors.New("archive has no blocks")
+	}
+	if imp.checkpoint == nil {
+		return errors.New("archive has no finalized checkpoint")
+	}
+	if err := imp.flushBlocks(ctx); err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(imp.checkpoint.Root) != params.BeaconConfig().ZeroHash {
+		if bytesutil.ToBytes32(imp.checkpoint.Root) != imp.lastRoot {
+			return errors.New("finalized checkpoint root is not the last block")
+		}
+		if err := imp.store.SaveFinalizedCheckpoint(ctx, imp.checkpoint); err != nil {
+			return err
+		}
+		// The archive does not hold the blocks after the finalized checkpoint, which the
+		// justified checkpoint may be the root of.
+		if err := imp.store.SaveJustifiedCheckpoint(ctx, imp.checkpoint); err != nil {
+			return err
+		}
+	}
+	if err := imp.store.SaveHeadBlockRoot(ctx, imp.lastRoot); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":   imp.lastBlock.Slot,
+		"states": imp.stateCount,
+	}).Info("Imported finalized chain")
+	return nil
+}
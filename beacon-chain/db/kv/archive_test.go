@@ -0,0 +1,148 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/snappy"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// archiveGenesis returns a genesis block and its state.
+func archiveGenesis(t *testing.T) (*ethpb.SignedBeaconBlock, *state.BeaconState) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	bodyRoot, err := genesis.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{BodyRoot: bodyRoot[:], StateRoot: make([]byte, 32), ParentRoot: make([]byte, 32)}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	genesis.Block.StateRoot = stateRoot[:]
+	return genesis, st
+}
+
+// setupArchiveChain saves a genesis block and state, and a chain of blocks up to a finalized
+// block at the slot, and returns the roots of the blocks.
+func setupArchiveChain(t *testing.T, store *Store, finalizedSlot types.Slot) [][32]byte {
+	ctx := context.Background()
+	genesis, st := archiveGenesis(t)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, genesis))
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, store.SaveState(ctx, st, genesisRoot))
+
+	roots := [][32]byte{genesisRoot}
+	for slot := types.Slot(1); slot <= finalizedSlot; slot++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = roots[len(roots)-1][:]
+		require.NoError(t, store.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+	}
+	// A block off the finalized chain is not exported.
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 1
+	orphan.Block.ParentRoot = genesisRoot[:]
+	orphan.Block.Body.Graffiti = bytes.Repeat([]byte{'a'}, 32)
+	require.NoError(t, store.SaveBlock(ctx, orphan))
+
+	finalizedRoot := roots[len(roots)-1]
+	require.NoError(t, store.SaveStateSummary(ctx, &pb.StateSummary{Slot: finalizedSlot, Root: finalizedRoot[:]}))
+	require.NoError(t, store.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	return roots
+}
+
+func TestStore_ExportImportArchive(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewKVStore(ctx, t.TempDir(), &Config{})
+	require.NoError(t, err, "Failed to instantiate DB")
+	roots := setupArchiveChain(t, store, 40)
+	addr := common.HexToAddress("0x0123456789abcdef0123456789abcdef01234567")
+	require.NoError(t, store.SaveDepositContractAddress(ctx, addr))
+	powchainData := &db.ETH1ChainData{CurrentEth1Data: &db.LatestETH1Data{BlockHeight: 100}}
+	require.NoError(t, store.SavePowchainData(ctx, powchainData))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, store.ExportArchive(ctx, buf, 0))
+	require.NoError(t, store.Close(), "Failed to close database")
+
+	imported := setupDB(t)
+	require.NoError(t, imported.ImportArchive(ctx, bytes.NewReader(buf.Bytes())))
+
+	for _, root := range roots {
+		assert.Equal(t, true, imported.HasBlock(ctx, root), "Missing block %#x", root)
+	}
+	blks, _, err := imported.Blocks(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(40))
+	require.NoError(t, err)
+	assert.Equal(t, len(roots), len(blks), "Unexpected number of imported blocks")
+	assert.Equal(t, true, imported.HasState(ctx, roots[0]))
+
+	head, err := imported.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(40), head.Block.Slot)
+	cp, err := imported.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[len(roots)-1][:], cp.Root)
+	importedAddr, err := imported.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, addr.Bytes(), importedAddr)
+	importedPowchainData, err := imported.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, powchainData, importedPowchainData)
+
+	assert.ErrorContains(t, "non-empty database", imported.ImportArchive(ctx, bytes.NewReader(buf.Bytes())))
+}
+
+func TestStore_ImportArchive_Corrupted(t *testing.T) {
+	ctx := context.Background()
+	raw := &bytes.Buffer{}
+	aw := &archiveWriter{w: raw, checksum: sha256.New()}
+	require.NoError(t, aw.write(append(append([]byte{}, archiveMagic...), archiveVersion)))
+	require.NoError(t, aw.writeRecord(archiveDepositContractRecord, []byte{'a'}))
+	require.NoError(t, aw.writeRecord(archiveEndRecord, []byte("not the checksum")))
+	buf := &bytes.Buffer{}
+	sw := snappy.NewBufferedWriter(buf)
+	_, err := sw.Write(raw.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, sw.Close())
+	store := setupDB(t)
+	assert.ErrorContains(t, "archive checksum mismatch", store.ImportArchive(ctx, buf))
+
+	assert.ErrorContains(t, "could not read archive header", store.ImportArchive(ctx, bytes.NewReader([]byte("not an archive"))))
+}
+
+func TestStore_ImportArchive_StateAfterSkippedSlots(t *testing.T) {
+	ctx := context.Background()
+	genesis, genesisState := archiveGenesis(t)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	skipped, err := transition.ProcessSlots(ctx, genesisState.Copy(), 3)
+	require.NoError(t, err)
+
+	imp := &archiveImporter{store: setupDB(t)}
+	require.NoError(t, imp.importBlock(ctx, genesis))
+	assert.ErrorContains(t, "no verified state to replay from", imp.importState(ctx, genesisRoot, skipped.CloneInnerState()))
+	require.NoError(t, imp.importState(ctx, genesisRoot, genesisState.CloneInnerState()))
+
+	tampered := skipped.CloneInnerState()
+	tampered.Eth1DepositIndex++
+	assert.ErrorContains(t, "does not match state root", imp.importState(ctx, genesisRoot, tampered))
+	require.NoError(t, imp.importState(ctx, genesisRoot, skipped.CloneInnerState()))
+	assert.Equal(t, 2, imp.stateCount)
+}
@@ -0,0 +1,120 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// saveBlockAttesterIndices indexes a block by the validator indices of the attestations it
+// includes. The indices are also saved by block root, to clear the block from the index when
+// it is deleted.
+func saveBlockAttesterIndices(tx *bolt.Tx, blockRoot []byte, indices []types.ValidatorIndex) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	bkt := tx.Bucket(blockAttesterIndicesBucket)
+	enc := make([]byte, 0, 8*len(indices))
+	for _, idx := range indices {
+		if err := bkt.Put(attesterIndexKey(idx, blockRoot), []byte{}); err != nil {
+			return err
+		}
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+	}
+	return tx.Bucket(blockAttestersBucket).Put(blockRoot, enc)
+}
+
+// deleteBlockAttesterIndices clears a block from the attester index.
+func deleteBlockAttesterIndices(tx *bolt.Tx, blockRoot []byte) error {
+	attesters := tx.Bucket(blockAttestersBucket)
+	enc := bytesutil.SafeCopyBytes(attesters.Get(blockRoot))
+	if enc == nil {
+		return nil
+	}
+	bkt := tx.Bucket(blockAttesterIndicesBucket)
+	for i := 0; i+8 <= len(enc); i += 8 {
+		idx := types.ValidatorIndex(bytesutil.BytesToUint64BigEndian(enc[i : i+8]))
+		if err := bkt.Delete(attesterIndexKey(idx, blockRoot)); err != nil {
+			return err
+		}
+	}
+	return attesters.Delete(blockRoot)
+}
+
+// saveBlockGraffitiIndex indexes a block by its graffiti.
+func saveBlockGraffitiIndex(tx *bolt.Tx, block *ethpb.BeaconBlock, blockRoot []byte) error {
+	if block.Body == nil {
+		return nil
+	}
+	return tx.Bucket(blockGraffitiIndicesBucket).Put(graffitiIndexKey(block.Body.Graffiti, blockRoot), []byte{})
+}
+
+// deleteBlockGraffitiIndex clears a block from the graffiti index.
+func deleteBlockGraffitiIndex(tx *bolt.Tx, block *ethpb.BeaconBlock, blockRoot []byte) error {
+	if block.Body == nil {
+		return nil
+	}
+	return tx.Bucket(blockGraffitiIndicesBucket).Delete(graffitiIndexKey(block.Body.Graffiti, blockRoot))
+}
+
+// blockRootsByPrefixFilters retrieves the block roots of the graffiti prefix and attester index
+// filters, whose indices are scanned by key prefix, as one list of roots per filter.
+func blockRootsByPrefixFilters(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter) ([][][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsByPrefixFilters")
+	defer span.End()
+	values := make([][][]byte, 0)
+	for k, v := range f.Filters() {
+		switch k {
+		case filters.GraffitiPrefix:
+			prefix, ok := v.([]byte)
+			if !ok {
+				return nil, errors.New("graffiti prefix is not []byte")
+			}
+			if len(prefix) > 32 {
+				return nil, errors.New("graffiti prefix is longer than 32 bytes")
+			}
+			values = append(values, rootsByKeyPrefix(tx, blockGraffitiIndicesBucket, prefix))
+		case filters.AttesterIndex:
+			idx, ok := v.(types.ValidatorIndex)
+			if !ok {
+				return nil, errors.New("attester index is not types.ValidatorIndex")
+			}
+			values = append(values, rootsByKeyPrefix(tx, blockAttesterIndicesBucket, bytesutil.Uint64ToBytesBigEndian(uint64(idx))))
+		}
+	}
+	return values, nil
+}
+
+// rootsByKeyPrefix returns the block roots ending the keys of the index bucket which start with
+// the prefix, skipping the roots of the blocks which are not in the DB.
+func rootsByKeyPrefix(tx *bolt.Tx, bucket, prefix []byte) [][]byte {
+	blocks := tx.Bucket(blocksBucket)
+	roots := make([][]byte, 0)
+	c := tx.Bucket(bucket).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		root := k[len(k)-32:]
+		if blocks.Get(root) == nil {
+			continue
+		}
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+func graffitiIndexKey(graffiti, blockRoot []byte) []byte {
+	key := make([]byte, 64)
+	copy(key[:32], graffiti)
+	copy(key[32:], blockRoot)
+	return key
+}
+
+func attesterIndexKey(idx types.ValidatorIndex, blockRoot []byte) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(idx)), blockRoot...)
+}
@@ -0,0 +1,120 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func indexedBlock(t *testing.T, slot types.Slot, proposer types.ValidatorIndex, graffiti string) (*ethpb.SignedBeaconBlock, [32]byte) {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ProposerIndex = proposer
+	b.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return b, root
+}
+
+// sortedRoots sorts roots, as the indices do not return them in slot order.
+func sortedRoots(roots [][32]byte) [][32]byte {
+	sort.Slice(roots, func(i, j int) bool {
+		return bytes.Compare(roots[i][:], roots[j][:]) < 0
+	})
+	return roots
+}
+
+func TestStore_BlockIndices(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	b1, r1 := indexedBlock(t, 1, 3, "prysm")
+	b2, r2 := indexedBlock(t, 2, 4, "prysm/v1.3.0")
+	b3, r3 := indexedBlock(t, 3, 3, "teku")
+	require.NoError(t, db.SaveBlocksWithAttesterIndices(
+		ctx,
+		[]*ethpb.SignedBeaconBlock{b1, b2, b3},
+		[][]types.ValidatorIndex{nil, {1, 5}, {5}},
+	))
+
+	tests := []struct {
+		name   string
+		filter *filters.QueryFilter
+		want   [][32]byte
+	}{
+		{name: "proposer index", filter: filters.NewFilter().SetProposerIndex(3), want: [][32]byte{r1, r3}},
+		{name: "unknown proposer index", filter: filters.NewFilter().SetProposerIndex(9), want: [][32]byte{}},
+		{name: "graffiti prefix", filter: filters.NewFilter().SetGraffitiPrefix([]byte("prysm")), want: [][32]byte{r1, r2}},
+		{name: "full graffiti", filter: filters.NewFilter().SetGraffitiPrefix(b1.Block.Body.Graffiti), want: [][32]byte{r1}},
+		{name: "attester index", filter: filters.NewFilter().SetAttesterIndex(5), want: [][32]byte{r2, r3}},
+		{name: "attester and proposer", filter: filters.NewFilter().SetAttesterIndex(5).SetProposerIndex(3), want: [][32]byte{r3}},
+		{name: "graffiti and slot range", filter: filters.NewFilter().SetGraffitiPrefix([]byte("prysm")).SetStartSlot(2).SetEndSlot(3), want: [][32]byte{r2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := db.BlockRoots(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.DeepEqual(t, sortedRoots(tt.want), sortedRoots(roots))
+		})
+	}
+
+	_, err := db.BlockRoots(ctx, filters.NewFilter().SetGraffitiPrefix(make([]byte, 33)))
+	assert.ErrorContains(t, "graffiti prefix is longer than 32 bytes", err)
+
+	require.NoError(t, db.deleteBlock(ctx, r1))
+	roots, err := db.BlockRoots(ctx, filters.NewFilter().SetGraffitiPrefix([]byte("prysm")))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r2}, roots)
+	roots, err = db.BlockRoots(ctx, filters.NewFilter().SetProposerIndex(3))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r3}, roots)
+	require.NoError(t, db.deleteBlocks(ctx, [][32]byte{r3}))
+	roots, err = db.BlockRoots(ctx, filters.NewFilter().SetAttesterIndex(5))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r2}, roots)
+}
+
+func TestStore_BlockIndices_AttesterIndexClearedWithBlock(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	b, r := indexedBlock(t, 1, 3, "prysm")
+	require.NoError(t, db.SaveBlocksWithAttesterIndices(ctx, []*ethpb.SignedBeaconBlock{b}, [][]types.ValidatorIndex{{1, 5}}))
+	assert.Equal(t, 2, bucketKeyCount(t, db, blockAttesterIndicesBucket))
+	require.NoError(t, db.deleteBlock(ctx, r))
+	assert.Equal(t, 0, bucketKeyCount(t, db, blockAttesterIndicesBucket))
+	assert.Equal(t, 0, bucketKeyCount(t, db, blockAttestersBucket))
+
+	// Index entries of blocks which are not in the DB are skipped.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return saveBlockAttesterIndices(tx, r[:], []types.ValidatorIndex{1})
+	}))
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetAttesterIndex(1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blks))
+	assert.Equal(t, 0, len(roots))
+}
+
+func TestStore_BlockIndices_Disabled(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	b, _ := indexedBlock(t, 1, 3, "prysm")
+	require.NoError(t, db.SaveBlock(ctx, b))
+	assert.Equal(t, 0, bucketKeyCount(t, db, blockProposerIndicesBucket))
+	assert.Equal(t, 0, bucketKeyCount(t, db, blockGraffitiIndicesBucket))
+}
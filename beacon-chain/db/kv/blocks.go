@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	bolt "go.etcd.io/bbolt"
@@ -202,6 +203,12 @@ func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := deleteBlockGraffitiIndex(tx, block.Block, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not delete root for graffiti index")
+		}
+		if err := deleteBlockAttesterIndices(tx, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not delete root for attester index")
+		}
 		s.blockCache.Del(string(blockRoot[:]))
 		return bkt.Delete(blockRoot[:])
 	})
@@ -227,6 +234,12 @@ func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 			if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 				return errors.Wrap(err, "could not delete root for DB indices")
 			}
+			if err := deleteBlockGraffitiIndex(tx, block.Block, blockRoot[:]); err != nil {
+				return errors.Wrap(err, "could not delete root for graffiti index")
+			}
+			if err := deleteBlockAttesterIndices(tx, blockRoot[:]); err != nil {
+				return errors.Wrap(err, "could not delete root for attester index")
+			}
 			s.blockCache.Del(string(blockRoot[:]))
 			if err := bkt.Delete(blockRoot[:]); err != nil {
 				return err
@@ -255,10 +268,27 @@ func (s *Store) SaveBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock)
 func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
 	defer span.End()
+	return s.saveBlocks(ctx, blocks, nil)
+}
+
+// SaveBlocksWithAttesterIndices saves blocks with the validator indices of the attestations each
+// of them includes, which are only known from the committees of their states. The blocks are
+// indexed by attester when block indices are enabled.
+func (s *Store) SaveBlocksWithAttesterIndices(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, attesters [][]types.ValidatorIndex) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocksWithAttesterIndices")
+	defer span.End()
+	if len(attesters) != len(blocks) {
+		return errors.New("blocks and attester indices length mismatch")
+	}
+	return s.saveBlocks(ctx, blocks, attesters)
+}
 
+// saveBlocks saves blocks, and the attester indices of each block if there are any, in a single
+// transaction.
+func (s *Store) saveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, attesters [][]types.ValidatorIndex) error {
 	return s.db.Update(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
-		for _, block := range blocks {
+		for i, block := range blocks {
 			blockRoot, err := block.Block.HashTreeRoot()
 			if err != nil {
 				return err
@@ -275,6 +305,16 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBloc
 			if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
+			if featureconfig.Get().EnableBlockIndices {
+				if err := saveBlockGraffitiIndex(tx, block.Block, blockRoot[:]); err != nil {
+					return errors.Wrap(err, "could not update graffiti index")
+				}
+				if attesters != nil {
+					if err := saveBlockAttesterIndices(tx, blockRoot[:], attesters[i]); err != nil {
+						return errors.Wrap(err, "could not update attester index")
+					}
+				}
+			}
 			s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
 
 			if err := bkt.Put(blockRoot[:], enc); err != nil {
@@ -413,6 +453,11 @@ func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter
 	// that list of roots to lookup the block. These block will
 	// meet the filter criteria.
 	indices := lookupValuesForIndices(ctx, indicesByBucket, tx)
+	prefixIndices, err := blockRootsByPrefixFilters(ctx, tx, f)
+	if err != nil {
+		return nil, err
+	}
+	indices = append(indices, prefixIndices...)
 	keys := rootsBySlotRange
 	if len(indices) > 0 {
 		// If we have found indices that meet the filter criteria, and there are also
@@ -540,6 +585,10 @@ func createBlockIndicesFromBlock(ctx context.Context, block *ethpb.BeaconBlock)
 		buckets = append(buckets, blockParentRootIndicesBucket)
 		indices = append(indices, block.ParentRoot)
 	}
+	if featureconfig.Get().EnableBlockIndices {
+		buckets = append(buckets, blockProposerIndicesBucket)
+		indices = append(indices, bytesutil.Uint64ToBytesBigEndian(uint64(block.ProposerIndex)))
+	}
 	for i := 0; i < len(buckets); i++ {
 		indicesByBucket[string(buckets[i])] = indices[i]
 	}
@@ -564,6 +613,12 @@ func createBlockIndicesFromFilters(ctx context.Context, f *filters.QueryFilter)
 				return nil, errors.New("parent root is not []byte")
 			}
 			indicesByBucket[string(blockParentRootIndicesBucket)] = parentRoot
+		case filters.ProposerIndex:
+			proposerIndex, ok := v.(types.ValidatorIndex)
+			if !ok {
+				return nil, errors.New("proposer index is not types.ValidatorIndex")
+			}
+			indicesByBucket[string(blockProposerIndicesBucket)] = bytesutil.Uint64ToBytesBigEndian(uint64(proposerIndex))
 		// The following cases are passthroughs for blocks, as they are not used
 		// for filtering indices.
 		case filters.StartSlot:
@@ -571,6 +626,9 @@ func createBlockIndicesFromFilters(ctx context.Context, f *filters.QueryFilter)
 		case filters.StartEpoch:
 		case filters.EndEpoch:
 		case filters.SlotStep:
+		// The graffiti prefix and attester index indices are scanned by key prefix.
+		case filters.GraffitiPrefix:
+		case filters.AttesterIndex:
 		default:
 			return nil, fmt.Errorf("filter criterion %v not supported for blocks", k)
 		}
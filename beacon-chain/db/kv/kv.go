@@ -51,6 +51,9 @@ var blockedBuckets = [][]byte{
 // Config for the bolt db kv store.
 type Config struct {
 	InitialMMapSize int
+	// MetricsRegisterer registers the metrics of the store, the default prometheus
+	// registerer if not set.
+	MetricsRegisterer prometheus.Registerer
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -61,6 +64,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	metricsRegisterer   prometheus.Registerer
 	ctx                 context.Context
 }
 
@@ -111,12 +115,17 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		return nil, err
 	}
 
+	registerer := config.MetricsRegisterer
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
 	kv := &Store{
 		db:                  boltDB,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorIndexCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		metricsRegisterer:   registerer,
 		ctx:                 ctx,
 	}
 
@@ -132,7 +141,12 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			chainMetadataBucket,
 			checkpointBucket,
 			powchainBucket,
+			operationPoolBucket,
 			stateSummaryBucket,
+			validatorsBucket,
+			validatorRefCountsBucket,
+			stateValidatorsBucket,
+			stateDiffsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -143,6 +157,10 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			stateSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			blockProposerIndicesBucket,
+			blockGraffitiIndicesBucket,
+			blockAttesterIndicesBucket,
+			blockAttestersBucket,
 			// New State Management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
@@ -152,7 +170,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		return nil, err
 	}
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	err = kv.metricsRegisterer.Register(createBoltCollector(kv.db))
 
 	return kv, err
 }
@@ -162,7 +180,7 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.metricsRegisterer.Unregister(createBoltCollector(s.db))
 	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
@@ -171,7 +189,7 @@ func (s *Store) ClearDB() error {
 
 // Close closes the underlying BoltDB database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.metricsRegisterer.Unregister(createBoltCollector(s.db))
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
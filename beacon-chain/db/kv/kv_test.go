@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -16,3 +17,17 @@ func setupDB(t testing.TB) *Store {
 	})
 	return db
 }
+
+func TestStore_Close_UnregistersMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	dir := t.TempDir()
+	db, err := NewKVStore(context.Background(), dir, &Config{MetricsRegisterer: registry})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// The metrics of the closed store were removed from the registry, so that a store
+	// reopened with the same registry can register them again.
+	db, err = NewKVStore(context.Background(), dir, &Config{MetricsRegisterer: registry})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
@@ -13,6 +13,8 @@ type migration func(*bolt.Tx) error
 var migrations = []migration{
 	migrateArchivedIndex,
 	migrateBlockSlotIndex,
+	migrateStateValidators,
+	migrateBlockIndices,
 }
 
 // RunMigrations defined in the migrations array.
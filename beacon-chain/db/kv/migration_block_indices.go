@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationBlockIndices0Key = []byte("block_indices_0")
+
+// migrateBlockIndices backfills the proposer and graffiti indices of the blocks saved while block
+// indices were disabled. The attester index cannot be backfilled, as it requires the committees
+// of the states of the blocks. While block indices are disabled, the migration is marked as not
+// completed, for the blocks saved meanwhile to be indexed once they are enabled again.
+func migrateBlockIndices(tx *bolt.Tx) error {
+	mb := tx.Bucket(migrationsBucket)
+	if !featureconfig.Get().EnableBlockIndices {
+		return mb.Delete(migrationBlockIndices0Key)
+	}
+	if b := mb.Get(migrationBlockIndices0Key); bytes.Equal(b, migrationCompleted) {
+		return nil // Migration already completed.
+	}
+
+	ctx := context.Background()
+	if err := tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+		// Skip the head and genesis block root keys.
+		if len(k) != 32 {
+			return nil
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, v, blk); err != nil {
+			return err
+		}
+		indicesByBucket := map[string][]byte{
+			string(blockProposerIndicesBucket): bytesutil.Uint64ToBytesBigEndian(uint64(blk.Block.ProposerIndex)),
+		}
+		if err := updateValueForIndices(ctx, indicesByBucket, k, tx); err != nil {
+			return err
+		}
+		return saveBlockGraffitiIndex(tx, blk.Block, k)
+	}); err != nil {
+		return err
+	}
+
+	return mb.Put(migrationBlockIndices0Key, migrationCompleted)
+}
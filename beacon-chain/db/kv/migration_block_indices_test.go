@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_migrateBlockIndices(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	b1, r1 := indexedBlock(t, 1, 3, "prysm")
+	b2, r2 := indexedBlock(t, 2, 3, "teku")
+	require.NoError(t, db.SaveBlock(ctx, b1))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, r1))
+
+	// Disabled block indices leave the migration not completed.
+	require.NoError(t, db.db.Update(migrateBlockIndices))
+	assert.Equal(t, 0, bucketKeyCount(t, db, blockProposerIndicesBucket))
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	require.NoError(t, db.SaveBlock(ctx, b2))
+	require.NoError(t, db.db.Update(migrateBlockIndices))
+
+	roots, err := db.BlockRoots(ctx, filters.NewFilter().SetProposerIndex(3))
+	require.NoError(t, err)
+	assert.DeepEqual(t, sortedRoots([][32]byte{r1, r2}), sortedRoots(roots))
+	roots, err = db.BlockRoots(ctx, filters.NewFilter().SetGraffitiPrefix([]byte("prysm")))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r1}, roots)
+	require.NoError(t, db.db.View(func(tx *bbolt.Tx) error {
+		assert.DeepEqual(t, migrationCompleted, tx.Bucket(migrationsBucket).Get(migrationBlockIndices0Key))
+		return nil
+	}))
+
+	resetCfg()
+	require.NoError(t, db.db.Update(migrateBlockIndices))
+	require.NoError(t, db.db.View(func(tx *bbolt.Tx) error {
+		assert.Equal(t, 0, len(tx.Bucket(migrationsBucket).Get(migrationBlockIndices0Key)))
+		return nil
+	}))
+}
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationStateValidators0Key = []byte("state_validators_0")
+
+// migrateStateValidators moves the validators of the states saved with them into the
+// validators bucket, shared by all states.
+func migrateStateValidators(tx *bolt.Tx) error {
+	mb := tx.Bucket(migrationsBucket)
+	if b := mb.Get(migrationStateValidators0Key); bytes.Equal(b, migrationCompleted) {
+		return nil // Migration already completed.
+	}
+
+	ctx := context.Background()
+	bkt := tx.Bucket(stateBucket)
+	refsBkt := tx.Bucket(stateValidatorsBucket)
+
+	// The state bucket cannot be modified while iterating over it, so the roots of the
+	// states to migrate are collected first.
+	var roots [][]byte
+	if err := bkt.ForEach(func(k, _ []byte) error {
+		if refsBkt.Get(k) == nil {
+			roots = append(roots, bytesutil.SafeCopyBytes(k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, root := range roots {
+		st, err := createState(ctx, bkt.Get(root))
+		if err != nil {
+			return errors.Wrapf(err, "could not decode state %#x", root)
+		}
+		enc, vals, err := encodeStateWithoutValidators(ctx, st)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(root, enc); err != nil {
+			return err
+		}
+		if err := saveStateValidators(tx, root, vals); err != nil {
+			return err
+		}
+	}
+
+	return mb.Put(migrationStateValidators0Key, migrationCompleted)
+}
@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"go.etcd.io/bbolt"
+)
+
+func Test_migrateStateValidators(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, db *Store)
+		eval  func(t *testing.T, db *Store)
+	}{
+		{
+			name: "only runs once",
+			setup: func(t *testing.T, db *Store) {
+				saveLegacyState(t, db, stateWithValidators(t, 1, 16), [32]byte{'A'})
+				require.NoError(t, db.db.Update(func(tx *bbolt.Tx) error {
+					return tx.Bucket(migrationsBucket).Put(migrationStateValidators0Key, migrationCompleted)
+				}))
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 0, bucketKeyCount(t, db, validatorsBucket))
+				assert.Equal(t, 0, bucketKeyCount(t, db, stateValidatorsBucket))
+			},
+		},
+		{
+			name: "migrates legacy states",
+			setup: func(t *testing.T, db *Store) {
+				saveLegacyState(t, db, stateWithValidators(t, 1, 16), [32]byte{'A'})
+				saveLegacyState(t, db, stateWithValidators(t, 2, 32), [32]byte{'B'})
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 32, bucketKeyCount(t, db, validatorsBucket))
+				assert.Equal(t, 2, bucketKeyCount(t, db, stateValidatorsBucket))
+				saved, err := db.State(context.Background(), [32]byte{'A'})
+				require.NoError(t, err)
+				assert.DeepSSZEqual(t, stateWithValidators(t, 1, 16).ToProtoUnsafe(), saved.ToProtoUnsafe())
+				saved, err = db.State(context.Background(), [32]byte{'B'})
+				require.NoError(t, err)
+				assert.DeepSSZEqual(t, stateWithValidators(t, 2, 32).ToProtoUnsafe(), saved.ToProtoUnsafe())
+			},
+		},
+		{
+			name: "keeps migrated states",
+			setup: func(t *testing.T, db *Store) {
+				require.NoError(t, db.SaveState(context.Background(), stateWithValidators(t, 1, 16), [32]byte{'A'}))
+				saveLegacyState(t, db, stateWithValidators(t, 2, 16), [32]byte{'B'})
+			},
+			eval: func(t *testing.T, db *Store) {
+				assert.Equal(t, 16, bucketKeyCount(t, db, validatorsBucket))
+				require.NoError(t, db.db.View(func(tx *bbolt.Tx) error {
+					// Both states reference the same records.
+					return tx.Bucket(validatorRefCountsBucket).ForEach(func(_, v []byte) error {
+						assert.DeepEqual(t, []byte{0, 0, 0, 0, 0, 0, 0, 2}, v)
+						return nil
+					})
+				}))
+				saved, err := db.State(context.Background(), [32]byte{'A'})
+				require.NoError(t, err)
+				assert.DeepSSZEqual(t, stateWithValidators(t, 1, 16).ToProtoUnsafe(), saved.ToProtoUnsafe())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			tt.setup(t, db)
+			assert.NoError(t, db.db.Update(migrateStateValidators), "migrateStateValidators(tx) error")
+			tt.eval(t, db)
+		})
+	}
+}
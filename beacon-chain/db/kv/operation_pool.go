@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveOperationPool saves a snapshot of the pending operations of the beacon node's
+// pools, replacing any previously saved snapshot.
+func (s *Store) SaveOperationPool(ctx context.Context, pool *db.OperationPool) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPool")
+	defer span.End()
+
+	if pool == nil {
+		err := errors.New("cannot save nil operation pool")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	enc, err := encode(ctx, pool)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationPoolBucket)
+		return bkt.Put(operationPoolKey, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// OperationPool retrieves the last saved snapshot of the operation pools. It returns
+// nil if no snapshot was saved.
+func (s *Store) OperationPool(ctx context.Context) (*db.OperationPool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OperationPool")
+	defer span.End()
+
+	var pool *db.OperationPool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationPoolBucket)
+		enc := bkt.Get(operationPoolKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		pool = &db.OperationPool{}
+		return decode(ctx, enc, pool)
+	})
+	return pool, err
+}
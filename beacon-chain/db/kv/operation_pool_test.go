@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_OperationPool_CanSaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+
+	pool, err := store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.OperationPool)(nil), pool, "Expected no operation pool before saving one")
+
+	want := &db.OperationPool{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 10}, Signature: make([]byte, 96)},
+		},
+		AggregatedAttestations: []*ethpb.Attestation{
+			testutil.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3}}),
+		},
+	}
+	require.NoError(t, store.SaveOperationPool(ctx, want))
+	pool, err = store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, pool)
+
+	// A new snapshot replaces the previous one.
+	want = &db.OperationPool{}
+	require.NoError(t, store.SaveOperationPool(ctx, want))
+	pool, err = store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, pool)
+}
+
+func TestStore_SaveOperationPool_Nil(t *testing.T) {
+	store := setupDB(t)
+	assert.ErrorContains(t, "cannot save nil operation pool", store.SaveOperationPool(context.Background(), nil))
+}
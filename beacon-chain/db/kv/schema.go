@@ -17,6 +17,16 @@ var (
 	chainMetadataBucket     = []byte("chain-metadata")
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
+	operationPoolBucket     = []byte("operation-pool")
+
+	// Validator records of states, stored once by the hash of their SSZ encoding and shared
+	// by all the states referencing them.
+	validatorsBucket         = []byte("validators")
+	validatorRefCountsBucket = []byte("validator-ref-counts")
+	stateValidatorsBucket    = []byte("state-validators")
+
+	// Diffs of the states between archived points from their base states.
+	stateDiffsBucket = []byte("state-diffs")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -34,6 +44,14 @@ var (
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 
+	// Optional block indices buckets, maintained when block indices are enabled. The graffiti and
+	// attester indices keys are the graffiti or the validator index followed by the block root. The
+	// attesters bucket holds the attester indices of each block root.
+	blockProposerIndicesBucket = []byte("block-proposer-indices")
+	blockGraffitiIndicesBucket = []byte("block-graffiti-indices")
+	blockAttesterIndicesBucket = []byte("block-attester-indices")
+	blockAttestersBucket       = []byte("block-attesters")
+
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
@@ -41,6 +59,7 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	operationPoolKey          = []byte("operation-pool")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
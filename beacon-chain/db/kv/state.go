@@ -21,19 +21,17 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconSta
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
 	var st *pb.BeaconState
-	enc, err := s.stateBytes(ctx, blockRoot)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		st, err = stateByRoot(ctx, tx, blockRoot[:])
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	if len(enc) == 0 {
+	if st == nil {
 		return nil, nil
 	}
-
-	st, err = createState(ctx, enc)
-	if err != nil {
-		return nil, err
-	}
 	return state.InitializeFromProtoUnsafe(st)
 }
 
@@ -48,14 +46,8 @@ func (s *Store) GenesisState(ctx context.Context) (*state.BeaconState, error) {
 		bucket := tx.Bucket(blocksBucket)
 		genesisBlockRoot := bucket.Get(genesisBlockRootKey)
 
-		bucket = tx.Bucket(stateBucket)
-		enc := bucket.Get(genesisBlockRoot)
-		if enc == nil {
-			return nil
-		}
-
 		var err error
-		st, err = createState(ctx, enc)
+		st, err = stateByRoot(ctx, tx, genesisBlockRoot)
 		return err
 	})
 	if err != nil {
@@ -84,8 +76,9 @@ func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blo
 	}
 	var err error
 	multipleEncs := make([][]byte, len(states))
+	multipleVals := make([]*encodedValidators, len(states))
 	for i, st := range states {
-		multipleEncs[i], err = encode(ctx, st.InnerStateUnsafe())
+		multipleEncs[i], multipleVals[i], err = encodeStateWithoutValidators(ctx, st.ToProtoUnsafe())
 		if err != nil {
 			return err
 		}
@@ -101,6 +94,9 @@ func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blo
 			if err := bucket.Put(rt[:], multipleEncs[i]); err != nil {
 				return err
 			}
+			if err := saveStateValidators(tx, rt[:], multipleVals[i]); err != nil {
+				return errors.Wrap(err, "could not save state validators")
+			}
 		}
 		return nil
 	})
@@ -152,7 +148,10 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
 
-		return bkt.Delete(blockRoot[:])
+		if err := bkt.Delete(blockRoot[:]); err != nil {
+			return err
+		}
+		return deleteStateValidators(tx, blockRoot[:])
 	})
 }
 
@@ -179,6 +178,26 @@ func createState(ctx context.Context, enc []byte) (*pb.BeaconState, error) {
 	return protoState, nil
 }
 
+// stateByRoot returns the state of the block root with its validators, or nil if there is none.
+func stateByRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (*pb.BeaconState, error) {
+	enc := tx.Bucket(stateBucket).Get(blockRoot)
+	if enc == nil {
+		return nil, nil
+	}
+	st, err := createState(ctx, enc)
+	if err != nil {
+		return nil, err
+	}
+	validators, ok, err := stateValidators(ctx, tx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		st.Validators = validators
+	}
+	return st, nil
+}
+
 // HasState checks if a state by root exists in the db.
 func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateBytes")
@@ -0,0 +1,70 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the diff of the state of the block root from its base state.
+func (s *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *db.StateDiff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if diff == nil {
+		err := errors.New("cannot save nil state diff")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	enc, err := encode(ctx, diff)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffsBucket)
+		return bkt.Put(blockRoot[:], enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// StateDiff retrieves the diff of the state of the block root from its base state. It returns
+// nil if no diff was saved for the block root.
+func (s *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*db.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff *db.StateDiff
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffsBucket)
+		enc := bkt.Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		diff = &db.StateDiff{}
+		return decode(ctx, enc, diff)
+	})
+	return diff, err
+}
+
+// HasStateDiff checks if the diff of the state of the block root exists in the db.
+func (s *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+
+	var exists bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffsBucket)
+		exists = bkt.Get(blockRoot[:]) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_StateDiff_CanSaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+	r := [32]byte{'A'}
+
+	assert.Equal(t, false, store.HasStateDiff(ctx, r))
+	diff, err := store.StateDiff(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.StateDiff)(nil), diff, "Expected no state diff before saving one")
+
+	want := &db.StateDiff{
+		BaseRoot:         []byte{'B'},
+		State:            &pb.BeaconState{Slot: 3},
+		BlockRoots:       &db.SparseRoots{Indices: []uint64{1}, Roots: [][]byte{make([]byte, 32)}},
+		ValidatorIndices: []uint64{2},
+		BalanceDeltas:    []int64{-1, 0, 1},
+	}
+	require.NoError(t, store.SaveStateDiff(ctx, r, want))
+	assert.Equal(t, true, store.HasStateDiff(ctx, r))
+	diff, err = store.StateDiff(ctx, r)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, diff)
+
+	assert.ErrorContains(t, "cannot save nil state diff", store.SaveStateDiff(ctx, r, nil))
+}
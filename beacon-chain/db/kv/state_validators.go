@@ -0,0 +1,170 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Validator records barely change between the states saved to the db, so they are not encoded
+// with the states. Each record is stored once in the validators bucket, keyed by the hash of
+// its SSZ encoding, and the state validators bucket holds, by block root, the keys of the
+// validators of the state in registry order. Records are reference counted, so they can be
+// deleted with the last state referencing them.
+//
+// States saved before validators were stored separately have no entry in the state validators
+// bucket and hold their validators, until migrated by migrateStateValidators.
+
+// stateValidatorsVersion prefixes the list of validator keys of a state.
+const stateValidatorsVersion = byte(0)
+
+// encodedValidators holds the keys of the validators of a state, in registry order, and the
+// snappy compressed SSZ encoding of every distinct record.
+type encodedValidators struct {
+	keys    [][32]byte
+	records map[[32]byte][]byte
+}
+
+// encodeStateWithoutValidators encodes a state without its validators, which are encoded separately.
+func encodeStateWithoutValidators(ctx context.Context, st *pb.BeaconState) ([]byte, *encodedValidators, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.encodeStateWithoutValidators")
+	defer span.End()
+	if st == nil {
+		return nil, nil, errors.New("nil state")
+	}
+
+	vals := &encodedValidators{
+		keys:    make([][32]byte, len(st.Validators)),
+		records: make(map[[32]byte][]byte, len(st.Validators)),
+	}
+	for i, v := range st.Validators {
+		enc, err := v.MarshalSSZ()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not encode validator %d", i)
+		}
+		key := hashutil.Hash(enc)
+		if _, ok := vals.records[key]; !ok {
+			vals.records[key] = snappy.Encode(nil, enc)
+		}
+		vals.keys[i] = key
+	}
+
+	stripped := *st
+	stripped.Validators = nil
+	enc, err := encode(ctx, &stripped)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, vals, nil
+}
+
+// saveStateValidators stores the validators of the state of the block root, replacing the
+// references to the validators it previously had, if any.
+func saveStateValidators(tx *bolt.Tx, blockRoot []byte, vals *encodedValidators) error {
+	if err := deleteStateValidators(tx, blockRoot); err != nil {
+		return err
+	}
+	recordsBkt := tx.Bucket(validatorsBucket)
+	countsBkt := tx.Bucket(validatorRefCountsBucket)
+	refs := make([]byte, 1, 1+len(vals.keys)*32)
+	refs[0] = stateValidatorsVersion
+	for _, key := range vals.keys {
+		var count uint64
+		if enc := countsBkt.Get(key[:]); enc != nil {
+			count = bytesutil.BytesToUint64BigEndian(enc)
+		} else if err := recordsBkt.Put(key[:], vals.records[key]); err != nil {
+			return err
+		}
+		if err := countsBkt.Put(key[:], bytesutil.Uint64ToBytesBigEndian(count+1)); err != nil {
+			return err
+		}
+		refs = append(refs, key[:]...)
+	}
+	return tx.Bucket(stateValidatorsBucket).Put(blockRoot, refs)
+}
+
+// deleteStateValidators deletes the references of the state of the block root to its validators,
+// and the validator records no other state references.
+func deleteStateValidators(tx *bolt.Tx, blockRoot []byte) error {
+	refsBkt := tx.Bucket(stateValidatorsBucket)
+	// The keys are copied, as they are read while modifying the db.
+	keys, err := stateValidatorKeys(bytesutil.SafeCopyBytes(refsBkt.Get(blockRoot)))
+	if err != nil || keys == nil {
+		return err
+	}
+	recordsBkt := tx.Bucket(validatorsBucket)
+	countsBkt := tx.Bucket(validatorRefCountsBucket)
+	for _, key := range keys {
+		count := bytesutil.BytesToUint64BigEndian(countsBkt.Get(key))
+		if count > 1 {
+			if err := countsBkt.Put(key, bytesutil.Uint64ToBytesBigEndian(count-1)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := countsBkt.Delete(key); err != nil {
+			return err
+		}
+		if err := recordsBkt.Delete(key); err != nil {
+			return err
+		}
+	}
+	return refsBkt.Delete(blockRoot)
+}
+
+// stateValidators returns the validators of the state of the block root, and false if the
+// state was saved with its validators.
+func stateValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte) ([]*ethpb.Validator, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateValidators")
+	defer span.End()
+
+	keys, err := stateValidatorKeys(tx.Bucket(stateValidatorsBucket).Get(blockRoot))
+	if err != nil || keys == nil {
+		return nil, false, err
+	}
+	recordsBkt := tx.Bucket(validatorsBucket)
+	validators := make([]*ethpb.Validator, len(keys))
+	for i, key := range keys {
+		enc := recordsBkt.Get(key)
+		if enc == nil {
+			return nil, false, fmt.Errorf("missing record %#x of validator %d", key, i)
+		}
+		dec, err := snappy.Decode(nil, enc)
+		if err != nil {
+			return nil, false, err
+		}
+		v := &ethpb.Validator{}
+		if err := v.UnmarshalSSZ(dec); err != nil {
+			return nil, false, errors.Wrapf(err, "could not decode validator %d", i)
+		}
+		validators[i] = v
+	}
+	return validators, true, nil
+}
+
+// stateValidatorKeys splits the list of validator keys of a state, returning nil if there is none.
+func stateValidatorKeys(refs []byte) ([][]byte, error) {
+	if refs == nil {
+		return nil, nil
+	}
+	if len(refs) == 0 || refs[0] != stateValidatorsVersion {
+		return nil, errors.New("unknown format of state validators")
+	}
+	refs = refs[1:]
+	if len(refs)%32 != 0 {
+		return nil, fmt.Errorf("state validators length %d is not a multiple of 32", len(refs))
+	}
+	keys := make([][]byte, len(refs)/32)
+	for i := range keys {
+		keys[i] = refs[i*32 : (i+1)*32]
+	}
+	return keys, nil
+}
@@ -0,0 +1,250 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_StateValidators_Deduplicated(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st1 := stateWithValidators(t, 1, 64)
+	st2 := stateWithValidators(t, 2, 64)
+	v, err := st2.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	v.EffectiveBalance -= params.BeaconConfig().EffectiveBalanceIncrement
+	require.NoError(t, st2.UpdateValidatorAtIndex(3, v))
+
+	r1, r2 := [32]byte{'A'}, [32]byte{'B'}
+	require.NoError(t, db.SaveStates(ctx, []*state.BeaconState{st1, st2}, [][32]byte{r1, r2}))
+	assert.Equal(t, 65, bucketKeyCount(t, db, validatorsBucket))
+
+	saved, err := db.State(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st1.ToProtoUnsafe(), saved.ToProtoUnsafe())
+	saved, err = db.State(ctx, r2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.ToProtoUnsafe(), saved.ToProtoUnsafe())
+
+	// Deleting a state only deletes the validator records no other state references.
+	require.NoError(t, db.DeleteState(ctx, r1))
+	assert.Equal(t, 64, bucketKeyCount(t, db, validatorsBucket))
+	assert.Equal(t, 64, bucketKeyCount(t, db, validatorRefCountsBucket))
+	saved, err = db.State(ctx, r2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.ToProtoUnsafe(), saved.ToProtoUnsafe())
+
+	require.NoError(t, db.DeleteState(ctx, r2))
+	assert.Equal(t, 0, bucketKeyCount(t, db, validatorsBucket))
+	assert.Equal(t, 0, bucketKeyCount(t, db, validatorRefCountsBucket))
+	assert.Equal(t, 0, bucketKeyCount(t, db, stateValidatorsBucket))
+}
+
+func TestStore_StateValidators_Overwrite(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	require.NoError(t, db.SaveState(ctx, stateWithValidators(t, 1, 16), r))
+	st := stateWithValidators(t, 1, 8)
+	require.NoError(t, db.SaveState(ctx, st, r))
+	assert.Equal(t, 8, bucketKeyCount(t, db, validatorsBucket))
+
+	saved, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.ToProtoUnsafe(), saved.ToProtoUnsafe())
+}
+
+func TestStore_StateValidators_DuplicateRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// Identical records in a state are stored once, and referenced by each validator.
+	st := stateWithValidators(t, 1, 1)
+	v, err := st.ValidatorAtIndex(0)
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{v, v, v}))
+	r := [32]byte{'A'}
+	require.NoError(t, db.SaveState(ctx, st, r))
+	assert.Equal(t, 1, bucketKeyCount(t, db, validatorsBucket))
+
+	saved, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.ToProtoUnsafe(), saved.ToProtoUnsafe())
+
+	require.NoError(t, db.DeleteState(ctx, r))
+	assert.Equal(t, 0, bucketKeyCount(t, db, validatorsBucket))
+	assert.Equal(t, 0, bucketKeyCount(t, db, validatorRefCountsBucket))
+}
+
+func TestStore_StateValidators_LegacyFormat(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	st := stateWithValidators(t, 1, 16)
+	saveLegacyState(t, db, st, r)
+
+	saved, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.ToProtoUnsafe(), saved.ToProtoUnsafe())
+
+	require.NoError(t, db.DeleteState(ctx, r))
+	assert.Equal(t, false, db.HasState(ctx, r))
+}
+
+// stateWithValidators returns a state at the slot with n validators, which only depend on n.
+func stateWithValidators(t testing.TB, slot types.Slot, n int) *state.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	validators := make([]*ethpb.Validator, n)
+	balances := make([]uint64, n)
+	for i := range validators {
+		// Keys and withdrawal credentials do not compress, like those of real validators.
+		h1 := hashutil.Hash(bytesutil.Bytes8(uint64(i)))
+		h2 := hashutil.Hash(h1[:])
+		h3 := hashutil.Hash(h2[:])
+		validators[i] = &ethpb.Validator{
+			PublicKey:                  append(h1[:], h2[:16]...),
+			WithdrawalCredentials:      h3[:],
+			EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+			ActivationEligibilityEpoch: params.BeaconConfig().FarFutureEpoch,
+			ActivationEpoch:            0,
+			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	return st
+}
+
+// saveLegacyState saves a state with its validators, as before they were stored separately.
+func saveLegacyState(t testing.TB, db *Store, st *state.BeaconState, blockRoot [32]byte) {
+	enc, err := encode(context.Background(), st.ToProtoUnsafe())
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		indicesByBucket := createStateIndicesFromStateSlot(context.Background(), st.Slot())
+		if err := updateValueForIndices(context.Background(), indicesByBucket, blockRoot[:], tx); err != nil {
+			return err
+		}
+		return tx.Bucket(stateBucket).Put(blockRoot[:], enc)
+	}))
+}
+
+// Archived states share almost all of their validator records. The benchmarks save and read
+// states of a registry in which 1% of the validators change between states, and report the
+// bytes stored per state against the size of the same states saved with their validators.
+const (
+	benchmarkValidatorCount  = 16384
+	benchmarkChangedPerState = benchmarkValidatorCount / 100
+	benchmarkReadStateCount  = 8
+)
+
+func BenchmarkStore_SaveState(b *testing.B) {
+	db := setupDB(b)
+	ctx := context.Background()
+	st := stateWithValidators(b, 0, benchmarkValidatorCount)
+	var legacySize int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		changeValidators(b, st, i)
+		enc, err := encode(ctx, st.ToProtoUnsafe())
+		require.NoError(b, err)
+		legacySize += len(enc) + 32
+		b.StartTimer()
+		require.NoError(b, db.SaveState(ctx, st, bytesutil.ToBytes32(bytesutil.Uint64ToBytesBigEndian(uint64(i)))))
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(storedStatesSize(b, db))/float64(b.N), "bytes/state")
+	b.ReportMetric(float64(legacySize)/float64(b.N), "legacy-bytes/state")
+}
+
+func BenchmarkStore_State(b *testing.B) {
+	db := setupDB(b)
+	roots := saveBenchmarkStates(b, db, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := db.State(context.Background(), roots[i%len(roots)])
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkStore_State_Legacy(b *testing.B) {
+	db := setupDB(b)
+	roots := saveBenchmarkStates(b, db, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := db.State(context.Background(), roots[i%len(roots)])
+		require.NoError(b, err)
+	}
+}
+
+func saveBenchmarkStates(b *testing.B, db *Store, legacy bool) [][32]byte {
+	st := stateWithValidators(b, 0, benchmarkValidatorCount)
+	roots := make([][32]byte, benchmarkReadStateCount)
+	for i := range roots {
+		changeValidators(b, st, i)
+		roots[i] = bytesutil.ToBytes32(bytesutil.Uint64ToBytesBigEndian(uint64(i)))
+		if legacy {
+			saveLegacyState(b, db, st, roots[i])
+		} else {
+			require.NoError(b, db.SaveState(context.Background(), st, roots[i]))
+		}
+	}
+	return roots
+}
+
+// changeValidators advances the state to the slot and changes the effective balance of
+// benchmarkChangedPerState of its validators.
+func changeValidators(b *testing.B, st *state.BeaconState, slot int) {
+	require.NoError(b, st.SetSlot(types.Slot(slot)))
+	for j := 0; j < benchmarkChangedPerState; j++ {
+		idx := types.ValidatorIndex((slot*benchmarkChangedPerState + j) % benchmarkValidatorCount)
+		v, err := st.ValidatorAtIndex(idx)
+		require.NoError(b, err)
+		v.EffectiveBalance -= params.BeaconConfig().EffectiveBalanceIncrement
+		require.NoError(b, st.UpdateValidatorAtIndex(idx, v))
+	}
+}
+
+// storedStatesSize returns the size of the keys and values holding the states in the db.
+func storedStatesSize(t testing.TB, db *Store) int {
+	var size int
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{stateBucket, validatorsBucket, validatorRefCountsBucket, stateValidatorsBucket} {
+			if err := tx.Bucket(bucket).ForEach(func(k, v []byte) error {
+				size += len(k) + len(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	return size
+}
+
+func bucketKeyCount(t testing.TB, db *Store, bucket []byte) int {
+	var count int
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket(bucket).Stats().KeyN
+		return nil
+	}))
+	return count
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"go.opencensus.io/trace"
+)
+
+// LastArchivedSlot from the db.
+func (s *Store) LastArchivedSlot(ctx context.Context) (types.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastArchivedSlot")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slot, _ := s.lastArchivedSlot()
+	return slot, nil
+}
+
+// LastArchivedRoot from the db.
+func (s *Store) LastArchivedRoot(ctx context.Context) [32]byte {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastArchivedRoot")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slot, ok := s.lastArchivedSlot()
+	if !ok {
+		return [32]byte{}
+	}
+	return s.stateSlotIndices[slot][0]
+}
+
+// ArchivedPointRoot returns the block root of an archived point from the DB.
+// This is essential for cold state management and to restore a cold state.
+func (s *Store) ArchivedPointRoot(ctx context.Context, slot types.Slot) [32]byte {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedPointRoot")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	roots := s.stateSlotIndices[slot]
+	if len(roots) == 0 {
+		return [32]byte{}
+	}
+	return roots[0]
+}
+
+// HasArchivedPoint returns true if an archived point exists in DB.
+func (s *Store) HasArchivedPoint(ctx context.Context, slot types.Slot) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasArchivedPoint")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.stateSlotIndices[slot]
+	return ok
+}
+
+// lastArchivedSlot returns the highest slot of the saved states.
+func (s *Store) lastArchivedSlot() (types.Slot, bool) {
+	var last types.Slot
+	found := false
+	for slot := range s.stateSlotIndices {
+		if !found || slot > last {
+			last = slot
+			found = true
+		}
+	}
+	return last, found
+}
@@ -0,0 +1,528 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// used to represent errors for inconsistent slot ranges.
+var errInvalidSlotRange = errors.New("invalid end slot and start slot provided")
+
+// Block retrieval by root.
+func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Block")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.block(blockRoot), nil
+}
+
+// HeadBlock returns the latest canonical block in eth2.
+func (s *Store) HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HeadBlock")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.headBlockRoot == [32]byte{} {
+		return nil, nil
+	}
+	return s.block(s.headBlockRoot), nil
+}
+
+// Blocks retrieves a list of beacon blocks and its respective roots by filter criteria.
+func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.SignedBeaconBlock, [][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Blocks")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	roots, err := s.blockRootsByFilter(ctx, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	blocks := make([]*ethpb.SignedBeaconBlock, len(roots))
+	for i, root := range roots {
+		blocks[i] = s.block(root)
+	}
+	return blocks, roots, nil
+}
+
+// BlockRoots retrieves a list of beacon block roots by filter criteria.
+func (s *Store) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockRoots")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	roots, err := s.blockRootsByFilter(ctx, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block roots")
+	}
+	return roots, nil
+}
+
+// HasBlock checks if a block by root exists in the db.
+func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasBlock")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.blocks[blockRoot]
+	return ok
+}
+
+// BlocksBySlot retrieves a list of beacon blocks and its respective roots by slot.
+func (s *Store) BlocksBySlot(ctx context.Context, slot types.Slot) (bool, []*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BlocksBySlot")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	roots := s.blockSlotIndices[slot]
+	blocks := make([]*ethpb.SignedBeaconBlock, len(roots))
+	for i, root := range roots {
+		blocks[i] = s.block(root)
+	}
+	return len(blocks) > 0, blocks, nil
+}
+
+// BlockRootsBySlot retrieves a list of beacon block roots by slot
+func (s *Store) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, [][32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BlockRootsBySlot")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	roots := append(make([][32]byte, 0, len(s.blockSlotIndices[slot])), s.blockSlotIndices[slot]...)
+	return len(roots) > 0, roots, nil
+}
+
+// deleteBlock by block root.
+func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.deleteBlock")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.removeBlock(blockRoot)
+	return nil
+}
+
+// deleteBlocks by block roots.
+func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.deleteBlocks")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, blockRoot := range blockRoots {
+		s.removeBlock(blockRoot)
+	}
+	return nil
+}
+
+// SaveBlock to the db.
+func (s *Store) SaveBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlock")
+	defer span.End()
+	return s.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{signed})
+}
+
+// SaveBlocks via bulk updates to the db.
+func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
+	defer span.End()
+	return s.saveBlocks(blocks, nil)
+}
+
+// SaveBlocksWithAttesterIndices saves blocks with the validator indices of the attestations each
+// of them includes, which are only known from the committees of their states. The blocks are
+// indexed by attester when block indices are enabled.
+func (s *Store) SaveBlocksWithAttesterIndices(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, attesters [][]types.ValidatorIndex) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocksWithAttesterIndices")
+	defer span.End()
+	if len(attesters) != len(blocks) {
+		return errors.New("blocks and attester indices length mismatch")
+	}
+	return s.saveBlocks(blocks, attesters)
+}
+
+// saveBlocks saves blocks, and the attester indices of each block if there are any.
+func (s *Store) saveBlocks(blocks []*ethpb.SignedBeaconBlock, attesters [][]types.ValidatorIndex) error {
+	roots := make([][32]byte, len(blocks))
+	for i, block := range blocks {
+		blockRoot, err := block.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = blockRoot
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, block := range blocks {
+		if _, ok := s.blocks[roots[i]]; ok {
+			continue
+		}
+		s.addBlock(roots[i], proto.Clone(block).(*ethpb.SignedBeaconBlock))
+		if attesters != nil && featureconfig.Get().EnableBlockIndices {
+			s.addBlockAttesters(roots[i], attesters[i])
+		}
+	}
+	return nil
+}
+
+// SaveHeadBlockRoot to the db.
+func (s *Store) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveHeadBlockRoot")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(blockRoot) {
+		return errors.New("no state or state summary found with head block root")
+	}
+	s.headBlockRoot = blockRoot
+	return nil
+}
+
+// GenesisBlock retrieves the genesis block of the beacon chain.
+func (s *Store) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.GenesisBlock")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.block(s.genesisBlockRoot), nil
+}
+
+// SaveGenesisBlockRoot to the db.
+func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveGenesisBlockRoot")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.genesisBlockRoot = blockRoot
+	return nil
+}
+
+// HighestSlotBlocksBelow returns the block with the highest slot below the input slot from the db.
+func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotBlocksBelow")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var blk *ethpb.SignedBeaconBlock
+	if best, ok := highestSlotBelow(s.blockSlotIndices, slot); ok {
+		blk = s.block(s.blockSlotIndices[best][0])
+	}
+	if blk == nil {
+		blk = s.block(s.genesisBlockRoot)
+	}
+	return []*ethpb.SignedBeaconBlock{blk}, nil
+}
+
+// block returns a copy of the block of the root, or nil if there is none.
+func (s *Store) block(blockRoot [32]byte) *ethpb.SignedBeaconBlock {
+	blk, ok := s.blocks[blockRoot]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+}
+
+// addBlock saves a block and adds it to the block indices.
+func (s *Store) addBlock(blockRoot [32]byte, signed *ethpb.SignedBeaconBlock) {
+	s.blocks[blockRoot] = signed
+	block := signed.Block
+	s.blockSlotIndices[block.Slot] = appendRoot(s.blockSlotIndices[block.Slot], blockRoot)
+	if len(block.ParentRoot) > 0 {
+		parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+		s.blockParentRootIndices[parentRoot] = appendRoot(s.blockParentRootIndices[parentRoot], blockRoot)
+	}
+	if featureconfig.Get().EnableBlockIndices {
+		s.blockProposerIndices[block.ProposerIndex] = appendRoot(s.blockProposerIndices[block.ProposerIndex], blockRoot)
+		if block.Body != nil {
+			graffiti := bytesutil.ToBytes32(block.Body.Graffiti)
+			s.blockGraffitiIndices[graffiti] = appendRoot(s.blockGraffitiIndices[graffiti], blockRoot)
+		}
+	}
+}
+
+// addBlockAttesters indexes a block by the validator indices of the attestations it includes.
+func (s *Store) addBlockAttesters(blockRoot [32]byte, indices []types.ValidatorIndex) {
+	if len(indices) == 0 {
+		return
+	}
+	for _, idx := range indices {
+		s.blockAttesterIndices[idx] = appendRoot(s.blockAttesterIndices[idx], blockRoot)
+	}
+	s.blockAttesters[blockRoot] = append([]types.ValidatorIndex{}, indices...)
+}
+
+// removeBlock deletes a block and clears it from the block indices.
+func (s *Store) removeBlock(blockRoot [32]byte) {
+	signed, ok := s.blocks[blockRoot]
+	if !ok {
+		return
+	}
+	delete(s.blocks, blockRoot)
+	block := signed.Block
+	if roots := removeRoot(s.blockSlotIndices[block.Slot], blockRoot); len(roots) > 0 {
+		s.blockSlotIndices[block.Slot] = roots
+	} else {
+		delete(s.blockSlotIndices, block.Slot)
+	}
+	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+	if roots := removeRoot(s.blockParentRootIndices[parentRoot], blockRoot); len(roots) > 0 {
+		s.blockParentRootIndices[parentRoot] = roots
+	} else {
+		delete(s.blockParentRootIndices, parentRoot)
+	}
+	if roots := removeRoot(s.blockProposerIndices[block.ProposerIndex], blockRoot); len(roots) > 0 {
+		s.blockProposerIndices[block.ProposerIndex] = roots
+	} else {
+		delete(s.blockProposerIndices, block.ProposerIndex)
+	}
+	if block.Body != nil {
+		graffiti := bytesutil.ToBytes32(block.Body.Graffiti)
+		if roots := removeRoot(s.blockGraffitiIndices[graffiti], blockRoot); len(roots) > 0 {
+			s.blockGraffitiIndices[graffiti] = roots
+		} else {
+			delete(s.blockGraffitiIndices, graffiti)
+		}
+	}
+	for _, idx := range s.blockAttesters[blockRoot] {
+		if roots := removeRoot(s.blockAttesterIndices[idx], blockRoot); len(roots) > 0 {
+			s.blockAttesterIndices[idx] = roots
+		} else {
+			delete(s.blockAttesterIndices, idx)
+		}
+	}
+	delete(s.blockAttesters, blockRoot)
+}
+
+// blockRootsByFilter retrieves the block roots given the filter criteria.
+func (s *Store) blockRootsByFilter(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.blockRootsByFilter")
+	defer span.End()
+
+	// If no filter criteria are specified, return an error.
+	if f == nil {
+		return nil, errors.New("must specify a filter criteria for retrieving blocks")
+	}
+
+	indices := make([][][32]byte, 0)
+	for k, v := range f.Filters() {
+		switch k {
+		case filters.ParentRoot:
+			parentRoot, ok := v.([]byte)
+			if !ok {
+				return nil, errors.New("parent root is not []byte")
+			}
+			indices = append(indices, s.blockParentRootIndices[bytesutil.ToBytes32(parentRoot)])
+		case filters.ProposerIndex:
+			proposerIndex, ok := v.(types.ValidatorIndex)
+			if !ok {
+				return nil, errors.New("proposer index is not types.ValidatorIndex")
+			}
+			indices = append(indices, s.blockProposerIndices[proposerIndex])
+		case filters.GraffitiPrefix:
+			prefix, ok := v.([]byte)
+			if !ok {
+				return nil, errors.New("graffiti prefix is not []byte")
+			}
+			if len(prefix) > 32 {
+				return nil, errors.New("graffiti prefix is longer than 32 bytes")
+			}
+			indices = append(indices, s.blockRootsByGraffitiPrefix(prefix))
+		case filters.AttesterIndex:
+			attesterIndex, ok := v.(types.ValidatorIndex)
+			if !ok {
+				return nil, errors.New("attester index is not types.ValidatorIndex")
+			}
+			indices = append(indices, sortRoots(s.blockAttesterIndices[attesterIndex]))
+		// The following cases are handled by the slot range.
+		case filters.StartSlot:
+		case filters.EndSlot:
+		case filters.StartEpoch:
+		case filters.EndEpoch:
+		case filters.SlotStep:
+		default:
+			return nil, fmt.Errorf("filter criterion %v not supported for blocks", k)
+		}
+	}
+
+	filtersMap := f.Filters()
+	rootsBySlotRange, err := s.blockRootsBySlotRange(
+		filtersMap[filters.StartSlot],
+		filtersMap[filters.EndSlot],
+		filtersMap[filters.StartEpoch],
+		filtersMap[filters.EndEpoch],
+		filtersMap[filters.SlotStep],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with the key value store, an empty slot range does not restrict the roots of the
+	// other filter criteria.
+	roots := rootsBySlotRange
+	if len(indices) > 0 {
+		if len(rootsBySlotRange) > 0 {
+			roots = intersectRoots(append([][][32]byte{rootsBySlotRange}, indices...)...)
+		} else {
+			roots = intersectRoots(indices...)
+		}
+	}
+	return roots, nil
+}
+
+// blockRootsBySlotRange retrieves the block roots in slot order from a start slot to an end
+// slot, or the slots of a start epoch to an end epoch, every slot step.
+func (s *Store) blockRootsBySlotRange(
+	startSlotEncoded, endSlotEncoded, startEpochEncoded, endEpochEncoded, slotStepEncoded interface{},
+) ([][32]byte, error) {
+	// Return nothing when all slot parameters are missing
+	if startSlotEncoded == nil && endSlotEncoded == nil && startEpochEncoded == nil && endEpochEncoded == nil {
+		return [][32]byte{}, nil
+	}
+
+	var startSlot, endSlot types.Slot
+	var step uint64
+	var ok bool
+	if startSlot, ok = startSlotEncoded.(types.Slot); !ok {
+		startSlot = 0
+	}
+	if endSlot, ok = endSlotEncoded.(types.Slot); !ok {
+		endSlot = 0
+	}
+	if step, ok = slotStepEncoded.(uint64); !ok || step == 0 {
+		step = 1
+	}
+	startEpoch, startEpochOk := startEpochEncoded.(types.Epoch)
+	endEpoch, endEpochOk := endEpochEncoded.(types.Epoch)
+	var err error
+	if startEpochOk && endEpochOk {
+		startSlot, err = helpers.StartSlot(startEpoch)
+		if err != nil {
+			return nil, err
+		}
+		endSlot, err = helpers.StartSlot(endEpoch)
+		if err != nil {
+			return nil, err
+		}
+		endSlot = endSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	}
+	if endSlot < startSlot {
+		return nil, errInvalidSlotRange
+	}
+
+	roots := make([][32]byte, 0)
+	for _, slot := range slotsInRange(s.blockSlotIndices, startSlot, endSlot, step) {
+		roots = append(roots, s.blockSlotIndices[slot]...)
+	}
+	return roots, nil
+}
+
+// blockRootsByGraffitiPrefix retrieves the roots of the blocks whose graffiti starts with the
+// prefix, ordered by graffiti and root.
+func (s *Store) blockRootsByGraffitiPrefix(prefix []byte) [][32]byte {
+	graffitis := make([][32]byte, 0)
+	for graffiti := range s.blockGraffitiIndices {
+		if bytes.HasPrefix(graffiti[:], prefix) {
+			graffitis = append(graffitis, graffiti)
+		}
+	}
+	sort.Slice(graffitis, func(i, j int) bool {
+		return bytes.Compare(graffitis[i][:], graffitis[j][:]) < 0
+	})
+	roots := make([][32]byte, 0)
+	for _, graffiti := range graffitis {
+		roots = append(roots, sortRoots(s.blockGraffitiIndices[graffiti])...)
+	}
+	return roots
+}
+
+// slotsInRange returns the slots of the index from a start slot to an end slot, every slot
+// step, in increasing order.
+func slotsInRange(index map[types.Slot][][32]byte, startSlot, endSlot types.Slot, step uint64) []types.Slot {
+	slots := make([]types.Slot, 0)
+	steps := uint64(endSlot.SubSlot(startSlot).Div(step))
+	// Walk the range when it is shorter than the index, and scan the index otherwise.
+	if steps < uint64(len(index)) {
+		for i := uint64(0); i <= steps; i++ {
+			slot := startSlot.Add(i * step)
+			if _, ok := index[slot]; ok {
+				slots = append(slots, slot)
+			}
+		}
+		return slots
+	}
+	for slot := range index {
+		if slot < startSlot || slot > endSlot || uint64(slot.SubSlot(startSlot))%step != 0 {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	return slots
+}
+
+// highestSlotBelow returns the highest slot of the index below the slot.
+func highestSlotBelow(index map[types.Slot][][32]byte, slot types.Slot) (types.Slot, bool) {
+	var best types.Slot
+	found := false
+	for k, roots := range index {
+		if k < slot && len(roots) > 0 && (!found || k > best) {
+			best = k
+			found = true
+		}
+	}
+	return best, found
+}
+
+// intersectRoots returns the roots present in every list, in the order of the first list.
+func intersectRoots(lists ...[][32]byte) [][32]byte {
+	if len(lists) == 0 {
+		return [][32]byte{}
+	}
+	counts := make(map[[32]byte]int)
+	for _, list := range lists[1:] {
+		seen := make(map[[32]byte]bool, len(list))
+		for _, root := range list {
+			if !seen[root] {
+				seen[root] = true
+				counts[root]++
+			}
+		}
+	}
+	roots := make([][32]byte, 0)
+	for _, root := range lists[0] {
+		if counts[root] == len(lists)-1 {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// sortRoots returns a sorted copy of the roots.
+func sortRoots(roots [][32]byte) [][32]byte {
+	sorted := append(make([][32]byte, 0, len(roots)), roots...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+	return sorted
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+var errMissingStateForCheckpoint = errors.New("missing state summary for finalized root")
+
+// JustifiedCheckpoint returns the latest justified checkpoint in beacon chain.
+func (s *Store) JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.JustifiedCheckpoint")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.justifiedCheckpoint == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}, nil
+	}
+	return proto.Clone(s.justifiedCheckpoint).(*ethpb.Checkpoint), nil
+}
+
+// FinalizedCheckpoint returns the latest finalized checkpoint in beacon chain.
+func (s *Store) FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.FinalizedCheckpoint")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return proto.Clone(s.finalizedCheckpointOrDefault()).(*ethpb.Checkpoint), nil
+}
+
+// SaveJustifiedCheckpoint saves justified checkpoint in beacon chain.
+func (s *Store) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveJustifiedCheckpoint")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(bytesutil.ToBytes32(checkpoint.Root)) {
+		return errMissingStateForCheckpoint
+	}
+	s.justifiedCheckpoint = proto.Clone(checkpoint).(*ethpb.Checkpoint)
+	return nil
+}
+
+// SaveFinalizedCheckpoint saves finalized checkpoint in beacon chain.
+func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveFinalizedCheckpoint")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(bytesutil.ToBytes32(checkpoint.Root)) {
+		return errMissingStateForCheckpoint
+	}
+	checkpoint = proto.Clone(checkpoint).(*ethpb.Checkpoint)
+	if err := s.updateFinalizedBlockRoots(ctx, checkpoint); err != nil {
+		return err
+	}
+	s.finalizedCheckpoint = checkpoint
+	return nil
+}
+
+// finalizedCheckpointOrDefault returns the finalized checkpoint, or a checkpoint of the zero
+// hash if none was saved.
+func (s *Store) finalizedCheckpointOrDefault() *ethpb.Checkpoint {
+	if s.finalizedCheckpoint == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	}
+	return s.finalizedCheckpoint
+}
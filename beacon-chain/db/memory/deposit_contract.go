@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opencensus.io/trace"
+)
+
+// DepositContractAddress returns contract address is the address of
+// the deposit contract on the proof of work chain.
+func (s *Store) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DepositContractAddress")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.depositContractAddress == nil {
+		return nil, nil
+	}
+	return append([]byte{}, s.depositContractAddress...), nil
+}
+
+// SaveDepositContractAddress to the db. It returns an error if an address has been previously saved.
+func (s *Store) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.VerifyContractAddress")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.depositContractAddress != nil {
+		return fmt.Errorf("cannot override deposit contract address: %v", s.depositContractAddress)
+	}
+	s.depositContractAddress = addr.Bytes()
+	return nil
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// updateFinalizedBlockRoots indexes the finalized and canonical blocks of the new finalized
+// checkpoint, with the same algorithm as the key value store: the block roots from the
+// previous finalized epoch are de-indexed, the ancestry chain of the finalized root is walked
+// up to an indexed block or genesis, and every block of the finalized epoch is marked as
+// final. Changes are only applied once the whole update succeeds.
+func (s *Store) updateFinalizedBlockRoots(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.updateFinalizedBlockRoots")
+	defer span.End()
+
+	updates := make(map[[32]byte]*dbpb.FinalizedBlockRootContainer)
+	deleted := make(map[[32]byte]bool)
+	lookup := func(root [32]byte) (*dbpb.FinalizedBlockRootContainer, bool) {
+		if ctr, ok := updates[root]; ok {
+			return ctr, true
+		}
+		if deleted[root] {
+			return nil, false
+		}
+		ctr, ok := s.finalizedBlockRoots[root]
+		return ctr, ok
+	}
+
+	// De-index recent finalized block roots, to be re-indexed.
+	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
+	if s.previousFinalizedCheckpoint != nil {
+		previousFinalizedCheckpoint = s.previousFinalizedCheckpoint
+	}
+	blockRoots, err := s.blockRootsByFilter(ctx, filters.NewFilter().
+		SetStartEpoch(previousFinalizedCheckpoint.Epoch).
+		SetEndEpoch(checkpoint.Epoch+1),
+	)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	for _, root := range blockRoots {
+		deleted[root] = true
+	}
+
+	// Walk up the ancestry chain until we reach a block root present in the finalized block roots
+	// index or genesis block root.
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	var previousRoot []byte
+	for root != s.genesisBlockRoot {
+		current := root
+		signedBlock, ok := s.blocks[root]
+		if !ok || signedBlock.Block == nil {
+			err := fmt.Errorf("missing block in database: block root=%#x", root)
+			traceutil.AnnotateError(span, err)
+			return err
+		}
+		block := signedBlock.Block
+		updates[root] = &dbpb.FinalizedBlockRootContainer{
+			ParentRoot: block.ParentRoot,
+			ChildRoot:  previousRoot,
+		}
+
+		// Found parent, loop exit condition.
+		parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+		if parent, ok := lookup(parentRoot); ok {
+			updated := &dbpb.FinalizedBlockRootContainer{ChildRoot: current[:]}
+			if parent != nil {
+				updated.ParentRoot = parent.ParentRoot
+			}
+			updates[parentRoot] = updated
+			break
+		}
+		previousRoot = current[:]
+		root = parentRoot
+	}
+
+	// Upsert blocks from the current finalized epoch.
+	roots, err := s.blockRootsByFilter(ctx, filters.NewFilter().SetStartEpoch(checkpoint.Epoch).SetEndEpoch(checkpoint.Epoch+1))
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	for _, r := range roots {
+		if _, ok := lookup(r); ok || r == bytesutil.ToBytes32(checkpoint.Root) {
+			continue
+		}
+		updates[r] = nil
+	}
+
+	for r := range deleted {
+		delete(s.finalizedBlockRoots, r)
+	}
+	for r, ctr := range updates {
+		s.finalizedBlockRoots[r] = ctr
+	}
+	s.previousFinalizedCheckpoint = checkpoint
+	return nil
+}
+
+// IsFinalizedBlock returns true if the block root is present in the finalized block root index.
+// A beacon block root contained exists in this index if it is considered finalized and canonical.
+// Note: beacon blocks from the latest finalized epoch return true, whether or not they are
+// considered canonical in the "head view" of the beacon node.
+func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.IsFinalizedBlock")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if _, ok := s.finalizedBlockRoots[blockRoot]; ok {
+		return true
+	}
+	// Check genesis block root.
+	return s.genesisBlockRoot == blockRoot
+}
+
+// FinalizedChildBlock returns the child block of a provided finalized block. If
+// no finalized block or its respective child block exists we return with a nil
+// block.
+func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.FinalizedChildBlock")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	ctr := s.finalizedBlockRoots[blockRoot]
+	if ctr == nil || len(ctr.ChildRoot) == 0 {
+		return nil, nil
+	}
+	return s.block(bytesutil.ToBytes32(ctr.ChildRoot)), nil
+}
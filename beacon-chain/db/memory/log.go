@@ -0,0 +1,5 @@
+package memory
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "db")
@@ -0,0 +1,142 @@
+// Package memory defines an in-memory implementation of the beacon chain database, for
+// tests and ephemeral nodes such as local devnets which do not need to keep any data
+// across restarts.
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+var _ iface.Database = (*Store)(nil)
+
+var errBackupNotSupported = errors.New("an in-memory database cannot be backed up")
+
+// Store defines an implementation of the Prysm Database interface which keeps every
+// object in memory. Objects are copied when saved and when retrieved, so that callers
+// do not share them with the store, the same as with a store which encodes its objects.
+type Store struct {
+	lock sync.RWMutex
+
+	blocks                 map[[32]byte]*ethpb.SignedBeaconBlock
+	blockSlotIndices       map[types.Slot][][32]byte
+	blockParentRootIndices map[[32]byte][][32]byte
+	blockProposerIndices   map[types.ValidatorIndex][][32]byte
+	blockGraffitiIndices   map[[32]byte][][32]byte
+	blockAttesterIndices   map[types.ValidatorIndex][][32]byte
+	blockAttesters         map[[32]byte][]types.ValidatorIndex
+	genesisBlockRoot       [32]byte
+	headBlockRoot          [32]byte
+
+	states           map[[32]byte]*pb.BeaconState
+	stateSlotIndices map[types.Slot][][32]byte
+	stateSummaries   map[[32]byte]*pb.StateSummary
+	stateDiffs       map[[32]byte]*dbpb.StateDiff
+
+	proposerSlashings map[[32]byte]*ethpb.ProposerSlashing
+	attesterSlashings map[[32]byte]*ethpb.AttesterSlashing
+	voluntaryExits    map[[32]byte]*ethpb.VoluntaryExit
+
+	justifiedCheckpoint         *ethpb.Checkpoint
+	finalizedCheckpoint         *ethpb.Checkpoint
+	previousFinalizedCheckpoint *ethpb.Checkpoint
+	// A nil container marks a block root from the recent finalized epoch, which is
+	// finalized but not yet known to be canonical.
+	finalizedBlockRoots map[[32]byte]*dbpb.FinalizedBlockRootContainer
+
+	depositContractAddress []byte
+	powchainData           *dbpb.ETH1ChainData
+	operationPool          *dbpb.OperationPool
+}
+
+// NewStore initializes a new, empty in-memory database.
+func NewStore() *Store {
+	s := &Store{}
+	s.reset()
+	return s
+}
+
+// reset drops every object of the store.
+func (s *Store) reset() {
+	s.blocks = make(map[[32]byte]*ethpb.SignedBeaconBlock)
+	s.blockSlotIndices = make(map[types.Slot][][32]byte)
+	s.blockParentRootIndices = make(map[[32]byte][][32]byte)
+	s.blockProposerIndices = make(map[types.ValidatorIndex][][32]byte)
+	s.blockGraffitiIndices = make(map[[32]byte][][32]byte)
+	s.blockAttesterIndices = make(map[types.ValidatorIndex][][32]byte)
+	s.blockAttesters = make(map[[32]byte][]types.ValidatorIndex)
+	s.genesisBlockRoot = [32]byte{}
+	s.headBlockRoot = [32]byte{}
+	s.states = make(map[[32]byte]*pb.BeaconState)
+	s.stateSlotIndices = make(map[types.Slot][][32]byte)
+	s.stateSummaries = make(map[[32]byte]*pb.StateSummary)
+	s.stateDiffs = make(map[[32]byte]*dbpb.StateDiff)
+	s.proposerSlashings = make(map[[32]byte]*ethpb.ProposerSlashing)
+	s.attesterSlashings = make(map[[32]byte]*ethpb.AttesterSlashing)
+	s.voluntaryExits = make(map[[32]byte]*ethpb.VoluntaryExit)
+	s.justifiedCheckpoint = nil
+	s.finalizedCheckpoint = nil
+	s.previousFinalizedCheckpoint = nil
+	s.finalizedBlockRoots = make(map[[32]byte]*dbpb.FinalizedBlockRootContainer)
+	s.depositContractAddress = nil
+	s.powchainData = nil
+	s.operationPool = nil
+}
+
+// ClearDB drops every object of the database.
+func (s *Store) ClearDB() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reset()
+	return nil
+}
+
+// Close is a no-op, the objects of the database are released with the store.
+func (s *Store) Close() error {
+	return nil
+}
+
+// DatabasePath is empty, as this database writes no files.
+func (s *Store) DatabasePath() string {
+	return ""
+}
+
+// Backup returns an error, as there is no database file to back up.
+func (s *Store) Backup(_ context.Context, _ string) error {
+	return errBackupNotSupported
+}
+
+// RunMigrations is a no-op, as an in-memory database is always created with the latest schema.
+func (s *Store) RunMigrations(_ context.Context) error {
+	return nil
+}
+
+// appendRoot appends a root to the roots of an index, unless it is already there.
+func appendRoot(roots [][32]byte, root [32]byte) [][32]byte {
+	for _, r := range roots {
+		if r == root {
+			return roots
+		}
+	}
+	return append(roots, root)
+}
+
+// removeRoot removes a root from the roots of an index, without modifying the
+// underlying array which may be shared with a caller.
+func removeRoot(roots [][32]byte, root [32]byte) [][32]byte {
+	for i, r := range roots {
+		if r == root {
+			updated := make([][32]byte, 0, len(roots)-1)
+			updated = append(updated, roots[:i]...)
+			return append(updated, roots[i+1:]...)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// setupDB instantiates and returns a Store instance.
+func setupDB(t testing.TB) *Store {
+	db := NewStore()
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+func TestStore_ClearDB(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blk := testutil.NewBeaconBlock()
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	require.NoError(t, db.ClearDB())
+	assert.Equal(t, false, db.HasBlock(ctx, root))
+	assert.Equal(t, false, db.HasState(ctx, root))
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, 0))
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	assert.Equal(t, true, db.HasBlock(ctx, root))
+}
+
+func TestStore_Backup(t *testing.T) {
+	db := setupDB(t)
+	assert.ErrorContains(t, errBackupNotSupported.Error(), db.Backup(context.Background(), t.TempDir()))
+	assert.Equal(t, "", db.DatabasePath())
+}
+
+func TestStore_DeleteBlock(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	block1 := testutil.NewBeaconBlock()
+	block1.Block.Slot = 10
+	require.NoError(t, db.SaveBlock(ctx, block1))
+	block2 := testutil.NewBeaconBlock()
+	block2.Block.Slot = 20
+	blockRoot, err := block2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, block2))
+	require.NoError(t, db.deleteBlock(ctx, blockRoot))
+	assert.Equal(t, false, db.HasBlock(ctx, blockRoot), "Expected block to have been deleted from the db")
+	highestAt, err := db.HighestSlotBlocksBelow(ctx, 21)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(highestAt))
+	assert.Equal(t, true, proto.Equal(block1, highestAt[0]), "Wanted: %v, received: %v", block1, highestAt[0])
+}
+
+func TestStore_BlocksBatchDelete(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	numBlocks := 10
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
+	blockRoots := make([][32]byte, 0)
+	oddBlocks := make([]*ethpb.SignedBeaconBlock, 0)
+	for i := 0; i < len(totalBlocks); i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+		if i%2 == 0 {
+			r, err := totalBlocks[i].Block.HashTreeRoot()
+			require.NoError(t, err)
+			blockRoots = append(blockRoots, r)
+		} else {
+			oddBlocks = append(oddBlocks, totalBlocks[i])
+		}
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	retrieved, _, err := db.Blocks(ctx, filters.NewFilter().SetParentRoot(bytesutil.PadTo([]byte("parent"), 32)))
+	require.NoError(t, err)
+	assert.Equal(t, numBlocks, len(retrieved), "Unexpected number of blocks received")
+	// We delete all even indexed blocks.
+	require.NoError(t, db.deleteBlocks(ctx, blockRoots))
+	// When we retrieve the data, only the odd indexed blocks should remain.
+	retrieved, _, err = db.Blocks(ctx, filters.NewFilter().SetParentRoot(bytesutil.PadTo([]byte("parent"), 32)))
+	require.NoError(t, err)
+	sort.Slice(retrieved, func(i, j int) bool {
+		return retrieved[i].Block.Slot < retrieved[j].Block.Slot
+	})
+	for i, block := range retrieved {
+		assert.Equal(t, true, proto.Equal(block, oddBlocks[i]), "Wanted: %v, received: %v", block, oddBlocks[i])
+	}
+}
+
+func TestStore_BlockIndicesClearedWithBlock(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = 1
+	b1.Block.ProposerIndex = 3
+	b1.Block.Body.Graffiti = bytesutil.PadTo([]byte("prysm"), 32)
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 2
+	b2.Block.ProposerIndex = 3
+	b2.Block.Body.Graffiti = bytesutil.PadTo([]byte("prysm"), 32)
+	r2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlocksWithAttesterIndices(
+		ctx,
+		[]*ethpb.SignedBeaconBlock{b1, b2},
+		[][]types.ValidatorIndex{{5}, {5}},
+	))
+
+	require.NoError(t, db.deleteBlock(ctx, r1))
+	for _, f := range []*filters.QueryFilter{
+		filters.NewFilter().SetProposerIndex(3),
+		filters.NewFilter().SetGraffitiPrefix([]byte("prysm")),
+		filters.NewFilter().SetAttesterIndex(5),
+	} {
+		roots, err := db.BlockRoots(ctx, f)
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][32]byte{r2}, roots)
+	}
+	require.NoError(t, db.deleteBlocks(ctx, [][32]byte{r2}))
+	assert.Equal(t, 0, len(db.blockProposerIndices))
+	assert.Equal(t, 0, len(db.blockGraffitiIndices))
+	assert.Equal(t, 0, len(db.blockAttesters))
+}
+
+func TestStore_BlockIndicesDisabled(t *testing.T) {
+	db := setupDB(t)
+	b := testutil.NewBeaconBlock()
+	b.Block.ProposerIndex = 3
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+	assert.Equal(t, 0, len(db.blockProposerIndices))
+	assert.Equal(t, 0, len(db.blockGraffitiIndices))
+}
+
+func TestStore_DeleteOperations(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	exit := &ethpb.VoluntaryExit{Epoch: 5}
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveVoluntaryExit(ctx, exit))
+	require.NoError(t, db.deleteVoluntaryExit(ctx, exitRoot))
+	assert.Equal(t, false, db.HasVoluntaryExit(ctx, exitRoot), "Expected voluntary exit to have been deleted from the db")
+
+	prop := &ethpb.ProposerSlashing{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}
+	propRoot, err := prop.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveProposerSlashing(ctx, prop))
+	require.NoError(t, db.deleteProposerSlashing(ctx, propRoot))
+	assert.Equal(t, false, db.HasProposerSlashing(ctx, propRoot), "Expected proposer slashing to have been deleted from the db")
+
+	att := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+	}
+	attRoot, err := att.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttesterSlashing(ctx, att))
+	require.NoError(t, db.deleteAttesterSlashing(ctx, attRoot))
+	assert.Equal(t, false, db.HasAttesterSlashing(ctx, attRoot), "Expected attester slashing to have been deleted from the db")
+}
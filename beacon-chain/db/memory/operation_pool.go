@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveOperationPool saves a snapshot of the pending operations of the beacon node's
+// pools, replacing any previously saved snapshot.
+func (s *Store) SaveOperationPool(ctx context.Context, pool *db.OperationPool) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPool")
+	defer span.End()
+
+	if pool == nil {
+		err := errors.New("cannot save nil operation pool")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.operationPool = proto.Clone(pool).(*db.OperationPool)
+	return nil
+}
+
+// OperationPool retrieves the last saved snapshot of the operation pools. It returns
+// nil if no snapshot was saved.
+func (s *Store) OperationPool(ctx context.Context) (*db.OperationPool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.OperationPool")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.operationPool == nil {
+		return nil, nil
+	}
+	return proto.Clone(s.operationPool).(*db.OperationPool), nil
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// VoluntaryExit retrieval by signing root.
+func (s *Store) VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*ethpb.VoluntaryExit, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.VoluntaryExit")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	exit, ok := s.voluntaryExits[exitRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(exit).(*ethpb.VoluntaryExit), nil
+}
+
+// HasVoluntaryExit verifies if a voluntary exit is stored in the db by its signing root.
+func (s *Store) HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasVoluntaryExit")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.voluntaryExits[exitRoot]
+	return ok
+}
+
+// SaveVoluntaryExit to the db by its signing root.
+func (s *Store) SaveVoluntaryExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveVoluntaryExit")
+	defer span.End()
+	exitRoot, err := exit.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.voluntaryExits[exitRoot] = proto.Clone(exit).(*ethpb.VoluntaryExit)
+	return nil
+}
+
+// deleteVoluntaryExit clears a voluntary exit from the db by its signing root.
+func (s *Store) deleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.deleteVoluntaryExit")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.voluntaryExits, exitRoot)
+	return nil
+}
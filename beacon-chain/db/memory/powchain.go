@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SavePowchainData saves the pow chain data.
+func (s *Store) SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePowchainData")
+	defer span.End()
+
+	if data == nil {
+		err := errors.New("cannot save nil eth1data")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.powchainData = proto.Clone(data).(*db.ETH1ChainData)
+	return nil
+}
+
+// PowchainData retrieves the powchain data.
+func (s *Store) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PowchainData")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.powchainData == nil {
+		return nil, nil
+	}
+	return proto.Clone(s.powchainData).(*db.ETH1ChainData), nil
+}
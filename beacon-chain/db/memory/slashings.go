@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// ProposerSlashing retrieval by slashing root.
+func (s *Store) ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.ProposerSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashing")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slashing, ok := s.proposerSlashings[slashingRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(slashing).(*ethpb.ProposerSlashing), nil
+}
+
+// HasProposerSlashing verifies if a slashing is stored in the db.
+func (s *Store) HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasProposerSlashing")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.proposerSlashings[slashingRoot]
+	return ok
+}
+
+// SaveProposerSlashing to the db by its hash tree root.
+func (s *Store) SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashing")
+	defer span.End()
+	slashingRoot, err := slashing.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.proposerSlashings[slashingRoot] = proto.Clone(slashing).(*ethpb.ProposerSlashing)
+	return nil
+}
+
+// deleteProposerSlashing clears a proposer slashing from the db by its hash tree root.
+func (s *Store) deleteProposerSlashing(ctx context.Context, slashingRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.deleteProposerSlashing")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.proposerSlashings, slashingRoot)
+	return nil
+}
+
+// AttesterSlashing retrieval by hash tree root.
+func (s *Store) AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.AttesterSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashing")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slashing, ok := s.attesterSlashings[slashingRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(slashing).(*ethpb.AttesterSlashing), nil
+}
+
+// HasAttesterSlashing verifies if a slashing is stored in the db.
+func (s *Store) HasAttesterSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasAttesterSlashing")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.attesterSlashings[slashingRoot]
+	return ok
+}
+
+// SaveAttesterSlashing to the db by its hash tree root.
+func (s *Store) SaveAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashing")
+	defer span.End()
+	slashingRoot, err := slashing.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.attesterSlashings[slashingRoot] = proto.Clone(slashing).(*ethpb.AttesterSlashing)
+	return nil
+}
+
+// deleteAttesterSlashing clears an attester slashing from the db by its hash tree root.
+func (s *Store) deleteAttesterSlashing(ctx context.Context, slashingRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.deleteAttesterSlashing")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.attesterSlashings, slashingRoot)
+	return nil
+}
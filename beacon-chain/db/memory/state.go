@@ -0,0 +1,226 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// State returns the saved state using block's signing root,
+// this particular block was used to generate the state.
+func (s *Store) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.State")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.state(blockRoot)
+}
+
+// GenesisState returns the genesis state in beacon chain.
+func (s *Store) GenesisState(ctx context.Context) (*state.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.GenesisState")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.state(s.genesisBlockRoot)
+}
+
+// SaveState stores a state to the db using block's signing root which was used to generate the state.
+func (s *Store) SaveState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveState")
+	defer span.End()
+	return s.SaveStates(ctx, []*state.BeaconState{st}, [][32]byte{blockRoot})
+}
+
+// SaveStates stores multiple states to the db using the provided corresponding roots.
+func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveStates")
+	defer span.End()
+	if states == nil {
+		return errors.New("nil state")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, rt := range blockRoots {
+		slot := states[i].Slot()
+		s.stateSlotIndices[slot] = appendRoot(s.stateSlotIndices[slot], rt)
+		s.states[rt] = states[i].CloneInnerState()
+	}
+	return nil
+}
+
+// HasState checks if a state by root exists in the db.
+func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasState")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.states[blockRoot]
+	return ok
+}
+
+// DeleteState by block root.
+func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteState")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.deleteState(blockRoot)
+}
+
+// DeleteStates by block roots.
+func (s *Store) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteStates")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, r := range blockRoots {
+		if err := s.deleteState(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HighestSlotStatesBelow returns the states with the highest slot below the input slot
+// from the db. Ideally there should just be one state per slot, but given validator
+// can double propose, a single slot could have multiple block roots and
+// results states. This returns a list of states.
+func (s *Store) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]*state.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotStatesBelow")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var st *state.BeaconState
+	var err error
+	if best, ok := highestSlotBelow(s.stateSlotIndices, slot); ok {
+		st, err = s.state(s.stateSlotIndices[best][0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if st == nil {
+		st, err = s.state(s.genesisBlockRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*state.BeaconState{st}, nil
+}
+
+// CleanUpDirtyStates removes states in DB that falls to under archived point interval rules.
+// Only following states would be kept:
+// 1.) state_slot % archived_interval == 0. (e.g. archived_interval=2048, states with slot 2048, 4096... etc)
+// 2.) archived_interval - archived_interval/3 < state_slot % archived_interval
+//   (e.g. archived_interval=2048, states with slots after 1365).
+//   This is to tolerate skip slots. Not every state lays on the boundary.
+// 3.) state with current finalized root
+// 4.) unfinalized States
+func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CleanUpDirtyStates")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	f := s.finalizedCheckpointOrDefault()
+	finalizedSlot, err := helpers.StartSlot(f.Epoch)
+	if err != nil {
+		return err
+	}
+	finalizedRoot := bytesutil.ToBytes32(f.Root)
+
+	deletedRoots := make([][32]byte, 0)
+	for _, slot := range slotsInRange(s.stateSlotIndices, 0, finalizedSlot, 1) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Only the first state of a slot is an archived point, as with the key value store.
+		root := s.stateSlotIndices[slot][0]
+		finalizedChkpt := root == finalizedRoot
+		mod := slot % slotsPerArchivedPoint
+		if mod != 0 && mod <= slotsPerArchivedPoint-slotsPerArchivedPoint/3 && !finalizedChkpt {
+			deletedRoots = append(deletedRoots, root)
+		}
+	}
+
+	// Length of to be deleted roots is 0. Nothing to do.
+	if len(deletedRoots) == 0 {
+		return nil
+	}
+
+	log.WithField("count", len(deletedRoots)).Info("Cleaning up dirty states")
+	for _, r := range deletedRoots {
+		if err := s.deleteState(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// state returns a copy of the state of the block root, or nil if there is none.
+func (s *Store) state(blockRoot [32]byte) (*state.BeaconState, error) {
+	st, ok := s.states[blockRoot]
+	if !ok {
+		return nil, nil
+	}
+	return state.InitializeFromProto(st)
+}
+
+// deleteState removes the state of the block root, unless it is the genesis, finalized or
+// head state.
+func (s *Store) deleteState(blockRoot [32]byte) error {
+	finalizedRoot := s.genesisBlockRoot
+	if s.finalizedCheckpoint != nil {
+		finalizedRoot = bytesutil.ToBytes32(s.finalizedCheckpoint.Root)
+	}
+	// Safe guard against deleting genesis, finalized, head state.
+	if blockRoot == finalizedRoot || blockRoot == s.genesisBlockRoot || blockRoot == s.headBlockRoot {
+		return errors.New("cannot delete genesis, finalized, or head state")
+	}
+
+	slot, err := s.slotByBlockRoot(blockRoot)
+	if err != nil {
+		return err
+	}
+	if roots := removeRoot(s.stateSlotIndices[slot], blockRoot); len(roots) > 0 {
+		s.stateSlotIndices[slot] = roots
+	} else {
+		delete(s.stateSlotIndices, slot)
+	}
+	delete(s.states, blockRoot)
+	return nil
+}
+
+// slotByBlockRoot retrieves the corresponding slot of the input block root.
+func (s *Store) slotByBlockRoot(blockRoot [32]byte) (types.Slot, error) {
+	if summary, ok := s.stateSummaries[blockRoot]; ok {
+		return summary.Slot, nil
+	}
+	// Fall back to check the block.
+	if blk, ok := s.blocks[blockRoot]; ok {
+		if err := helpers.VerifyNilBeaconBlock(blk); err != nil {
+			return 0, err
+		}
+		return blk.Block.Slot, nil
+	}
+	// Fallback and check the state.
+	st, ok := s.states[blockRoot]
+	if !ok {
+		return 0, errors.New("state enc can't be nil")
+	}
+	return st.Slot, nil
+}
+
+// hasStateOrSummary checks if a state or a state summary of the block root exists in the db.
+func (s *Store) hasStateOrSummary(blockRoot [32]byte) bool {
+	_, hasState := s.states[blockRoot]
+	_, hasSummary := s.stateSummaries[blockRoot]
+	return hasState || hasSummary
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the diff of the state of the block root from its base state.
+func (s *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *db.StateDiff) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if diff == nil {
+		err := errors.New("cannot save nil state diff")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.stateDiffs[blockRoot] = proto.Clone(diff).(*db.StateDiff)
+	return nil
+}
+
+// StateDiff retrieves the diff of the state of the block root from its base state. It returns
+// nil if no diff was saved for the block root.
+func (s *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*db.StateDiff, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	diff, ok := s.stateDiffs[blockRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(diff).(*db.StateDiff), nil
+}
+
+// HasStateDiff checks if the diff of the state of the block root exists in the db.
+func (s *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.stateDiffs[blockRoot]
+	return ok
+}
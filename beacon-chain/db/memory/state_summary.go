@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateSummary saves a state summary object to the DB.
+func (s *Store) SaveStateSummary(ctx context.Context, summary *pb.StateSummary) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummary")
+	defer span.End()
+	return s.SaveStateSummaries(ctx, []*pb.StateSummary{summary})
+}
+
+// SaveStateSummaries saves state summary objects to the DB.
+func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
+	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, ss := range summaries {
+		s.stateSummaries[bytesutil.ToBytes32(ss.Root)] = proto.Clone(ss).(*pb.StateSummary)
+	}
+	return nil
+}
+
+// StateSummary returns the state summary object from the db using input block root.
+func (s *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StateSummary")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	summary, ok := s.stateSummaries[blockRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(summary).(*pb.StateSummary), nil
+}
+
+// HasStateSummary returns true if a state summary exists in DB.
+func (s *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasStateSummary")
+	defer span.End()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.stateSummaries[blockRoot]
+	return ok
+}
@@ -0,0 +1,706 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testSaveBlockNoDuplicates(t *testing.T, db iface.Database) {
+	slot := types.Slot(20)
+	ctx := context.Background()
+	prevBlock := testutil.NewBeaconBlock()
+	prevBlock.Block.Slot = slot - 1
+	prevBlock.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+	require.NoError(t, db.SaveBlock(ctx, prevBlock))
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = slot
+	block.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+	// Saving a block again should not cause duplicated blocks in the DB.
+	for i := 0; i < 100; i++ {
+		require.NoError(t, db.SaveBlock(ctx, block))
+	}
+	f := filters.NewFilter().SetStartSlot(slot).SetEndSlot(slot)
+	retrieved, _, err := db.Blocks(ctx, f)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(retrieved))
+}
+
+func testBlocksCRUD(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 20
+	block.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	retrievedBlock, err := db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.SignedBeaconBlock)(nil), retrievedBlock, "Expected nil block")
+	require.NoError(t, db.SaveBlock(ctx, block))
+	assert.Equal(t, true, db.HasBlock(ctx, blockRoot), "Expected block to exist in the db")
+	retrievedBlock, err = db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(block, retrievedBlock), "Wanted: %v, received: %v", block, retrievedBlock)
+}
+
+func testBlocksHandleZeroCase(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	numBlocks := 10
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
+	for i := 0; i < len(totalBlocks); i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+		_, err := totalBlocks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	zeroFilter := filters.NewFilter().SetStartSlot(0).SetEndSlot(0)
+	retrieved, _, err := db.Blocks(ctx, zeroFilter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(retrieved), "Unexpected number of blocks received, expected one")
+}
+
+func testBlocksHandleInvalidEndSlot(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	numBlocks := 10
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
+	// Save blocks from slot 1 onwards.
+	for i := 0; i < len(totalBlocks); i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i) + 1
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+		_, err := totalBlocks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	badFilter := filters.NewFilter().SetStartSlot(5).SetEndSlot(1)
+	_, _, err := db.Blocks(ctx, badFilter)
+	require.ErrorContains(t, "invalid end slot and start slot provided", err)
+
+	goodFilter := filters.NewFilter().SetStartSlot(0).SetEndSlot(1)
+	requested, _, err := db.Blocks(ctx, goodFilter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(requested), "Unexpected number of blocks received, only expected two")
+}
+
+func testGenesisBlock(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	genesisBlock := testutil.NewBeaconBlock()
+	genesisBlock.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+	blockRoot, err := genesisBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+	require.NoError(t, db.SaveBlock(ctx, genesisBlock))
+	retrievedBlock, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(genesisBlock, retrievedBlock), "Wanted: %v, received: %v", genesisBlock, retrievedBlock)
+}
+
+func testBlocksCRUDCopies(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 20
+	block.Block.ParentRoot = bytesutil.PadTo([]byte{1, 2, 3}, 32)
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, block))
+	// Neither the saved nor the retrieved block is shared with the store.
+	block.Block.Slot = 21
+	retrievedBlock, err := db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), retrievedBlock.Block.Slot)
+	retrievedBlock.Block.Slot = 22
+	retrievedBlock, err = db.Block(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), retrievedBlock.Block.Slot)
+}
+
+func testBlocksFiltersCorrectly(t *testing.T, db iface.Database) {
+	b4 := testutil.NewBeaconBlock()
+	b4.Block.Slot = 4
+	b4.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	b5 := testutil.NewBeaconBlock()
+	b5.Block.Slot = 5
+	b5.Block.ParentRoot = bytesutil.PadTo([]byte("parent2"), 32)
+	b6 := testutil.NewBeaconBlock()
+	b6.Block.Slot = 6
+	b6.Block.ParentRoot = bytesutil.PadTo([]byte("parent2"), 32)
+	b7 := testutil.NewBeaconBlock()
+	b7.Block.Slot = 7
+	b7.Block.ParentRoot = bytesutil.PadTo([]byte("parent3"), 32)
+	b8 := testutil.NewBeaconBlock()
+	b8.Block.Slot = 8
+	b8.Block.ParentRoot = bytesutil.PadTo([]byte("parent4"), 32)
+	blocks := []*ethpb.SignedBeaconBlock{b4, b5, b6, b7, b8}
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, blocks))
+
+	tests := []struct {
+		filter            *filters.QueryFilter
+		expectedNumBlocks int
+	}{
+		{
+			filter:            filters.NewFilter().SetParentRoot(bytesutil.PadTo([]byte("parent2"), 32)),
+			expectedNumBlocks: 2,
+		},
+		{
+			// No block meets the criteria below.
+			filter:            filters.NewFilter().SetParentRoot(bytesutil.PadTo([]byte{3, 4, 5}, 32)),
+			expectedNumBlocks: 0,
+		},
+		{
+			// Block slot range filter criteria.
+			filter:            filters.NewFilter().SetStartSlot(5).SetEndSlot(7),
+			expectedNumBlocks: 3,
+		},
+		{
+			filter:            filters.NewFilter().SetStartSlot(7).SetEndSlot(7),
+			expectedNumBlocks: 1,
+		},
+		{
+			filter:            filters.NewFilter().SetStartSlot(4).SetEndSlot(8),
+			expectedNumBlocks: 5,
+		},
+		{
+			filter:            filters.NewFilter().SetStartSlot(4).SetEndSlot(5),
+			expectedNumBlocks: 2,
+		},
+		{
+			filter:            filters.NewFilter().SetStartSlot(5).SetEndSlot(9),
+			expectedNumBlocks: 4,
+		},
+		{
+			filter:            filters.NewFilter().SetEndSlot(7),
+			expectedNumBlocks: 4,
+		},
+		{
+			filter:            filters.NewFilter().SetEndSlot(8),
+			expectedNumBlocks: 5,
+		},
+		{
+			filter:            filters.NewFilter().SetStartSlot(5).SetEndSlot(10),
+			expectedNumBlocks: 4,
+		},
+		{
+			// Composite filter criteria.
+			filter: filters.NewFilter().
+				SetParentRoot(bytesutil.PadTo([]byte("parent2"), 32)).
+				SetStartSlot(6).
+				SetEndSlot(8),
+			expectedNumBlocks: 1,
+		},
+	}
+	for _, tt := range tests {
+		retrievedBlocks, _, err := db.Blocks(ctx, tt.filter)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expectedNumBlocks, len(retrievedBlocks), "Unexpected number of blocks")
+	}
+}
+
+func testBlocksVerifyBlockRoots(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = 1
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 2
+	r2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, db.SaveBlock(ctx, b1))
+	require.NoError(t, db.SaveBlock(ctx, b2))
+
+	filter := filters.NewFilter().SetStartSlot(b1.Block.Slot).SetEndSlot(b2.Block.Slot)
+	roots, err := db.BlockRoots(ctx, filter)
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, [][32]byte{r1, r2}, roots)
+}
+
+func testBlocksRetrieveSlotRange(t *testing.T, db iface.Database) {
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, 500)
+	for i := 0; i < 500; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+	}
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	retrieved, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(100).SetEndSlot(399))
+	require.NoError(t, err)
+	assert.Equal(t, 300, len(retrieved))
+}
+
+func testBlocksRetrieveEpoch(t *testing.T, db iface.Database) {
+	slots := params.BeaconConfig().SlotsPerEpoch.Mul(7)
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, slots)
+	for i := types.Slot(0); i < slots; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+	}
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	retrieved, _, err := db.Blocks(ctx, filters.NewFilter().SetStartEpoch(5).SetEndEpoch(6))
+	require.NoError(t, err)
+	want := params.BeaconConfig().SlotsPerEpoch.Mul(2)
+	assert.Equal(t, uint64(want), uint64(len(retrieved)))
+	retrieved, _, err = db.Blocks(ctx, filters.NewFilter().SetStartEpoch(0).SetEndEpoch(0))
+	require.NoError(t, err)
+	want = params.BeaconConfig().SlotsPerEpoch
+	assert.Equal(t, uint64(want), uint64(len(retrieved)))
+}
+
+func testBlocksRetrieveSlotRangeWithStep(t *testing.T, db iface.Database) {
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, 500)
+	for i := 0; i < 500; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		totalBlocks[i] = b
+	}
+	const step = 2
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	retrieved, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(100).SetEndSlot(399).SetSlotStep(step))
+	require.NoError(t, err)
+	assert.Equal(t, 150, len(retrieved))
+	for _, b := range retrieved {
+		assert.Equal(t, types.Slot(0), (b.Block.Slot-100)%step, "Unexpect block slot %d", b.Block.Slot)
+	}
+}
+
+func testSaveBlockCanGetHighestAt(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	block1 := testutil.NewBeaconBlock()
+	block1.Block.Slot = 1
+	require.NoError(t, db.SaveBlock(ctx, block1))
+	block2 := testutil.NewBeaconBlock()
+	block2.Block.Slot = 10
+	require.NoError(t, db.SaveBlock(ctx, block2))
+	block3 := testutil.NewBeaconBlock()
+	block3.Block.Slot = 100
+	require.NoError(t, db.SaveBlock(ctx, block3))
+
+	highestAt, err := db.HighestSlotBlocksBelow(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, len(highestAt) <= 0, "Got empty highest at slice")
+	assert.Equal(t, true, proto.Equal(block1, highestAt[0]), "Wanted: %v, received: %v", block1, highestAt[0])
+	highestAt, err = db.HighestSlotBlocksBelow(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, false, len(highestAt) <= 0, "Got empty highest at slice")
+	assert.Equal(t, true, proto.Equal(block2, highestAt[0]), "Wanted: %v, received: %v", block2, highestAt[0])
+	highestAt, err = db.HighestSlotBlocksBelow(ctx, 101)
+	require.NoError(t, err)
+	assert.Equal(t, false, len(highestAt) <= 0, "Got empty highest at slice")
+	assert.Equal(t, true, proto.Equal(block3, highestAt[0]), "Wanted: %v, received: %v", block3, highestAt[0])
+}
+
+func testGenesisBlockCanGetHighestAt(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	genesisBlock := testutil.NewBeaconBlock()
+	genesisRoot, err := genesisBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveBlock(ctx, genesisBlock))
+	block1 := testutil.NewBeaconBlock()
+	block1.Block.Slot = 1
+	require.NoError(t, db.SaveBlock(ctx, block1))
+
+	highestAt, err := db.HighestSlotBlocksBelow(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(block1, highestAt[0]), "Wanted: %v, received: %v", block1, highestAt[0])
+	highestAt, err = db.HighestSlotBlocksBelow(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(genesisBlock, highestAt[0]), "Wanted: %v, received: %v", genesisBlock, highestAt[0])
+	highestAt, err = db.HighestSlotBlocksBelow(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(genesisBlock, highestAt[0]), "Wanted: %v, received: %v", genesisBlock, highestAt[0])
+}
+
+func testSaveBlocksHasSavedBlocks(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	b := make([]*ethpb.SignedBeaconBlock, 500)
+	for i := 0; i < 500; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		blk.Block.Slot = types.Slot(i)
+		b[i] = blk
+	}
+
+	require.NoError(t, db.SaveBlock(ctx, b[0]))
+	require.NoError(t, db.SaveBlocks(ctx, b))
+	f := filters.NewFilter().SetStartSlot(0).SetEndSlot(500)
+
+	blks, _, err := db.Blocks(ctx, f)
+	require.NoError(t, err)
+	assert.Equal(t, 500, len(blks), "Did not get wanted blocks")
+}
+
+func testSaveBlocksHasRootsMatched(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	b := make([]*ethpb.SignedBeaconBlock, 500)
+	for i := 0; i < 500; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		blk.Block.Slot = types.Slot(i)
+		b[i] = blk
+	}
+
+	require.NoError(t, db.SaveBlocks(ctx, b))
+	f := filters.NewFilter().SetStartSlot(0).SetEndSlot(500)
+
+	blks, roots, err := db.Blocks(ctx, f)
+	require.NoError(t, err)
+	assert.Equal(t, 500, len(blks), "Did not get wanted blocks")
+
+	for i, blk := range blks {
+		rt, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, roots[i], rt, "mismatch of block roots")
+	}
+}
+
+func testBlocksBySlotBlockRootsBySlot(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = 20
+	require.NoError(t, db.SaveBlock(ctx, b1))
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 100
+	b2.Block.ParentRoot = bytesutil.PadTo([]byte("parent1"), 32)
+	require.NoError(t, db.SaveBlock(ctx, b2))
+	b3 := testutil.NewBeaconBlock()
+	b3.Block.Slot = 100
+	b3.Block.ParentRoot = bytesutil.PadTo([]byte("parent2"), 32)
+	require.NoError(t, db.SaveBlock(ctx, b3))
+
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	r2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	r3, err := b3.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	hasBlocks, retrievedBlocks, err := db.BlocksBySlot(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrievedBlocks), "Unexpected number of blocks received, expected none")
+	assert.Equal(t, false, hasBlocks, "Expected no blocks")
+	hasBlocks, retrievedBlocks, err = db.BlocksBySlot(ctx, 20)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(b1, retrievedBlocks[0]), "Wanted: %v, received: %v", b1, retrievedBlocks[0])
+	assert.Equal(t, true, hasBlocks, "Expected to have blocks")
+	hasBlocks, retrievedBlocks, err = db.BlocksBySlot(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(b2, retrievedBlocks[0]), "Wanted: %v, received: %v", b2, retrievedBlocks[0])
+	assert.Equal(t, true, proto.Equal(b3, retrievedBlocks[1]), "Wanted: %v, received: %v", b3, retrievedBlocks[1])
+	assert.Equal(t, true, hasBlocks, "Expected to have blocks")
+
+	hasBlockRoots, retrievedBlockRoots, err := db.BlockRootsBySlot(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{}, retrievedBlockRoots)
+	assert.Equal(t, false, hasBlockRoots, "Expected no block roots")
+	hasBlockRoots, retrievedBlockRoots, err = db.BlockRootsBySlot(ctx, 20)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r1}, retrievedBlockRoots)
+	assert.Equal(t, true, hasBlockRoots, "Expected no block roots")
+	hasBlockRoots, retrievedBlockRoots, err = db.BlockRootsBySlot(ctx, 100)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{r2, r3}, retrievedBlockRoots)
+	assert.Equal(t, true, hasBlockRoots, "Expected no block roots")
+}
+
+func indexedBlock(t *testing.T, slot types.Slot, proposer types.ValidatorIndex, graffiti string) (*ethpb.SignedBeaconBlock, [32]byte) {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ProposerIndex = proposer
+	b.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return b, root
+}
+
+// sortedRoots sorts roots, as the indices do not return them in slot order.
+func sortedRoots(roots [][32]byte) [][32]byte {
+	sort.Slice(roots, func(i, j int) bool {
+		return bytes.Compare(roots[i][:], roots[j][:]) < 0
+	})
+	return roots
+}
+
+func testBlockIndices(t *testing.T, db iface.Database) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	ctx := context.Background()
+
+	b1, r1 := indexedBlock(t, 1, 3, "prysm")
+	b2, r2 := indexedBlock(t, 2, 4, "prysm/v1.3.0")
+	b3, r3 := indexedBlock(t, 3, 3, "teku")
+	require.NoError(t, db.SaveBlocksWithAttesterIndices(
+		ctx,
+		[]*ethpb.SignedBeaconBlock{b1, b2, b3},
+		[][]types.ValidatorIndex{nil, {1, 5}, {5}},
+	))
+
+	tests := []struct {
+		name   string
+		filter *filters.QueryFilter
+		want   [][32]byte
+	}{
+		{name: "proposer index", filter: filters.NewFilter().SetProposerIndex(3), want: [][32]byte{r1, r3}},
+		{name: "unknown proposer index", filter: filters.NewFilter().SetProposerIndex(9), want: [][32]byte{}},
+		{name: "graffiti prefix", filter: filters.NewFilter().SetGraffitiPrefix([]byte("prysm")), want: [][32]byte{r1, r2}},
+		{name: "full graffiti", filter: filters.NewFilter().SetGraffitiPrefix(b1.Block.Body.Graffiti), want: [][32]byte{r1}},
+		{name: "attester index", filter: filters.NewFilter().SetAttesterIndex(5), want: [][32]byte{r2, r3}},
+		{name: "attester and proposer", filter: filters.NewFilter().SetAttesterIndex(5).SetProposerIndex(3), want: [][32]byte{r3}},
+		{name: "graffiti and slot range", filter: filters.NewFilter().SetGraffitiPrefix([]byte("prysm")).SetStartSlot(2).SetEndSlot(3), want: [][32]byte{r2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := db.BlockRoots(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.DeepEqual(t, sortedRoots(tt.want), sortedRoots(roots))
+		})
+	}
+
+	_, err := db.BlockRoots(ctx, filters.NewFilter().SetGraffitiPrefix(make([]byte, 33)))
+	assert.ErrorContains(t, "graffiti prefix is longer than 32 bytes", err)
+
+}
+
+var genesisBlockRoot = bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
+
+func testIsFinalizedBlock(t *testing.T, db iface.Database) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	root, err := blks[slotsPerEpoch].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	cp := &ethpb.Checkpoint{
+		Epoch: 1,
+		Root:  root[:],
+	}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	// a state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+
+	// All blocks up to slotsPerEpoch*2 should be in the finalized index.
+	for i := uint64(0); i < slotsPerEpoch*2; i++ {
+		root, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+	for i := slotsPerEpoch * 3; i < uint64(len(blks)); i++ {
+		root, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, root), "Block at index %d was considered finalized in the index, but should not have", i)
+	}
+}
+
+func testIsFinalizedBlockGenesis(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 0
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, root))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Finalized genesis block doesn't exist in db")
+}
+
+// This test scenario is to test a specific edge case where the finalized block root is not part of
+// the finalized and canonical chain.
+//
+// Example:
+// 0    1  2  3   4     5   6     slot
+// a <- b <-- d <- e <- f <- g    roots
+//      ^- c
+// Imagine that epochs are 2 slots and that epoch 1, 2, and 3 are finalized. Checkpoint roots would
+// be c, e, and g. In this scenario, c was a finalized checkpoint root but no block built upon it so
+// it should not be considered "final and canonical" in the view at slot 6.
+func testIsFinalizedForkEdgeCase(t *testing.T, db iface.Database) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	blocks0 := makeBlocks(t, slotsPerEpoch*0, slotsPerEpoch, genesisBlockRoot)
+	blocks1 := append(
+		makeBlocks(t, slotsPerEpoch*1, 1, bytesutil.ToBytes32(sszRootOrDie(t, blocks0[len(blocks0)-1]))), // No block builds off of the first block in epoch.
+		makeBlocks(t, slotsPerEpoch*1+1, slotsPerEpoch-1, bytesutil.ToBytes32(sszRootOrDie(t, blocks0[len(blocks0)-1])))...,
+	)
+	blocks2 := makeBlocks(t, slotsPerEpoch*2, slotsPerEpoch, bytesutil.ToBytes32(sszRootOrDie(t, blocks1[len(blocks1)-1])))
+
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	require.NoError(t, db.SaveBlocks(ctx, blocks0))
+	require.NoError(t, db.SaveBlocks(ctx, blocks1))
+	require.NoError(t, db.SaveBlocks(ctx, blocks2))
+
+	// First checkpoint
+	checkpoint1 := &ethpb.Checkpoint{
+		Root:  sszRootOrDie(t, blocks1[0]),
+		Epoch: 1,
+	}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	// A state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, bytesutil.ToBytes32(checkpoint1.Root)))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, checkpoint1))
+	// All blocks in blocks0 and blocks1 should be finalized and canonical.
+	for i, block := range append(blocks0, blocks1...) {
+		root := sszRootOrDie(t, block)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(root)), "%d - Expected block %#x to be finalized", i, root)
+	}
+
+	// Second checkpoint
+	checkpoint2 := &ethpb.Checkpoint{
+		Root:  sszRootOrDie(t, blocks2[0]),
+		Epoch: 2,
+	}
+	// A state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, bytesutil.ToBytes32(checkpoint2.Root)))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, checkpoint2))
+	// All blocks in blocks0 and blocks2 should be finalized and canonical.
+	for i, block := range append(blocks0, blocks2...) {
+		root := sszRootOrDie(t, block)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(root)), "%d - Expected block %#x to be finalized", i, root)
+	}
+	// All blocks in blocks1 should be finalized and canonical, except blocks1[0].
+	for i, block := range blocks1 {
+		root := sszRootOrDie(t, block)
+		if db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(root)) == (i == 0) {
+			t.Errorf("Expected db.IsFinalizedBlock(ctx, blocks1[%d]) to be %v", i, i != 0)
+		}
+	}
+}
+
+func testIsFinalizedChildBlock(t *testing.T, db iface.Database) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := blks[slotsPerEpoch].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	cp := &ethpb.Checkpoint{
+		Epoch: 1,
+		Root:  root[:],
+	}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	// a state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+
+	// All blocks up to slotsPerEpoch should have a finalized child block.
+	for i := uint64(0); i < slotsPerEpoch; i++ {
+		root, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+		blk, err := db.FinalizedChildBlock(ctx, root)
+		assert.NoError(t, err)
+		if blk == nil {
+			t.Error("Child block doesn't exist for valid finalized block.")
+		}
+	}
+}
+
+func sszRootOrDie(t *testing.T, block *ethpb.SignedBeaconBlock) []byte {
+	root, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return root[:]
+}
+
+func makeBlocks(t *testing.T, i, n uint64, previousRoot [32]byte) []*ethpb.SignedBeaconBlock {
+	blocks := make([]*ethpb.SignedBeaconBlock, n)
+	for j := i; j < n+i; j++ {
+		parentRoot := make([]byte, 32)
+		copy(parentRoot, previousRoot[:])
+		blocks[j-i] = testutil.NewBeaconBlock()
+		blocks[j-i].Block.Slot = types.Slot(j + 1)
+		blocks[j-i].Block.ParentRoot = parentRoot
+		var err error
+		previousRoot, err = blocks[j-i].Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return blocks
+}
+
+func testArchivedPointIndexRootCanSaveRetrieve(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	i1 := types.Slot(100)
+	r1 := [32]byte{'A'}
+
+	received := db.ArchivedPointRoot(ctx, i1)
+	require.NotEqual(t, r1, received, "Should not have been saved")
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(i1))
+	require.NoError(t, db.SaveState(ctx, st, r1))
+	received = db.ArchivedPointRoot(ctx, i1)
+	assert.Equal(t, r1, received, "Should have been saved")
+}
+
+func testLastArchivedPointCanRetrieve(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	i, err := db.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), i, "Did not get correct index")
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	assert.NoError(t, db.SaveState(ctx, st, [32]byte{'A'}))
+	assert.Equal(t, [32]byte{'A'}, db.LastArchivedRoot(ctx), "Did not get wanted root")
+
+	assert.NoError(t, st.SetSlot(2))
+	assert.NoError(t, db.SaveState(ctx, st, [32]byte{'B'}))
+	assert.Equal(t, [32]byte{'B'}, db.LastArchivedRoot(ctx))
+
+	assert.NoError(t, st.SetSlot(3))
+	assert.NoError(t, db.SaveState(ctx, st, [32]byte{'C'}))
+
+	i, err = db.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(3), i, "Did not get correct index")
+}
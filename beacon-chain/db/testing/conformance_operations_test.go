@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testVoluntaryExitsCRUD(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	exit := &ethpb.VoluntaryExit{
+		Epoch: 5,
+	}
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	retrieved, err := db.VoluntaryExit(ctx, exitRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.VoluntaryExit)(nil), retrieved, "Expected nil voluntary exit")
+	require.NoError(t, db.SaveVoluntaryExit(ctx, exit))
+	assert.Equal(t, true, db.HasVoluntaryExit(ctx, exitRoot), "Expected voluntary exit to exist in the db")
+	retrieved, err = db.VoluntaryExit(ctx, exitRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(exit, retrieved), "Wanted %v, received %v", exit, retrieved)
+}
+
+func testProposerSlashingCRUD(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	prop := &ethpb.ProposerSlashing{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				ProposerIndex: 5,
+			},
+		}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				ProposerIndex: 5,
+			},
+		}),
+	}
+	slashingRoot, err := prop.HashTreeRoot()
+	require.NoError(t, err)
+	retrieved, err := db.ProposerSlashing(ctx, slashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.ProposerSlashing)(nil), retrieved, "Expected nil proposer slashing")
+	require.NoError(t, db.SaveProposerSlashing(ctx, prop))
+	assert.Equal(t, true, db.HasProposerSlashing(ctx, slashingRoot), "Expected proposer slashing to exist in the db")
+	retrieved, err = db.ProposerSlashing(ctx, slashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(prop, retrieved), "Wanted %v, received %v", prop, retrieved)
+}
+
+func testAttesterSlashingCRUD(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	att := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Slot: 5,
+			}}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Slot: 7,
+			}})}
+	slashingRoot, err := att.HashTreeRoot()
+	require.NoError(t, err)
+	retrieved, err := db.AttesterSlashing(ctx, slashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.AttesterSlashing)(nil), retrieved, "Expected nil attester slashing")
+	require.NoError(t, db.SaveAttesterSlashing(ctx, att))
+	assert.Equal(t, true, db.HasAttesterSlashing(ctx, slashingRoot), "Expected attester slashing to exist in the db")
+	retrieved, err = db.AttesterSlashing(ctx, slashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(att, retrieved), "Wanted %v, received %v", att, retrieved)
+}
+
+func testOperationPoolCanSaveRetrieve(t *testing.T, store iface.Database) {
+	ctx := context.Background()
+
+	pool, err := store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.OperationPool)(nil), pool, "Expected no operation pool before saving one")
+
+	want := &dbpb.OperationPool{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 10}, Signature: make([]byte, 96)},
+		},
+		AggregatedAttestations: []*ethpb.Attestation{
+			testutil.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3}}),
+		},
+	}
+	require.NoError(t, store.SaveOperationPool(ctx, want))
+	pool, err = store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, pool)
+
+	// A new snapshot replaces the previous one.
+	want = &dbpb.OperationPool{}
+	require.NoError(t, store.SaveOperationPool(ctx, want))
+	pool, err = store.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, pool)
+}
+
+func testSaveOperationPoolNil(t *testing.T, store iface.Database) {
+	assert.ErrorContains(t, "cannot save nil operation pool", store.SaveOperationPool(context.Background(), nil))
+}
+
+func testDepositContract(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	contractAddress := common.Address{1, 2, 3}
+	retrieved, err := db.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint8(nil), retrieved, "Expected nil contract address")
+	require.NoError(t, db.SaveDepositContractAddress(ctx, contractAddress))
+	retrieved, err = db.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, contractAddress, common.BytesToAddress(retrieved), "Unexpected address")
+	otherAddress := common.Address{4, 5, 6}
+	err = db.SaveDepositContractAddress(ctx, otherAddress)
+	want := "cannot override deposit contract address"
+	assert.ErrorContains(t, want, err, "Should not have been able to override old deposit contract address")
+}
+
+func testSavePowchainData(t *testing.T, db iface.Database) {
+	type args struct {
+		data *dbpb.ETH1ChainData
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "nil data",
+			args: args{
+				data: nil,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := db.SavePowchainData(context.Background(), tt.args.data); (err != nil) != tt.wantErr {
+				t.Errorf("SavePowchainData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
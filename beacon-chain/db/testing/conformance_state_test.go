@@ -0,0 +1,467 @@
+package testing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"gopkg.in/d4l3k/messagediff.v1"
+)
+
+func testStateCanSaveRetrieve(t *testing.T, db iface.Database) {
+
+	r := [32]byte{'A'}
+
+	require.Equal(t, false, db.HasState(context.Background(), r))
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+
+	require.NoError(t, db.SaveState(context.Background(), st, r))
+	assert.Equal(t, true, db.HasState(context.Background(), r))
+
+	savedS, err := db.State(context.Background(), r)
+	require.NoError(t, err)
+
+	if !reflect.DeepEqual(st.ToProtoUnsafe(), savedS.ToProtoUnsafe()) {
+		diff, _ := messagediff.PrettyDiff(st.ToProtoUnsafe(), savedS.ToProtoUnsafe())
+		t.Errorf("Did not retrieve saved state: %v", diff)
+	}
+
+	savedS, err = db.State(context.Background(), [32]byte{'B'})
+	require.NoError(t, err)
+	assert.Equal(t, (*state.BeaconState)(nil), savedS, "Unsaved state should've been nil")
+}
+
+func testGenesisStateCanSaveRetrieve(t *testing.T, db iface.Database) {
+
+	headRoot := [32]byte{'B'}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), headRoot))
+	require.NoError(t, db.SaveState(context.Background(), st, headRoot))
+
+	savedGenesisS, err := db.GenesisState(context.Background())
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.ToProtoUnsafe(), savedGenesisS.ToProtoUnsafe(), "Did not retrieve saved state")
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), [32]byte{'C'}))
+}
+
+func testStatesBatchDelete(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	numBlocks := 100
+	totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
+	blockRoots := make([][32]byte, 0)
+	evenBlockRoots := make([][32]byte, 0)
+	for i := 0; i < len(totalBlocks); i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		totalBlocks[i] = b
+		r, err := totalBlocks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(types.Slot(i)))
+		require.NoError(t, db.SaveState(context.Background(), st, r))
+		blockRoots = append(blockRoots, r)
+		if i%2 == 0 {
+			evenBlockRoots = append(evenBlockRoots, r)
+		}
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	// We delete all even indexed states.
+	require.NoError(t, db.DeleteStates(ctx, evenBlockRoots))
+	// When we retrieve the data, only the odd indexed state should remain.
+	for _, r := range blockRoots {
+		s, err := db.State(context.Background(), r)
+		require.NoError(t, err)
+		if s == nil {
+			continue
+		}
+		assert.Equal(t, types.Slot(1), s.Slot()%2, "State with slot %d should have been deleted", s.Slot())
+	}
+}
+
+func testDeleteGenesisState(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	genesisBlockRoot := [32]byte{'A'}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+	require.NoError(t, db.SaveState(ctx, st, genesisBlockRoot))
+	wantedErr := "cannot delete genesis, finalized, or head state"
+	assert.ErrorContains(t, wantedErr, db.DeleteState(ctx, genesisBlockRoot))
+}
+
+func testDeleteFinalizedState(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	genesis := bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesis))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.ParentRoot = genesis[:]
+	blk.Block.Slot = 100
+
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	finalizedBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	finalizedState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, finalizedState.SetSlot(100))
+	require.NoError(t, db.SaveState(ctx, finalizedState, finalizedBlockRoot))
+	finalizedCheckpoint := &ethpb.Checkpoint{Root: finalizedBlockRoot[:]}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, finalizedCheckpoint))
+	wantedErr := "cannot delete genesis, finalized, or head state"
+	assert.ErrorContains(t, wantedErr, db.DeleteState(ctx, finalizedBlockRoot))
+}
+
+func testDeleteHeadState(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	genesis := bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesis))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.ParentRoot = genesis[:]
+	blk.Block.Slot = 100
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	headBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+	require.NoError(t, db.SaveState(ctx, st, headBlockRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, headBlockRoot))
+	wantedErr := "cannot delete genesis, finalized, or head state"
+	assert.ErrorContains(t, wantedErr, db.DeleteState(ctx, headBlockRoot))
+}
+
+func testSaveDeleteStateCanGetHighestBelow(t *testing.T, db iface.Database) {
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	s0 := st.ToProtoUnsafe()
+	require.NoError(t, db.SaveState(context.Background(), st, r))
+
+	b.Block.Slot = 100
+	r1, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+	st, err = testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+	s1 := st.ToProtoUnsafe()
+	require.NoError(t, db.SaveState(context.Background(), st, r1))
+
+	b.Block.Slot = 1000
+	r2, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+	st, err = testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1000))
+	s2 := st.ToProtoUnsafe()
+
+	require.NoError(t, db.SaveState(context.Background(), st, r2))
+
+	highest, err := db.HighestSlotStatesBelow(context.Background(), 2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), s0)
+
+	highest, err = db.HighestSlotStatesBelow(context.Background(), 101)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), s1)
+
+	highest, err = db.HighestSlotStatesBelow(context.Background(), 1001)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), s2)
+}
+
+func testGenesisStateCanGetHighestBelow(t *testing.T, db iface.Database) {
+
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), genesisRoot))
+	require.NoError(t, db.SaveState(context.Background(), genesisState, genesisRoot))
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, db.SaveState(context.Background(), st, r))
+
+	highest, err := db.HighestSlotStatesBelow(context.Background(), 2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), st.ToProtoUnsafe())
+
+	highest, err = db.HighestSlotStatesBelow(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), genesisState.ToProtoUnsafe())
+	highest, err = db.HighestSlotStatesBelow(context.Background(), 0)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, highest[0].ToProtoUnsafe(), genesisState.ToProtoUnsafe())
+}
+
+func testCleanUpDirtyStatesAboveThreshold(t *testing.T, db iface.Database) {
+
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), genesisRoot))
+	require.NoError(t, db.SaveState(context.Background(), genesisState, genesisRoot))
+
+	bRoots := make([][32]byte, 0)
+	slotsPerArchivedPoint := types.Slot(128)
+	prevRoot := genesisRoot
+	for i := types.Slot(1); i <= slotsPerArchivedPoint; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = prevRoot[:]
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(context.Background(), b))
+		bRoots = append(bRoots, r)
+		prevRoot = r
+
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(i))
+		require.NoError(t, db.SaveState(context.Background(), st, r))
+	}
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(context.Background(), &ethpb.Checkpoint{
+		Root:  bRoots[len(bRoots)-1][:],
+		Epoch: types.Epoch(slotsPerArchivedPoint / params.BeaconConfig().SlotsPerEpoch),
+	}))
+	require.NoError(t, db.CleanUpDirtyStates(context.Background(), slotsPerArchivedPoint))
+
+	for i, root := range bRoots {
+		if types.Slot(i) >= slotsPerArchivedPoint.SubSlot(slotsPerArchivedPoint.Div(3)) {
+			require.Equal(t, true, db.HasState(context.Background(), root))
+		} else {
+			require.Equal(t, false, db.HasState(context.Background(), root))
+		}
+	}
+}
+
+func testCleanUpDirtyStatesFinalized(t *testing.T, db iface.Database) {
+
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), genesisRoot))
+	require.NoError(t, db.SaveState(context.Background(), genesisState, genesisRoot))
+
+	for i := types.Slot(1); i <= params.BeaconConfig().SlotsPerEpoch; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(context.Background(), b))
+
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(i))
+		require.NoError(t, db.SaveState(context.Background(), st, r))
+	}
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(context.Background(), &ethpb.Checkpoint{Root: genesisRoot[:]}))
+	require.NoError(t, db.CleanUpDirtyStates(context.Background(), params.BeaconConfig().SlotsPerEpoch))
+	require.Equal(t, true, db.HasState(context.Background(), genesisRoot))
+}
+
+func testCleanUpDirtyStatesDontDeleteNonFinalized(t *testing.T, db iface.Database) {
+
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(context.Background(), genesisRoot))
+	require.NoError(t, db.SaveState(context.Background(), genesisState, genesisRoot))
+
+	var unfinalizedRoots [][32]byte
+	for i := types.Slot(1); i <= params.BeaconConfig().SlotsPerEpoch; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(context.Background(), b))
+		unfinalizedRoots = append(unfinalizedRoots, r)
+
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(i))
+		require.NoError(t, db.SaveState(context.Background(), st, r))
+	}
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(context.Background(), &ethpb.Checkpoint{Root: genesisRoot[:]}))
+	require.NoError(t, db.CleanUpDirtyStates(context.Background(), params.BeaconConfig().SlotsPerEpoch))
+
+	for _, rt := range unfinalizedRoots {
+		require.Equal(t, true, db.HasState(context.Background(), rt))
+	}
+}
+
+func testStateSummaryCanSaveRetrieve(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	r1 := bytesutil.ToBytes32([]byte{'A'})
+	r2 := bytesutil.ToBytes32([]byte{'B'})
+	s1 := &pb.StateSummary{Slot: 1, Root: r1[:]}
+
+	// State summary should not exist yet.
+	require.Equal(t, false, db.HasStateSummary(ctx, r1), "State summary should not be saved")
+	require.NoError(t, db.SaveStateSummary(ctx, s1))
+	require.Equal(t, true, db.HasStateSummary(ctx, r1), "State summary should be saved")
+
+	saved, err := db.StateSummary(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, s1, saved, "State summary does not equal")
+
+	// Save a new state summary.
+	s2 := &pb.StateSummary{Slot: 2, Root: r2[:]}
+
+	// State summary should not exist yet.
+	require.Equal(t, false, db.HasStateSummary(ctx, r2), "State summary should not be saved")
+	require.NoError(t, db.SaveStateSummary(ctx, s2))
+	require.Equal(t, true, db.HasStateSummary(ctx, r2), "State summary should be saved")
+
+	saved, err = db.StateSummary(ctx, r2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, s2, saved, "State summary does not equal")
+}
+
+func testStateDiffCanSaveRetrieve(t *testing.T, store iface.Database) {
+	ctx := context.Background()
+	r := [32]byte{'A'}
+
+	assert.Equal(t, false, store.HasStateDiff(ctx, r))
+	diff, err := store.StateDiff(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.StateDiff)(nil), diff, "Expected no state diff before saving one")
+
+	want := &dbpb.StateDiff{
+		BaseRoot:         []byte{'B'},
+		State:            &pb.BeaconState{Slot: 3},
+		BlockRoots:       &dbpb.SparseRoots{Indices: []uint64{1}, Roots: [][]byte{make([]byte, 32)}},
+		ValidatorIndices: []uint64{2},
+		BalanceDeltas:    []int64{-1, 0, 1},
+	}
+	require.NoError(t, store.SaveStateDiff(ctx, r, want))
+	assert.Equal(t, true, store.HasStateDiff(ctx, r))
+	diff, err = store.StateDiff(ctx, r)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, diff)
+
+	assert.ErrorContains(t, "cannot save nil state diff", store.SaveStateDiff(ctx, r, nil))
+}
+
+func testJustifiedCheckpointCanSaveRetrieve(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	root := bytesutil.ToBytes32([]byte{'A'})
+	cp := &ethpb.Checkpoint{
+		Epoch: 10,
+		Root:  root[:],
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, cp))
+
+	retrieved, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(cp, retrieved), "Wanted %v, received %v", cp, retrieved)
+}
+
+func testFinalizedCheckpointCanSaveRetrieve(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	genesis := bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesis))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.ParentRoot = genesis[:]
+	blk.Block.Slot = 40
+
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	cp := &ethpb.Checkpoint{
+		Epoch: 5,
+		Root:  root[:],
+	}
+
+	// a valid chain is required to save finalized checkpoint.
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	// a state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+
+	retrieved, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(cp, retrieved), "Wanted %v, received %v", cp, retrieved)
+}
+
+func testJustifiedCheckpointDefaultIsZeroHash(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	retrieved, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(cp, retrieved), "Wanted %v, received %v", cp, retrieved)
+}
+
+func testFinalizedCheckpointDefaultIsZeroHash(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	retrieved, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(cp, retrieved), "Wanted %v, received %v", cp, retrieved)
+}
+
+func testFinalizedCheckpointStateMustExist(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	cp := &ethpb.Checkpoint{
+		Epoch: 5,
+		Root:  []byte{'B'},
+	}
+
+	require.ErrorContains(t, "missing state summary for finalized root", db.SaveFinalizedCheckpoint(ctx, cp))
+}
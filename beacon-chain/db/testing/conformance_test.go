@@ -0,0 +1,84 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+)
+
+// conformanceTests are run against every database implementation, which must all
+// behave the same way through the database interface.
+var conformanceTests = []struct {
+	name string
+	run  func(t *testing.T, db iface.Database)
+}{
+	{name: "SaveBlockNoDuplicates", run: testSaveBlockNoDuplicates},
+	{name: "BlocksCRUD", run: testBlocksCRUD},
+	{name: "BlocksHandleZeroCase", run: testBlocksHandleZeroCase},
+	{name: "BlocksHandleInvalidEndSlot", run: testBlocksHandleInvalidEndSlot},
+	{name: "GenesisBlock", run: testGenesisBlock},
+	{name: "BlocksCRUDCopies", run: testBlocksCRUDCopies},
+	{name: "BlocksFiltersCorrectly", run: testBlocksFiltersCorrectly},
+	{name: "BlocksVerifyBlockRoots", run: testBlocksVerifyBlockRoots},
+	{name: "BlocksRetrieveSlotRange", run: testBlocksRetrieveSlotRange},
+	{name: "BlocksRetrieveEpoch", run: testBlocksRetrieveEpoch},
+	{name: "BlocksRetrieveSlotRangeWithStep", run: testBlocksRetrieveSlotRangeWithStep},
+	{name: "SaveBlockCanGetHighestAt", run: testSaveBlockCanGetHighestAt},
+	{name: "GenesisBlockCanGetHighestAt", run: testGenesisBlockCanGetHighestAt},
+	{name: "SaveBlocksHasSavedBlocks", run: testSaveBlocksHasSavedBlocks},
+	{name: "SaveBlocksHasRootsMatched", run: testSaveBlocksHasRootsMatched},
+	{name: "BlocksBySlotBlockRootsBySlot", run: testBlocksBySlotBlockRootsBySlot},
+	{name: "BlockIndices", run: testBlockIndices},
+	{name: "IsFinalizedBlock", run: testIsFinalizedBlock},
+	{name: "IsFinalizedBlockGenesis", run: testIsFinalizedBlockGenesis},
+	{name: "IsFinalizedForkEdgeCase", run: testIsFinalizedForkEdgeCase},
+	{name: "IsFinalizedChildBlock", run: testIsFinalizedChildBlock},
+	{name: "ArchivedPointIndexRootCanSaveRetrieve", run: testArchivedPointIndexRootCanSaveRetrieve},
+	{name: "LastArchivedPointCanRetrieve", run: testLastArchivedPointCanRetrieve},
+	{name: "StateCanSaveRetrieve", run: testStateCanSaveRetrieve},
+	{name: "GenesisStateCanSaveRetrieve", run: testGenesisStateCanSaveRetrieve},
+	{name: "StatesBatchDelete", run: testStatesBatchDelete},
+	{name: "DeleteGenesisState", run: testDeleteGenesisState},
+	{name: "DeleteFinalizedState", run: testDeleteFinalizedState},
+	{name: "DeleteHeadState", run: testDeleteHeadState},
+	{name: "SaveDeleteStateCanGetHighestBelow", run: testSaveDeleteStateCanGetHighestBelow},
+	{name: "GenesisStateCanGetHighestBelow", run: testGenesisStateCanGetHighestBelow},
+	{name: "CleanUpDirtyStatesAboveThreshold", run: testCleanUpDirtyStatesAboveThreshold},
+	{name: "CleanUpDirtyStatesFinalized", run: testCleanUpDirtyStatesFinalized},
+	{name: "CleanUpDirtyStatesDontDeleteNonFinalized", run: testCleanUpDirtyStatesDontDeleteNonFinalized},
+	{name: "StateSummaryCanSaveRetrieve", run: testStateSummaryCanSaveRetrieve},
+	{name: "StateDiffCanSaveRetrieve", run: testStateDiffCanSaveRetrieve},
+	{name: "JustifiedCheckpointCanSaveRetrieve", run: testJustifiedCheckpointCanSaveRetrieve},
+	{name: "FinalizedCheckpointCanSaveRetrieve", run: testFinalizedCheckpointCanSaveRetrieve},
+	{name: "JustifiedCheckpointDefaultIsZeroHash", run: testJustifiedCheckpointDefaultIsZeroHash},
+	{name: "FinalizedCheckpointDefaultIsZeroHash", run: testFinalizedCheckpointDefaultIsZeroHash},
+	{name: "FinalizedCheckpointStateMustExist", run: testFinalizedCheckpointStateMustExist},
+	{name: "VoluntaryExitsCRUD", run: testVoluntaryExitsCRUD},
+	{name: "ProposerSlashingCRUD", run: testProposerSlashingCRUD},
+	{name: "AttesterSlashingCRUD", run: testAttesterSlashingCRUD},
+	{name: "OperationPoolCanSaveRetrieve", run: testOperationPoolCanSaveRetrieve},
+	{name: "SaveOperationPoolNil", run: testSaveOperationPoolNil},
+	{name: "DepositContract", run: testDepositContract},
+	{name: "SavePowchainData", run: testSavePowchainData},
+}
+
+// runConformanceTests runs every conformance test against a fresh database from setup.
+func runConformanceTests(t *testing.T, setup func(t testing.TB) iface.Database) {
+	for _, tt := range conformanceTests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, setup(t))
+		})
+	}
+}
+
+func TestConformance_KV(t *testing.T) {
+	runConformanceTests(t, func(t testing.TB) iface.Database {
+		return SetupDB(t)
+	})
+}
+
+func TestConformance_Memory(t *testing.T) {
+	runConformanceTests(t, func(t testing.TB) iface.Database {
+		return SetupInMemoryDB(t)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
 )
 
 // SetupDB instantiates and returns database backed by key value store.
@@ -23,3 +24,9 @@ func SetupDB(t testing.TB) db.Database {
 	})
 	return s
 }
+
+// SetupInMemoryDB instantiates and returns an in-memory database, for tests which do not
+// depend on the key value store.
+func SetupInMemoryDB(t testing.TB) db.Database {
+	return memory.NewStore()
+}
@@ -106,6 +106,73 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// SlotsPerStateDiff specifies the number of slots between the state diffs saved in the cold section of DB,
+	// to reconstruct historical states from the archived states without replaying blocks.
+	SlotsPerStateDiff = &cli.IntFlag{
+		Name: "slots-per-state-diff",
+		Usage: "The slot durations of when a state diff gets saved in the DB, between archived states. " +
+			"Diffs are also saved every 8, 64... times this duration, so a state is reconstructed by applying " +
+			"a few diffs to an archived state. Must divide slots-per-archive-point. Disabled if 0.",
+		Value: 0,
+	}
+	// ArchiveFileFlag specifies the archive file written by the db export command and read by the
+	// db import command.
+	ArchiveFileFlag = &cli.StringFlag{
+		Name:  "archive-file",
+		Usage: "Filepath to the archive of the finalized chain exported from, or imported into the database",
+	}
+	// ArchiveStateIntervalFlag specifies the slot interval of the archived states written by the db
+	// export command.
+	ArchiveStateIntervalFlag = &cli.IntFlag{
+		Name: "archive-state-interval",
+		Usage: "The slot interval of the archived states exported along with the finalized chain, " +
+			"besides the genesis and finalized states. Exports every archived state if 0.",
+		Value: 0,
+	}
+	// InMemoryDBFlag keeps the beacon chain database in memory, for ephemeral nodes.
+	InMemoryDBFlag = &cli.BoolFlag{
+		Name: "in-memory-db",
+		Usage: "Keeps the beacon chain database in memory instead of the data directory, for ephemeral " +
+			"nodes such as local devnets. Nothing is written to disk and all data is lost on shutdown.",
+	}
+	// HotStateCacheSize specifies the number of hot states kept in memory by the state generator.
+	HotStateCacheSize = &cli.IntFlag{
+		Name:  "hot-state-cache-size",
+		Usage: "The maximum number of hot states, after the last finalized state, kept in memory.",
+		Value: 32,
+	}
+	// EpochBoundaryStateCacheSize specifies the number of epoch boundary states kept in memory by the state generator.
+	EpochBoundaryStateCacheSize = &cli.IntFlag{
+		Name:  "epoch-boundary-state-cache-size",
+		Usage: "The maximum number of epoch boundary states kept in memory, to replay states from during non-finality.",
+		Value: 8,
+	}
+	// CheckpointStateCacheSize specifies the number of checkpoint states kept in memory to verify attestations.
+	CheckpointStateCacheSize = &cli.IntFlag{
+		Name:  "checkpoint-state-cache-size",
+		Usage: "The maximum number of checkpoint states kept in memory to verify attestations.",
+		Value: 10,
+	}
+	// SkipSlotCacheSize specifies the number of states processed through skip slots kept in memory.
+	SkipSlotCacheSize = &cli.IntFlag{
+		Name:  "skip-slot-cache-size",
+		Usage: "The maximum number of states processed through skip slots kept in memory.",
+		Value: 8,
+	}
+	// CommitteeCacheSize specifies the number of epochs of shuffled committees kept in memory.
+	CommitteeCacheSize = &cli.IntFlag{
+		Name:  "committee-cache-size",
+		Usage: "The maximum number of epochs of shuffled committees kept in memory.",
+		Value: 32,
+	}
+	// StateCacheMemoryBudget specifies the memory budget shared by the state and committee caches.
+	StateCacheMemoryBudget = &cli.IntFlag{
+		Name: "state-cache-memory-budget",
+		Usage: "The estimated memory, in MiB, shared by the caches of hot, epoch boundary, checkpoint and " +
+			"skip slot states and of committees. The least recently used entries, weighted by how likely " +
+			"each cache is to reuse them, are evicted across the caches to stay within the budget. Unlimited if 0.",
+		Value: 0,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
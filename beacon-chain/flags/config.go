@@ -8,13 +8,19 @@ import (
 // GlobalFlags specifies all the global flags for the
 // beacon node.
 type GlobalFlags struct {
-	HeadSync                   bool
-	DisableSync                bool
-	DisableDiscv5              bool
-	SubscribeToAllSubnets      bool
-	MinimumSyncPeers           int
-	BlockBatchLimit            int
-	BlockBatchLimitBurstFactor int
+	HeadSync                    bool
+	DisableSync                 bool
+	DisableDiscv5               bool
+	SubscribeToAllSubnets       bool
+	MinimumSyncPeers            int
+	BlockBatchLimit             int
+	BlockBatchLimitBurstFactor  int
+	HotStateCacheSize           int
+	EpochBoundaryStateCacheSize int
+	CheckpointStateCacheSize    int
+	SkipSlotCacheSize           int
+	CommitteeCacheSize          int
+	StateCacheMemoryBudget      uint64
 }
 
 var globalConfig *GlobalFlags
@@ -52,6 +58,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	configureMinimumPeers(ctx, cfg)
+	configureStateCaches(ctx, cfg)
 
 	Init(cfg)
 }
@@ -64,3 +71,14 @@ func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 		cfg.MinimumSyncPeers = maxPeers
 	}
 }
+
+func configureStateCaches(ctx *cli.Context, cfg *GlobalFlags) {
+	cfg.HotStateCacheSize = ctx.Int(HotStateCacheSize.Name)
+	cfg.EpochBoundaryStateCacheSize = ctx.Int(EpochBoundaryStateCacheSize.Name)
+	cfg.CheckpointStateCacheSize = ctx.Int(CheckpointStateCacheSize.Name)
+	cfg.SkipSlotCacheSize = ctx.Int(SkipSlotCacheSize.Name)
+	cfg.CommitteeCacheSize = ctx.Int(CommitteeCacheSize.Name)
+	if budget := ctx.Int(StateCacheMemoryBudget.Name); budget > 0 {
+		cfg.StateCacheMemoryBudget = uint64(budget) << 20
+	}
+}
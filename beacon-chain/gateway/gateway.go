@@ -65,6 +65,9 @@ func (g *Gateway) Start() {
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
 		pbrpc.RegisterHealthHandler,
+		pbrpc.RegisterDutiesHandler,
+		pbrpc.RegisterBlockIndexHandler,
+		pbrpc.RegisterValidatorHistoryHandler,
 	}
 	if g.enableDebugRPCEndpoints {
 		handlers = append(handlers, pbrpc.RegisterDebugHandler)
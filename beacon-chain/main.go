@@ -53,6 +53,16 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.SlotsPerStateDiff,
+	flags.ArchiveFileFlag,
+	flags.ArchiveStateIntervalFlag,
+	flags.InMemoryDBFlag,
+	flags.HotStateCacheSize,
+	flags.EpochBoundaryStateCacheSize,
+	flags.CheckpointStateCacheSize,
+	flags.SkipSlotCacheSize,
+	flags.CommitteeCacheSize,
+	flags.StateCacheMemoryBudget,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -19,15 +19,20 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/persistence"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -96,6 +101,7 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 	featureconfig.ConfigureBeaconChain(cliCtx)
 	cmd.ConfigureBeaconChain(cliCtx)
 	flags.ConfigureGlobalFlags(cliCtx)
+	configureStateCaches()
 
 	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
 		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
@@ -124,6 +130,19 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		params.OverrideBeaconConfig(c)
 	}
 
+	if cliCtx.IsSet(flags.SlotsPerStateDiff.Name) {
+		c := params.BeaconConfig()
+		c.SlotsPerStateDiff = types.Slot(cliCtx.Int(flags.SlotsPerStateDiff.Name))
+		if c.SlotsPerStateDiff != 0 && c.SlotsPerArchivedPoint%c.SlotsPerStateDiff != 0 {
+			return nil, fmt.Errorf(
+				"%d slots per state diff do not divide %d slots per archive point",
+				c.SlotsPerStateDiff,
+				c.SlotsPerArchivedPoint,
+			)
+		}
+		params.OverrideBeaconConfig(c)
+	}
+
 	// ETH PoW related flags.
 	if cliCtx.IsSet(flags.ChainID.Name) {
 		c := params.BeaconConfig()
@@ -198,6 +217,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerOperationPoolPersistence(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -286,12 +309,53 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
+// configureStateCaches applies the state memory budget of the node flags, and recreates the package
+// level caches created before the flags were configured, applying their configured sizes.
+func configureStateCaches() {
+	budget := flags.Get().StateCacheMemoryBudget
+	cache.StateMemoryBudget.SetLimit(budget)
+	if budget > 0 {
+		log.WithField("budgetMiB", budget>>20).Info("Bounding the memory of the state caches")
+	}
+	transition.SkipSlotCache = cache.NewSkipSlotCache()
+	helpers.ClearCache()
+}
+
 func (b *BeaconNode) startForkChoice() {
 	f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
 	b.forkChoiceStore = f
 }
 
 func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
+	var d db.Database
+	var err error
+	if cliCtx.Bool(flags.InMemoryDBFlag.Name) {
+		log.Warning("Using an in-memory database, all chain data will be lost on shutdown")
+		d = memory.NewStore()
+	} else {
+		d, err = b.openKVStore(cliCtx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := d.RunMigrations(b.ctx); err != nil {
+		return err
+	}
+
+	b.db = d
+
+	depositCache, err := depositcache.New()
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit cache")
+	}
+
+	b.depositCache = depositCache
+	return nil
+}
+
+// openKVStore opens the database in the data directory, clearing it first if requested.
+func (b *BeaconNode) openKVStore(cliCtx *cli.Context) (db.Database, error) {
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
 	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
@@ -303,7 +367,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	clearDBConfirmed := false
 	if clearDB && !forceClearDB {
@@ -312,38 +376,25 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		deniedText := "Database will not be deleted. No changes have been made."
 		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if clearDBConfirmed || forceClearDB {
 		log.Warning("Removing database")
 		if err := d.Close(); err != nil {
-			return errors.Wrap(err, "could not close db prior to clearing")
+			return nil, errors.Wrap(err, "could not close db prior to clearing")
 		}
 		if err := d.ClearDB(); err != nil {
-			return errors.Wrap(err, "could not clear database")
+			return nil, errors.Wrap(err, "could not clear database")
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
 			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
 		})
 		if err != nil {
-			return errors.Wrap(err, "could not create new database")
+			return nil, errors.Wrap(err, "could not create new database")
 		}
 	}
-
-	if err := d.RunMigrations(b.ctx); err != nil {
-		return err
-	}
-
-	b.db = d
-
-	depositCache, err := depositcache.New()
-	if err != nil {
-		return errors.Wrap(err, "could not create deposit cache")
-	}
-
-	b.depositCache = depositCache
-	return nil
+	return d, nil
 }
 
 func (b *BeaconNode) startStateGen() {
@@ -474,6 +525,23 @@ func (b *BeaconNode) registerBlockchainService() error {
 	return b.services.RegisterService(blockchainService)
 }
 
+func (b *BeaconNode) registerOperationPoolPersistence() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	s := persistence.NewService(b.ctx, &persistence.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+		AttPool:       b.attestationPool,
+		ExitPool:      b.exitPool,
+		SlashingPool:  b.slashingsPool,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
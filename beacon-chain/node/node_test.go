@@ -9,7 +9,11 @@ import (
 	"testing"
 
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -81,3 +85,23 @@ func TestClearDB(t *testing.T) {
 	require.LogsContain(t, hook, "Removing database")
 	require.NoError(t, os.RemoveAll(tmp))
 }
+
+func TestInMemoryDB(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	tmp := filepath.Join(t.TempDir(), "datadirtest")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("datadir", tmp, "node data directory")
+	set.Bool(flags.InMemoryDBFlag.Name, true, "in-memory db")
+
+	context := cli.NewContext(&app, set, nil)
+	node, err := New(context)
+	require.NoError(t, err)
+
+	require.LogsContain(t, hook, "Using an in-memory database")
+	_, ok := node.db.(*memory.Store)
+	assert.Equal(t, true, ok, "Expected an in-memory database")
+	assert.Equal(t, false, fileutil.FileExists(filepath.Join(tmp, kv.BeaconNodeDbDirName, kv.DatabaseFileName)))
+}
@@ -0,0 +1,150 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// persistPools saves the pending operations of the pools which are still valid
+// against the head state to the beacon DB.
+func (s *Service) persistPools(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "persistence.persistPools")
+	defer span.End()
+
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("head state is nil")
+	}
+	atts := make([]*ethpb.Attestation, 0)
+	for _, att := range s.attPool.AggregatedAttestations() {
+		if !expired(headState, att.Data.Slot) {
+			atts = append(atts, att)
+		}
+	}
+	pool := &dbpb.OperationPool{
+		ProposerSlashings:      s.slashingPool.PendingProposerSlashings(ctx, headState, true /* noLimit */),
+		AttesterSlashings:      s.slashingPool.PendingAttesterSlashings(ctx, headState, true /* noLimit */),
+		VoluntaryExits:         s.exitPool.PendingExits(headState, headState.Slot(), true /* noLimit */),
+		AggregatedAttestations: atts,
+	}
+	if err := s.beaconDB.SaveOperationPool(ctx, pool); err != nil {
+		return errors.Wrap(err, "could not save operation pool")
+	}
+	log.WithFields(logrus.Fields{
+		"proposerSlashings":      len(pool.ProposerSlashings),
+		"attesterSlashings":      len(pool.AttesterSlashings),
+		"voluntaryExits":         len(pool.VoluntaryExits),
+		"aggregatedAttestations": len(pool.AggregatedAttestations),
+	}).Debug("Persisted operation pools")
+	return nil
+}
+
+// restorePools reloads the persisted operations into the pools. Every operation is
+// re-validated against the head state, and the ones which are no longer valid are dropped.
+func (s *Service) restorePools(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "persistence.restorePools")
+	defer span.End()
+
+	pool, err := s.beaconDB.OperationPool(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve operation pool")
+	}
+	if pool == nil {
+		return nil
+	}
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("head state is nil")
+	}
+
+	var restored, dropped int
+	for _, slashing := range pool.ProposerSlashings {
+		if err := s.slashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Dropping persisted proposer slashing")
+			dropped++
+			continue
+		}
+		restored++
+	}
+	for _, slashing := range pool.AttesterSlashings {
+		if err := s.slashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Dropping persisted attester slashing")
+			dropped++
+			continue
+		}
+		restored++
+	}
+	for _, exit := range pool.VoluntaryExits {
+		if err := verifyExit(headState, exit); err != nil {
+			log.WithError(err).Debug("Dropping persisted voluntary exit")
+			dropped++
+			continue
+		}
+		s.exitPool.InsertVoluntaryExit(ctx, headState, exit)
+		restored++
+	}
+	for _, att := range pool.AggregatedAttestations {
+		if err := verifyAttestation(ctx, headState, att); err != nil {
+			log.WithError(err).Debug("Dropping persisted aggregated attestation")
+			dropped++
+			continue
+		}
+		if err := s.attPool.SaveAggregatedAttestation(att); err != nil {
+			log.WithError(err).Debug("Dropping persisted aggregated attestation")
+			dropped++
+			continue
+		}
+		restored++
+	}
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"dropped":  dropped,
+	}).Info("Restored persisted operation pools")
+	return nil
+}
+
+func verifyExit(headState *stateTrie.BeaconState, exit *ethpb.SignedVoluntaryExit) error {
+	if exit == nil || exit.Exit == nil {
+		return errors.New("nil voluntary exit")
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	return blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorRoot())
+}
+
+func verifyAttestation(ctx context.Context, headState *stateTrie.BeaconState, att *ethpb.Attestation) error {
+	if att == nil || att.Data == nil {
+		return errors.New("nil attestation")
+	}
+	if expired(headState, att.Data.Slot) {
+		return errors.New("attestation expired")
+	}
+	if !helpers.IsAggregated(att) {
+		return errors.New("attestation is not aggregated")
+	}
+	return blocks.VerifyAttestationSignature(ctx, headState, att)
+}
+
+// expired returns true if an attestation for the given slot has expired, following the
+// rule of the attestation pool: an attestation expires one epoch after its slot.
+func expired(headState *stateTrie.BeaconState, slot types.Slot) bool {
+	return slot+params.BeaconConfig().SlotsPerEpoch <= helpers.CurrentSlot(headState.GenesisTime())
+}
@@ -0,0 +1,142 @@
+// Package persistence defines a service which saves the pending operations of the
+// beacon node's slashing, voluntary exit and aggregated attestation pools to the
+// beacon DB, and restores them at startup so they survive a restart.
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Service persists the operation pools of the beacon node.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	beaconDB        db.HeadAccessDatabase
+	headFetcher     blockchain.HeadFetcher
+	stateNotifier   statefeed.Notifier
+	attPool         attestations.Pool
+	exitPool        voluntaryexits.PoolManager
+	slashingPool    slashings.PoolManager
+	persistInterval time.Duration
+	restored        chan struct{}
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB      db.HeadAccessDatabase
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	AttPool       attestations.Pool
+	ExitPool      voluntaryexits.PoolManager
+	SlashingPool  slashings.PoolManager
+	// PersistInterval is how often the operation pools are saved. It defaults to one epoch.
+	PersistInterval time.Duration
+}
+
+// NewService instantiates a new operation pool persistence service instance that will
+// be registered into a running beacon node.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	persistInterval := cfg.PersistInterval
+	if persistInterval == 0 {
+		// Persist the operation pools every epoch interval.
+		persistInterval = time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		beaconDB:        cfg.BeaconDB,
+		headFetcher:     cfg.HeadFetcher,
+		stateNotifier:   cfg.StateNotifier,
+		attPool:         cfg.AttPool,
+		exitPool:        cfg.ExitPool,
+		slashingPool:    cfg.SlashingPool,
+		persistInterval: persistInterval,
+		restored:        make(chan struct{}),
+	}
+}
+
+// Start restores the persisted operation pools once the chain is initialized, and
+// then periodically saves them.
+func (s *Service) Start() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	go func() {
+		defer stateSub.Unsubscribe()
+		if !s.waitForHeadState(stateChannel, stateSub.Err()) {
+			return
+		}
+		if err := s.restorePools(s.ctx); err != nil {
+			log.WithError(err).Error("Could not restore operation pools")
+		}
+		close(s.restored)
+		s.persistRoutine()
+	}()
+}
+
+// Stop saves the operation pools a last time before the beacon node shuts down.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	select {
+	case <-s.restored:
+		// The pools are only saved once they were restored, to avoid overwriting
+		// the persisted operations with empty pools.
+		return s.persistPools(s.ctx)
+	default:
+		return nil
+	}
+}
+
+// Status returns nil, as the service has no failure state.
+func (s *Service) Status() error {
+	return nil
+}
+
+// waitForHeadState blocks until the head state is available, which is the case
+// once the state initialized event was received. It returns false if the service
+// stopped before that.
+func (s *Service) waitForHeadState(stateChannel <-chan *feed.Event, errChannel <-chan error) bool {
+	if headState, err := s.headFetcher.HeadState(s.ctx); err == nil && headState != nil {
+		return true
+	}
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Initialized {
+				return true
+			}
+		case err := <-errChannel:
+			log.WithError(err).Error("Subscription to state notifier failed")
+			return false
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return false
+		}
+	}
+}
+
+func (s *Service) persistRoutine() {
+	ticker := time.NewTicker(s.persistInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.persistPools(s.ctx); err != nil {
+				log.WithError(err).Error("Could not persist operation pools")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
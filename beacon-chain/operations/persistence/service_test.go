@@ -0,0 +1,178 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// setupHeadState returns a head state at a slot late enough for validators to exit,
+// with a genesis time such that the head slot is the current slot.
+func setupHeadState(t *testing.T) (*stateTrie.BeaconState, []bls.SecretKey) {
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	epoch := params.BeaconConfig().ShardCommitteePeriod + 1
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))
+	require.NoError(t, st.SetSlot(slot))
+	genesisTime := time.Now().Add(-time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	require.NoError(t, st.SetGenesisTime(uint64(genesisTime.Unix())))
+	return st, privKeys
+}
+
+func signedExit(t *testing.T, st *stateTrie.BeaconState, idx types.ValidatorIndex, key bls.SecretKey) *ethpb.SignedVoluntaryExit {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit: &ethpb.VoluntaryExit{
+			Epoch:          helpers.CurrentEpoch(st),
+			ValidatorIndex: idx,
+		},
+	}
+	var err error
+	exit.Signature, err = helpers.ComputeDomainAndSign(st, exit.Exit.Epoch, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, key)
+	require.NoError(t, err)
+	return exit
+}
+
+func TestService_PersistAndRestorePools(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, privKeys := setupHeadState(t)
+	chainService := &mock.ChainService{State: st}
+
+	validExit := signedExit(t, st, 0, privKeys[0])
+	// Signed by the wrong key, this exit must be dropped at restore time.
+	invalidExit := signedExit(t, st, 1, privKeys[2])
+	atts, err := testutil.GenerateAttestations(st, privKeys, 1, st.Slot()-1, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	proposerSlashing, err := testutil.GenerateProposerSlashingForValidator(st, privKeys[3], 3)
+	require.NoError(t, err)
+
+	attPool := attestations.NewPool()
+	require.NoError(t, attPool.SaveAggregatedAttestation(atts[0]))
+	exitPool := voluntaryexits.NewPool()
+	exitPool.InsertVoluntaryExit(ctx, st, validExit)
+	exitPool.InsertVoluntaryExit(ctx, st, invalidExit)
+	slashingPool := &slashings.PoolMock{PendingPropSlashings: []*ethpb.ProposerSlashing{proposerSlashing}}
+	s := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		AttPool:       attPool,
+		ExitPool:      exitPool,
+		SlashingPool:  slashingPool,
+	})
+	require.NoError(t, s.persistPools(ctx))
+
+	saved, err := beaconDB.OperationPool(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, 2, len(saved.VoluntaryExits))
+	assert.Equal(t, 1, len(saved.AggregatedAttestations))
+	assert.Equal(t, 1, len(saved.ProposerSlashings))
+
+	// Restore into empty pools, as a restarted node would.
+	restoredAttPool := attestations.NewPool()
+	restoredExitPool := voluntaryexits.NewPool()
+	restoredSlashingPool := &slashings.PoolMock{}
+	restarted := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		AttPool:       restoredAttPool,
+		ExitPool:      restoredExitPool,
+		SlashingPool:  restoredSlashingPool,
+	})
+	require.NoError(t, restarted.restorePools(ctx))
+
+	assert.DeepEqual(t, []*ethpb.SignedVoluntaryExit{validExit}, restoredExitPool.PendingExits(st, st.Slot(), true))
+	assert.DeepEqual(t, atts, restoredAttPool.AggregatedAttestations())
+	assert.DeepEqual(t, []*ethpb.ProposerSlashing{proposerSlashing}, restoredSlashingPool.PendingPropSlashings)
+}
+
+func TestService_RestorePools_DropsExpiredAttestations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, privKeys := setupHeadState(t)
+	atts, err := testutil.GenerateAttestations(st, privKeys, 1, st.Slot()-params.BeaconConfig().SlotsPerEpoch, false)
+	require.NoError(t, err)
+	chainService := &mock.ChainService{State: st}
+	require.NoError(t, beaconDB.SaveOperationPool(ctx, &dbpb.OperationPool{AggregatedAttestations: atts}))
+
+	attPool := attestations.NewPool()
+	s := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		AttPool:       attPool,
+		ExitPool:      voluntaryexits.NewPool(),
+		SlashingPool:  &slashings.PoolMock{},
+	})
+	require.NoError(t, s.restorePools(ctx))
+	assert.Equal(t, 0, len(attPool.AggregatedAttestations()))
+}
+
+func TestService_StartStop(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, privKeys := setupHeadState(t)
+	chainService := &mock.ChainService{State: st}
+	exit := signedExit(t, st, 0, privKeys[0])
+	require.NoError(t, beaconDB.SaveOperationPool(ctx, &dbpb.OperationPool{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{exit},
+	}))
+
+	exitPool := voluntaryexits.NewPool()
+	s := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		AttPool:       attestations.NewPool(),
+		ExitPool:      exitPool,
+		SlashingPool:  &slashings.PoolMock{},
+	})
+	s.Start()
+	select {
+	case <-s.restored:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for pools to be restored")
+	}
+	assert.Equal(t, 1, len(exitPool.PendingExits(st, st.Slot(), true)))
+
+	// The exit is included in a block before shutting down, the persisted pool must reflect it.
+	exitPool.MarkIncluded(exit)
+	require.NoError(t, s.Stop())
+	saved, err := beaconDB.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved.VoluntaryExits))
+}
+
+func TestService_Stop_BeforeRestore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	s := NewService(ctx, &Config{
+		BeaconDB:     beaconDB,
+		HeadFetcher:  &mock.ChainService{},
+		AttPool:      attestations.NewPool(),
+		ExitPool:     voluntaryexits.NewPool(),
+		SlashingPool: &slashings.PoolMock{},
+	})
+	require.NoError(t, s.Stop())
+	saved, err := beaconDB.OperationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.OperationPool)(nil), saved, "Pools must not be persisted before they are restored")
+}
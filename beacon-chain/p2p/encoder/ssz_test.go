@@ -114,7 +114,7 @@ func TestSszNetworkEncoder_DecodeWithMultipleFrames(t *testing.T) {
 	maxChunkSize := uint64(1 << 22)
 	c.MaxChunkSize = maxChunkSize
 	params.OverrideBeaconNetworkConfig(c)
-	_, err := e.EncodeWithMaxLength(buf, st.InnerStateUnsafe())
+	_, err := e.EncodeWithMaxLength(buf, st.ToProtoUnsafe())
 	require.NoError(t, err)
 	// Max snappy block size
 	if buf.Len() <= 76490 {
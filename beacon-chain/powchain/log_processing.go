@@ -533,7 +533,7 @@ func (s *Service) savePowchainData(ctx context.Context) error {
 	eth1Data := &protodb.ETH1ChainData{
 		CurrentEth1Data:   s.latestEth1Data,
 		ChainstartData:    s.chainStartData,
-		BeaconState:       s.preGenesisState.InnerStateUnsafe(), // I promise not to mutate it!
+		BeaconState:       s.preGenesisState.ToProtoUnsafe(), // I promise not to mutate it!
 		Trie:              s.depositTrie.ToProto(),
 		DepositContainers: s.depositCache.AllDepositContainers(ctx),
 	}
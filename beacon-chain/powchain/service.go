@@ -81,6 +81,7 @@ type ChainStartFetcher interface {
 type ChainInfoFetcher interface {
 	Eth2GenesisPowchainInfo() (uint64, *big.Int)
 	IsConnectedToETH1() bool
+	Eth1ClientVersion() string
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -153,6 +154,7 @@ type Service struct {
 	preGenesisState         *stateTrie.BeaconState
 	stateGen                *stategen.State
 	eth1HeaderReqLimit      uint64
+	eth1ClientVersion       string
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -323,6 +325,12 @@ func (s *Service) IsConnectedToETH1() bool {
 	return s.connectedETH1
 }
 
+// Eth1ClientVersion returns the version of the connected eth1 client, such as
+// "Geth/v1.10.1-stable/linux-amd64/go1.16", or an empty string if unknown.
+func (s *Service) Eth1ClientVersion() string {
+	return s.eth1ClientVersion
+}
+
 // DepositRoot returns the Merkle root of the latest deposit trie
 // from the ETH1.0 deposit contract.
 func (s *Service) DepositRoot() [32]byte {
@@ -387,6 +395,13 @@ func (s *Service) connectToPowChain() error {
 		return errors.New("eth1 client is nil")
 	}
 
+	// The client version is informational, so failing to fetch it does not fail the connection.
+	var clientVersion string
+	if err := rpcClient.CallContext(s.ctx, &clientVersion, "web3_clientVersion"); err != nil {
+		log.WithError(err).Debug("Could not get eth1 client version")
+	}
+	s.eth1ClientVersion = clientVersion
+
 	s.initializeConnection(httpClient, rpcClient, depositContractCaller)
 	return nil
 }
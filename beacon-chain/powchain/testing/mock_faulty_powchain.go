@@ -88,6 +88,11 @@ func (f *FaultyMockPOWChain) IsConnectedToETH1() bool {
 	return true
 }
 
+// Eth1ClientVersion --
+func (f *FaultyMockPOWChain) Eth1ClientVersion() string {
+	return ""
+}
+
 // BlockExistsWithCache --
 func (f *FaultyMockPOWChain) BlockExistsWithCache(ctx context.Context, hash common.Hash) (bool, *big.Int, error) {
 	return f.BlockExists(ctx, hash)
@@ -31,6 +31,7 @@ type POWChain struct {
 	Eth1Data          *ethpb.Eth1Data
 	GenesisEth1Block  *big.Int
 	GenesisState      *beaconstate.BeaconState
+	ClientVersion     string
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -134,6 +135,11 @@ func (m *POWChain) IsConnectedToETH1() bool {
 	return true
 }
 
+// Eth1ClientVersion --
+func (m *POWChain) Eth1ClientVersion() string {
+	return m.ClientVersion
+}
+
 // RPCClient defines the mock rpc client.
 type RPCClient struct {
 	Backend *backends.SimulatedBackend
@@ -0,0 +1,88 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListIndexedBlocks retrieves blocks by proposer index, graffiti prefix or included attester,
+// using the optional block indices of the database, in slot order.
+//
+// Blocks saved before block indices were enabled are indexed by proposer index and graffiti
+// upon restart, but not by attester.
+func (bs *Server) ListIndexedBlocks(
+	ctx context.Context, req *pbrpc.ListIndexedBlocksRequest,
+) (*ethpb.ListBlocksResponse, error) {
+	if !featureconfig.Get().EnableBlockIndices {
+		return nil, status.Error(codes.FailedPrecondition, "Block indices are not enabled")
+	}
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+
+	f := filters.NewFilter()
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.ListIndexedBlocksRequest_ProposerIndex:
+		f = f.SetProposerIndex(q.ProposerIndex)
+	case *pbrpc.ListIndexedBlocksRequest_GraffitiPrefix:
+		if len(q.GraffitiPrefix) > 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Graffiti prefix of %d bytes is longer than 32 bytes", len(q.GraffitiPrefix))
+		}
+		f = f.SetGraffitiPrefix(q.GraffitiPrefix)
+	case *pbrpc.ListIndexedBlocksRequest_AttesterIndex:
+		f = f.SetAttesterIndex(q.AttesterIndex)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching blocks")
+	}
+
+	blks, roots, err := bs.BeaconDB.Blocks(ctx, f)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+	}
+	numBlks := len(blks)
+	if numBlks == 0 {
+		return &ethpb.ListBlocksResponse{
+			BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
+			TotalSize:       0,
+			NextPageToken:   strconv.Itoa(0),
+		}, nil
+	}
+	containers := make([]*ethpb.BeaconBlockContainer, numBlks)
+	for i, b := range blks {
+		containers[i] = &ethpb.BeaconBlockContainer{Block: b, BlockRoot: roots[i][:]}
+	}
+	sort.SliceStable(containers, func(i, j int) bool {
+		return containers[i].Block.Block.Slot < containers[j].Block.Block.Slot
+	})
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numBlks)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate blocks: %v", err)
+	}
+	containers = containers[start:end]
+	for _, c := range containers {
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, bytesutil.ToBytes32(c.BlockRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		c.Canonical = canonical
+	}
+
+	return &ethpb.ListBlocksResponse{
+		BlockContainers: containers,
+		TotalSize:       int32(numBlks),
+		NextPageToken:   nextPageToken,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListIndexedBlocks(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableBlockIndices: true})
+	defer resetCfg()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	chain := &chainMock.ChainService{CanonicalRoots: map[[32]byte]bool{}}
+
+	var roots [][32]byte
+	for i := types.Slot(0); i < 10; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = 10 - i
+		b.Block.ProposerIndex = types.ValidatorIndex(i % 2)
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte("prysm"), 32)
+		if i == 3 {
+			b.Block.Body.Graffiti = bytesutil.PadTo([]byte("lighthouse"), 32)
+		}
+		var attesters []types.ValidatorIndex
+		if i == 4 {
+			attesters = []types.ValidatorIndex{7, 8}
+		}
+		require.NoError(t, db.SaveBlocksWithAttesterIndices(ctx, []*ethpb.SignedBeaconBlock{b}, [][]types.ValidatorIndex{attesters}))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		chain.CanonicalRoots[root] = true
+		roots = append(roots, root)
+	}
+	bs := &Server{BeaconDB: db, CanonicalFetcher: chain}
+
+	res, err := bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_ProposerIndex{ProposerIndex: 1},
+		PageSize:    3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), res.TotalSize)
+	require.Equal(t, 3, len(res.BlockContainers))
+	for i, c := range res.BlockContainers {
+		assert.Equal(t, types.ValidatorIndex(1), c.Block.Block.ProposerIndex)
+		assert.Equal(t, types.Slot(2*i+1), c.Block.Block.Slot, "Blocks are not in slot order")
+		assert.Equal(t, true, c.Canonical)
+	}
+	assert.Equal(t, "1", res.NextPageToken)
+
+	res, err = bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_GraffitiPrefix{GraffitiPrefix: []byte("light")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.BlockContainers))
+	assert.DeepEqual(t, roots[3][:], res.BlockContainers[0].BlockRoot)
+
+	res, err = bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_AttesterIndex{AttesterIndex: 8},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.BlockContainers))
+	assert.DeepEqual(t, roots[4][:], res.BlockContainers[0].BlockRoot)
+
+	res, err = bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_AttesterIndex{AttesterIndex: 9},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.BlockContainers))
+
+	_, err = bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_ProposerIndex{ProposerIndex: 1},
+		PageSize:    int32(cmd.Get().MaxRPCPageSize + 1),
+	})
+	assert.ErrorContains(t, "can not be greater than max size", err)
+	_, err = bs.ListIndexedBlocks(ctx, &pbrpc.ListIndexedBlocksRequest{})
+	assert.ErrorContains(t, "Must specify a filter criteria", err)
+}
+
+func TestServer_ListIndexedBlocks_Disabled(t *testing.T) {
+	bs := &Server{BeaconDB: dbTest.SetupDB(t)}
+	_, err := bs.ListIndexedBlocks(context.Background(), &pbrpc.ListIndexedBlocksRequest{
+		QueryFilter: &pbrpc.ListIndexedBlocksRequest_ProposerIndex{ProposerIndex: 1},
+	})
+	assert.ErrorContains(t, "Block indices are not enabled", err)
+}
@@ -0,0 +1,221 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamValidatorHistory streams the balances, status and participation of a set of validators
+// over a range of completed epochs, one response per epoch.
+//
+// The states of the range are walked in slot order: a single state is regenerated for the start
+// of the range and then advanced through the canonical blocks of each following epoch, instead of
+// regenerating the state of every epoch from the last saved state. The balances and status of a
+// validator in an epoch are read from the state at the start of the epoch, and its participation
+// in the epoch from the state at the end of the next epoch, once all its attestations for the
+// epoch could be included.
+func (bs *Server) StreamValidatorHistory(
+	req *pbrpc.ValidatorHistoryRequest, stream pbrpc.ValidatorHistory_StreamValidatorHistoryServer,
+) error {
+	ctx := stream.Context()
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	if req.StartEpoch > req.EndEpoch {
+		return status.Errorf(codes.InvalidArgument, "Start epoch %d can not be greater than end epoch %d",
+			req.StartEpoch, req.EndEpoch)
+	}
+	currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(currentSlot)
+	if req.EndEpoch >= currentEpoch {
+		return status.Errorf(codes.InvalidArgument,
+			"Cannot retrieve information about an epoch that is not completed, current epoch %d, requesting %d",
+			currentEpoch, req.EndEpoch)
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	indices, err := validatorHistoryIndices(headState, req)
+	if err != nil {
+		return err
+	}
+	totalSize := len(indices)
+	nextPageToken := "0"
+	if totalSize > 0 {
+		var start, end int
+		start, end, nextPageToken, err = pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+		}
+		indices = indices[start:end]
+	}
+
+	startSlot, err := helpers.StartSlot(req.StartEpoch)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", req.StartEpoch, err)
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get state of slot %d: %v", startSlot, err)
+	}
+	summaries, err := validatorEpochSummaries(st, req.StartEpoch, indices)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not summarize validators in epoch %d: %v", req.StartEpoch, err)
+	}
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		nextEpochStart, err := helpers.StartSlot(epoch + 1)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch+1, err)
+		}
+		st, err = bs.StateGen.AdvanceState(ctx, st, nextEpochStart)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not advance state to slot %d: %v", nextEpochStart, err)
+		}
+		var nextSummaries []*pbrpc.ValidatorEpochSummary
+		if epoch < req.EndEpoch {
+			nextSummaries, err = validatorEpochSummaries(st, epoch+1, indices)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not summarize validators in epoch %d: %v", epoch+1, err)
+			}
+		}
+
+		// The attestations for the epoch can be included until the end of the next epoch.
+		participationSlot := nextEpochStart + params.BeaconConfig().SlotsPerEpoch - 1
+		if participationSlot > currentSlot {
+			participationSlot = currentSlot
+		}
+		st, err = bs.StateGen.AdvanceState(ctx, st, participationSlot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not advance state to slot %d: %v", participationSlot, err)
+		}
+		if err := setEpochParticipation(ctx, st, summaries); err != nil {
+			return status.Errorf(codes.Internal, "Could not compute participation in epoch %d: %v", epoch, err)
+		}
+
+		if err := stream.Send(&pbrpc.ValidatorHistoryResponse{
+			Epoch:         epoch,
+			Summaries:     summaries,
+			NextPageToken: nextPageToken,
+			TotalSize:     int32(totalSize),
+		}); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		summaries = nextSummaries
+	}
+	return nil
+}
+
+// validatorHistoryIndices returns the sorted and deduplicated indices of the validators requested by
+// index or public key, or all the validator indices of the head state if none were requested.
+func validatorHistoryIndices(headState *state.BeaconState, req *pbrpc.ValidatorHistoryRequest) ([]types.ValidatorIndex, error) {
+	numVals := uint64(headState.NumValidators())
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		indices := make([]types.ValidatorIndex, numVals)
+		for i := range indices {
+			indices[i] = types.ValidatorIndex(i)
+		}
+		return indices, nil
+	}
+
+	filtered := map[types.ValidatorIndex]bool{} // Track filtered validators to prevent duplication in the response.
+	indices := make([]types.ValidatorIndex, 0, len(req.Indices)+len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", pubKey)
+		}
+		if !filtered[idx] {
+			indices = append(indices, idx)
+			filtered[idx] = true
+		}
+	}
+	for _, idx := range req.Indices {
+		if uint64(idx) >= numVals {
+			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= validator count %d", idx, numVals)
+		}
+		if !filtered[idx] {
+			indices = append(indices, idx)
+			filtered[idx] = true
+		}
+	}
+	// Depending on the indices and public keys given, results might not be sorted.
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices, nil
+}
+
+// validatorEpochSummaries returns the summaries of the balances and status of the validators in the
+// epoch, from the state at the start of the epoch. Validators which were not deposited yet have an
+// unknown status.
+func validatorEpochSummaries(
+	st *state.BeaconState, epoch types.Epoch, indices []types.ValidatorIndex,
+) ([]*pbrpc.ValidatorEpochSummary, error) {
+	numVals := uint64(st.NumValidators())
+	summaries := make([]*pbrpc.ValidatorEpochSummary, len(indices))
+	for i, idx := range indices {
+		if uint64(idx) >= numVals {
+			summaries[i] = &pbrpc.ValidatorEpochSummary{Index: idx, Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+			continue
+		}
+		val, err := st.ValidatorAtIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		summaries[i] = &pbrpc.ValidatorEpochSummary{
+			Index:            idx,
+			PublicKey:        val.PublicKey,
+			Balance:          balance,
+			EffectiveBalance: val.EffectiveBalance,
+			Status:           validatorStatus(val, epoch),
+		}
+	}
+	return summaries, nil
+}
+
+// setEpochParticipation sets the participation of the summarized validators in the previous epoch of
+// the input state.
+func setEpochParticipation(ctx context.Context, st *state.BeaconState, summaries []*pbrpc.ValidatorEpochSummary) error {
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return err
+	}
+	vp, _, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		if uint64(s.Index) >= uint64(len(vp)) {
+			continue
+		}
+		v := vp[s.Index]
+		s.CorrectlyVotedSource = v.IsPrevEpochAttester
+		s.CorrectlyVotedTarget = v.IsPrevEpochTargetAttester
+		s.CorrectlyVotedHead = v.IsPrevEpochHeadAttester
+		if v.IsPrevEpochAttester {
+			s.InclusionSlot = v.InclusionSlot
+			s.InclusionDistance = v.InclusionDistance
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type validatorHistoryStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pbrpc.ValidatorHistoryResponse
+}
+
+func (s *validatorHistoryStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *validatorHistoryStream) Send(res *pbrpc.ValidatorHistoryResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+// setupValidatorHistoryServer saves a chain with a full block at every slot up to the end slot,
+// and returns a server whose current slot is the input current slot.
+func setupValidatorHistoryServer(t *testing.T, endSlot, currentSlot types.Slot) (*Server, *state.BeaconState) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	genesisStateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	for slot := types.Slot(1); slot <= endSlot; slot++ {
+		b, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: slot, Root: r[:]}))
+	}
+
+	return &Server{
+		BeaconDB:           beaconDB,
+		StateGen:           stategen.New(beaconDB),
+		HeadFetcher:        &mock.ChainService{State: st},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}, st
+}
+
+func TestServer_StreamValidatorHistory(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	endSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	currentSlot := endSlot + params.BeaconConfig().SlotsPerEpoch/2
+	bs, _ := setupValidatorHistoryServer(t, endSlot, currentSlot)
+
+	stream := &validatorHistoryStream{ctx: ctx}
+	req := &pbrpc.ValidatorHistoryRequest{StartEpoch: 0, EndEpoch: 2, Indices: []types.ValidatorIndex{7, 3, 7, 60}}
+	require.NoError(t, bs.StreamValidatorHistory(req, stream))
+	require.Equal(t, 3, len(stream.sent))
+
+	voted := false
+	for i, res := range stream.sent {
+		epoch := types.Epoch(i)
+		assert.Equal(t, epoch, res.Epoch)
+		assert.Equal(t, int32(3), res.TotalSize)
+		assert.Equal(t, "", res.NextPageToken)
+
+		// Regenerate the states of the epoch from the database for comparison.
+		startSlot, err := helpers.StartSlot(epoch)
+		require.NoError(t, err)
+		startState, err := bs.StateGen.StateBySlot(ctx, startSlot)
+		require.NoError(t, err)
+		want, err := validatorEpochSummaries(startState, epoch, []types.ValidatorIndex{3, 7, 60})
+		require.NoError(t, err)
+		participationSlot := startSlot + 2*params.BeaconConfig().SlotsPerEpoch - 1
+		if participationSlot > currentSlot {
+			participationSlot = currentSlot
+		}
+		participationState, err := bs.StateGen.StateBySlot(ctx, participationSlot)
+		require.NoError(t, err)
+		require.NoError(t, setEpochParticipation(ctx, participationState, want))
+		require.DeepEqual(t, want, res.Summaries)
+
+		for _, s := range res.Summaries {
+			assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, s.EffectiveBalance)
+			voted = voted || s.CorrectlyVotedSource
+		}
+	}
+	assert.Equal(t, true, voted, "Expected participation in at least one epoch")
+}
+
+func TestServer_StreamValidatorHistory_Pagination(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	endSlot := params.BeaconConfig().SlotsPerEpoch
+	bs, _ := setupValidatorHistoryServer(t, endSlot, endSlot+1)
+
+	stream := &validatorHistoryStream{ctx: ctx}
+	req := &pbrpc.ValidatorHistoryRequest{StartEpoch: 0, EndEpoch: 0, PageSize: 10, PageToken: "1"}
+	require.NoError(t, bs.StreamValidatorHistory(req, stream))
+	require.Equal(t, 1, len(stream.sent))
+	res := stream.sent[0]
+	assert.Equal(t, int32(64), res.TotalSize)
+	assert.Equal(t, "2", res.NextPageToken)
+	require.Equal(t, 10, len(res.Summaries))
+	for i, s := range res.Summaries {
+		assert.Equal(t, types.ValidatorIndex(10+i), s.Index)
+	}
+}
+
+func TestServer_StreamValidatorHistory_PublicKeys(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	endSlot := params.BeaconConfig().SlotsPerEpoch
+	bs, headState := setupValidatorHistoryServer(t, endSlot, endSlot+1)
+
+	pubKey := headState.PubkeyAtIndex(5)
+	stream := &validatorHistoryStream{ctx: ctx}
+	req := &pbrpc.ValidatorHistoryRequest{PublicKeys: [][]byte{pubKey[:]}, Indices: []types.ValidatorIndex{5}}
+	require.NoError(t, bs.StreamValidatorHistory(req, stream))
+	require.Equal(t, 1, len(stream.sent))
+	require.Equal(t, 1, len(stream.sent[0].Summaries))
+	assert.Equal(t, types.ValidatorIndex(5), stream.sent[0].Summaries[0].Index)
+	assert.DeepEqual(t, pubKey[:], stream.sent[0].Summaries[0].PublicKey)
+
+	req = &pbrpc.ValidatorHistoryRequest{PublicKeys: [][]byte{{'a'}}}
+	assert.ErrorContains(t, "Could not find validator index for public key", bs.StreamValidatorHistory(req, stream))
+	req = &pbrpc.ValidatorHistoryRequest{Indices: []types.ValidatorIndex{64}}
+	assert.ErrorContains(t, "Validator index 64 >= validator count 64", bs.StreamValidatorHistory(req, stream))
+}
+
+func TestServer_StreamValidatorHistory_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+	currentSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	stream := &validatorHistoryStream{ctx: ctx}
+
+	req := &pbrpc.ValidatorHistoryRequest{StartEpoch: 1, EndEpoch: 0}
+	assert.ErrorContains(t, "Start epoch 1 can not be greater than end epoch 0", bs.StreamValidatorHistory(req, stream))
+	req = &pbrpc.ValidatorHistoryRequest{StartEpoch: 0, EndEpoch: 2}
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch that is not completed", bs.StreamValidatorHistory(req, stream))
+	req = &pbrpc.ValidatorHistoryRequest{PageSize: 1 << 30}
+	assert.ErrorContains(t, "can not be greater than max size", bs.StreamValidatorHistory(req, stream))
+}
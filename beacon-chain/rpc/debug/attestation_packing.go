@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAttestationPackingReports returns the attestation packing quality reports
+// of the most recent blocks proposed through this beacon node.
+func (ds *Server) ListAttestationPackingReports(_ context.Context, _ *ptypes.Empty) (*pbrpc.AttestationPackingReports, error) {
+	if ds.AttestationPackingCache == nil {
+		return nil, status.Error(codes.Unavailable, "Attestation packing reports are not enabled")
+	}
+	return &pbrpc.AttestationPackingReports{
+		Reports: ds.AttestationPackingCache.Reports(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListAttestationPackingReports(t *testing.T) {
+	c := cache.NewAttestationPackingCache()
+	c.Add(&pbrpc.AttestationPackingReport{Slot: 1, NewVotesIncluded: 10})
+	c.Add(&pbrpc.AttestationPackingReport{Slot: 2, NewVotesIncluded: 20})
+	ds := &Server{AttestationPackingCache: c}
+	res, err := ds.ListAttestationPackingReports(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Reports))
+	assert.Equal(t, uint64(2), uint64(res.Reports[0].Slot), "Expected most recent report first")
+	assert.Equal(t, uint64(1), uint64(res.Reports[1].Slot))
+}
+
+func TestServer_ListAttestationPackingReports_Unavailable(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.ListAttestationPackingReports(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "not enabled", err)
+}
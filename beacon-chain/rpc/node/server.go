@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
@@ -38,6 +39,7 @@ type Server struct {
 	PeerManager          p2p.PeerManager
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
+	Eth1InfoFetcher      powchain.ChainInfoFetcher
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -78,8 +80,16 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.Genes
 
 // GetVersion checks the version information of the beacon node.
 func (ns *Server) GetVersion(_ context.Context, _ *ptypes.Empty) (*ethpb.Version, error) {
+	// The metadata reports the connected eth1 client, which validators may show in their graffiti.
+	var metadata string
+	if ns.Eth1InfoFetcher != nil {
+		if v := ns.Eth1InfoFetcher.Eth1ClientVersion(); v != "" {
+			metadata = "eth1_client=" + v
+		}
+	}
 	return &ethpb.Version{
-		Version: version.Version(),
+		Version:  version.Version(),
+		Metadata: metadata,
 	}, nil
 }
 
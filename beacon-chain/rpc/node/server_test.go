@@ -17,6 +17,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -77,6 +78,16 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	res, err := ns.GetVersion(context.Background(), &ptypes.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.Metadata)
+}
+
+func TestNodeServer_GetVersion_Eth1Client(t *testing.T) {
+	ns := &Server{
+		Eth1InfoFetcher: &mockPOW.POWChain{ClientVersion: "Geth/v1.10.1-stable/linux-amd64/go1.16"},
+	}
+	res, err := ns.GetVersion(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "eth1_client=Geth/v1.10.1-stable/linux-amd64/go1.16", res.Metadata)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
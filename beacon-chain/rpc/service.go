@@ -230,32 +230,37 @@ func (s *Service) Start() {
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
+	var attestationPackingCache *cache.AttestationPackingCache
+	if featureconfig.Get().EnableAttestationPackingReport {
+		attestationPackingCache = cache.NewAttestationPackingCache()
+	}
 	validatorServer := &validator.Server{
-		Ctx:                    s.ctx,
-		BeaconDB:               s.beaconDB,
-		AttestationCache:       cache.NewAttestationCache(),
-		AttPool:                s.attestationsPool,
-		ExitPool:               s.exitPool,
-		HeadFetcher:            s.headFetcher,
-		ForkFetcher:            s.forkFetcher,
-		FinalizationFetcher:    s.finalizationFetcher,
-		TimeFetcher:            s.timeFetcher,
-		CanonicalStateChan:     s.canonicalStateChan,
-		BlockFetcher:           s.powChainService,
-		DepositFetcher:         s.depositFetcher,
-		ChainStartFetcher:      s.chainStartFetcher,
-		Eth1InfoFetcher:        s.powChainService,
-		SyncChecker:            s.syncService,
-		StateNotifier:          s.stateNotifier,
-		BlockNotifier:          s.blockNotifier,
-		OperationNotifier:      s.operationNotifier,
-		P2P:                    s.p2p,
-		BlockReceiver:          s.blockReceiver,
-		MockEth1Votes:          s.mockEth1Votes,
-		Eth1BlockFetcher:       s.powChainService,
-		PendingDepositsFetcher: s.pendingDepositFetcher,
-		SlashingsPool:          s.slashingsPool,
-		StateGen:               s.stateGen,
+		Ctx:                     s.ctx,
+		BeaconDB:                s.beaconDB,
+		AttestationCache:        cache.NewAttestationCache(),
+		AttestationPackingCache: attestationPackingCache,
+		AttPool:                 s.attestationsPool,
+		ExitPool:                s.exitPool,
+		HeadFetcher:             s.headFetcher,
+		ForkFetcher:             s.forkFetcher,
+		FinalizationFetcher:     s.finalizationFetcher,
+		TimeFetcher:             s.timeFetcher,
+		CanonicalStateChan:      s.canonicalStateChan,
+		BlockFetcher:            s.powChainService,
+		DepositFetcher:          s.depositFetcher,
+		ChainStartFetcher:       s.chainStartFetcher,
+		Eth1InfoFetcher:         s.powChainService,
+		SyncChecker:             s.syncService,
+		StateNotifier:           s.stateNotifier,
+		BlockNotifier:           s.blockNotifier,
+		OperationNotifier:       s.operationNotifier,
+		P2P:                     s.p2p,
+		BlockReceiver:           s.blockReceiver,
+		MockEth1Votes:           s.mockEth1Votes,
+		Eth1BlockFetcher:        s.powChainService,
+		PendingDepositsFetcher:  s.pendingDepositFetcher,
+		SlashingsPool:           s.slashingsPool,
+		StateGen:                s.stateGen,
 	}
 	nodeServer := &node.Server{
 		LogsStreamer:         logutil.NewStreamServer(),
@@ -267,6 +272,7 @@ func (s *Service) Start() {
 		PeersFetcher:         s.peersFetcher,
 		PeerManager:          s.peerManager,
 		GenesisFetcher:       s.genesisFetcher,
+		Eth1InfoFetcher:      s.powChainService,
 		BeaconMonitoringHost: s.beaconMonitoringHost,
 		BeaconMonitoringPort: s.beaconMonitoringPort,
 	}
@@ -326,6 +332,8 @@ func (s *Service) Start() {
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterBlockIndexServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterValidatorHistoryServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
@@ -336,10 +344,13 @@ func (s *Service) Start() {
 			HeadFetcher:        s.headFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+
+			AttestationPackingCache: attestationPackingCache,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterDutiesServer(s.grpcServer, validatorServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
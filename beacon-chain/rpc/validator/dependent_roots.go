@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamDependentRoots streams the dependent roots of the current and next epoch duties
+// upon subscription, then whenever a new head, a reorg or the start of a new epoch
+// changes them. Validator clients fetch their duties again when the dependent root of
+// their duties changes.
+func (vs *Server) StreamDependentRoots(_ *ptypes.Empty, stream pbrpc.Duties_StreamDependentRootsServer) error {
+	if vs.TimeFetcher.GenesisTime().IsZero() {
+		return status.Error(codes.Unavailable, "genesis time is not set")
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	// Heads may also change without a new block, when attestations tip fork choice, and
+	// the current epoch changes at epoch boundaries, so the roots are checked every slot.
+	slotTicker := slotutil.NewSlotTicker(vs.TimeFetcher.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer slotTicker.Done()
+
+	var last *pbrpc.DependentRootsResponse
+	sendIfChanged := func() error {
+		res, err := vs.dependentRoots(stream.Context())
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not compute dependent roots: %v", err)
+		}
+		if last != nil && res.Epoch == last.Epoch &&
+			bytes.Equal(res.CurrentEpochDependentRoot, last.CurrentEpochDependentRoot) &&
+			bytes.Equal(res.NextEpochDependentRoot, last.NextEpochDependentRoot) {
+			return nil
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+		}
+		last = res
+		return nil
+	}
+
+	if err := sendIfChanged(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-slotTicker.C():
+			if err := sendIfChanged(); err != nil {
+				return err
+			}
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.BlockProcessed && ev.Type != statefeed.Reorg {
+				continue
+			}
+			if err := sendIfChanged(); err != nil {
+				return err
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream context canceled")
+		case <-vs.Ctx.Done():
+			return status.Error(codes.Canceled, "RPC context canceled")
+		}
+	}
+}
+
+// dependentRoots computes the dependent roots of the current and next epoch duties from
+// the head of the chain.
+func (vs *Server) dependentRoots(ctx context.Context) (*pbrpc.DependentRootsResponse, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	epoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	current, err := dependentRoot(headState, headRoot, epoch)
+	if err != nil {
+		return nil, err
+	}
+	next, err := dependentRoot(headState, headRoot, epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	return &pbrpc.DependentRootsResponse{
+		Epoch:                     epoch,
+		CurrentEpochDependentRoot: current,
+		NextEpochDependentRoot:    next,
+	}, nil
+}
+
+// dependentRoot returns the root of the last block before the start of the epoch in the
+// chain of the given head, or the genesis block root for the genesis epoch.
+func dependentRoot(headState *stateTrie.BeaconState, headRoot []byte, epoch types.Epoch) ([]byte, error) {
+	var slot types.Slot
+	if epoch > 0 {
+		startSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, err
+		}
+		slot = startSlot - 1
+	}
+	// The block roots of the state only cover the slots before the state slot, and the
+	// head is the last block for any later slot.
+	if slot >= headState.Slot() {
+		return headRoot, nil
+	}
+	return helpers.BlockRootAtSlot(headState, slot)
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type dependentRootsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.DependentRootsResponse
+}
+
+func (s *dependentRootsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *dependentRootsStream) Send(res *pbrpc.DependentRootsResponse) error {
+	s.sent <- res
+	return nil
+}
+
+// headRootFetcher lets tests change the head root of the mock chain service while it is used.
+type headRootFetcher struct {
+	*mockChain.ChainService
+	lock sync.Mutex
+	root []byte
+}
+
+func (f *headRootFetcher) HeadRoot(_ context.Context) ([]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.root, nil
+}
+
+func (f *headRootFetcher) setHeadRoot(root []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.root = root
+}
+
+// stateWithBlockRoots returns a state at the slot, whose block root at every earlier slot
+// is the slot number.
+func stateWithBlockRoots(t *testing.T, slot types.Slot) *stateTrie.BeaconState {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	roots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range roots {
+		roots[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 32)
+	}
+	require.NoError(t, st.SetBlockRoots(roots))
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+func TestDependentRoot(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st := stateWithBlockRoots(t, 2*slotsPerEpoch+3)
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+
+	root, err := dependentRoot(st, headRoot, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(0), 32), root)
+
+	root, err = dependentRoot(st, headRoot, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(uint64(2*slotsPerEpoch-1)), 32), root)
+
+	// The last block before the next epoch is the head.
+	root, err = dependentRoot(st, headRoot, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, headRoot, root)
+}
+
+func TestStreamDependentRoots_SendsChanges(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	slot := 2*slotsPerEpoch + 3
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	chain := &mockChain.ChainService{
+		State:   stateWithBlockRoots(t, slot),
+		Genesis: time.Now(),
+		Slot:    &slot,
+	}
+	headFetcher := &headRootFetcher{ChainService: chain, root: headRoot}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   headFetcher,
+		TimeFetcher:   chain,
+		StateNotifier: &mockChain.MockStateNotifier{},
+	}
+	stream := &dependentRootsStream{ctx: ctx, sent: make(chan *pbrpc.DependentRootsResponse, 2)}
+	exited := make(chan error)
+	go func() {
+		exited <- vs.StreamDependentRoots(&ptypes.Empty{}, stream)
+	}()
+
+	res := <-stream.sent
+	assert.Equal(t, types.Epoch(2), res.Epoch)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(uint64(2*slotsPerEpoch-1)), 32), res.CurrentEpochDependentRoot)
+	assert.DeepEqual(t, headRoot, res.NextEpochDependentRoot)
+
+	// A new block which does not change the dependent roots is not sent.
+	for sent := 0; sent == 0; {
+		sent = vs.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.BlockProcessed})
+	}
+	// A reorg to another head changes the dependent root of the next epoch.
+	newHeadRoot := bytesutil.PadTo([]byte("new head"), 32)
+	headFetcher.setHeadRoot(newHeadRoot)
+	for sent := 0; sent == 0; {
+		sent = vs.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.Reorg, Data: &statefeed.ReorgData{}})
+	}
+	res = <-stream.sent
+	assert.Equal(t, types.Epoch(2), res.Epoch)
+	assert.DeepEqual(t, newHeadRoot, res.NextEpochDependentRoot)
+
+	cancel()
+	require.ErrorContains(t, "context canceled", <-exited)
+}
@@ -546,11 +546,21 @@ func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, st *s
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
 	defer span.End()
 
+	validAtts, err := vs.validAttestationsForBlockInclusion(ctx, st, atts)
+	if err != nil {
+		return nil, err
+	}
+	return validAtts.dedup().sortByProfitability().limitToMaxAttestations(), nil
+}
+
+// validAttestationsForBlockInclusion returns the attestations which can be included in a block
+// built on top of the given state. Invalid attestations are deleted from the pool.
+func (vs *Server) validAttestationsForBlockInclusion(ctx context.Context, st *stateTrie.BeaconState, atts []*ethpb.Attestation) (proposerAtts, error) {
 	validAtts, invalidAtts := proposerAtts(atts).filter(ctx, st)
 	if err := vs.deleteAttsInPool(ctx, invalidAtts); err != nil {
 		return nil, err
 	}
-	return validAtts.dedup().sortByProfitability().limitToMaxAttestations(), nil
+	return validAtts, nil
 }
 
 // The input attestations are processed and seen by the node, this deletes them from pool
@@ -592,30 +602,60 @@ func (vs *Server) packAttestations(ctx context.Context, latestState *stateTrie.B
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
+	// Filtering processes the candidate attestations against the state, so the packing
+	// report needs a copy of the state taken before any attestation is counted.
+	var preState *stateTrie.BeaconState
+	if vs.AttestationPackingCache != nil {
+		preState = latestState.Copy()
+	}
+	atts, stats, err := vs.packAttestationsWithStats(ctx, latestState)
+	if err != nil {
+		return nil, err
+	}
+	if preState != nil {
+		go vs.recordAttestationPacking(vs.Ctx, preState, stats)
+	}
+	return atts, nil
+}
+
+// packAttestationsWithStats selects the attestations from the pool to pack into a block built on
+// top of the given state, along with measurements of the packing process.
+func (vs *Server) packAttestationsWithStats(ctx context.Context, latestState *stateTrie.BeaconState) ([]*ethpb.Attestation, *packingStats, error) {
+	if featureconfig.Get().EnableRewardMaximizingAttestations {
+		return vs.packAttestationsByReward(ctx, latestState)
+	}
+	start := time.Now()
 	atts := vs.AttPool.AggregatedAttestations()
-	atts, err := vs.filterAttestationsForBlockInclusion(ctx, latestState, atts)
+	poolSize := len(atts)
+	validAtts, err := vs.validAttestationsForBlockInclusion(ctx, latestState, atts)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not filter attestations")
+		return nil, nil, errors.Wrap(err, "could not filter attestations")
 	}
+	candidates := make([]*ethpb.Attestation, len(validAtts))
+	copy(candidates, validAtts)
+	atts = validAtts.dedup().sortByProfitability().limitToMaxAttestations()
 
 	// If there is any room left in the block, consider unaggregated attestations as well.
 	numAtts := uint64(len(atts))
 	if numAtts < params.BeaconConfig().MaxAttestations {
 		uAtts, err := vs.AttPool.UnaggregatedAttestations()
 		if err != nil {
-			return nil, errors.Wrap(err, "could not get unaggregated attestations")
+			return nil, nil, errors.Wrap(err, "could not get unaggregated attestations")
 		}
-		uAtts, err = vs.filterAttestationsForBlockInclusion(ctx, latestState, uAtts)
+		poolSize += len(uAtts)
+		validUAtts, err := vs.validAttestationsForBlockInclusion(ctx, latestState, uAtts)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not filter attestations")
+			return nil, nil, errors.Wrap(err, "could not filter attestations")
 		}
+		candidates = append(candidates, validUAtts...)
+		uAtts = validUAtts.dedup().sortByProfitability().limitToMaxAttestations()
 		atts = append(atts, uAtts...)
 
 		attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation, len(atts))
 		for _, att := range atts {
 			attDataRoot, err := att.Data.HashTreeRoot()
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			attsByDataRoot[attDataRoot] = append(attsByDataRoot[attDataRoot], att)
 		}
@@ -624,11 +664,71 @@ func (vs *Server) packAttestations(ctx context.Context, latestState *stateTrie.B
 		for _, as := range attsByDataRoot {
 			as, err := attaggregation.Aggregate(as)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			attsForInclusion = append(attsForInclusion, as...)
 		}
 		atts = attsForInclusion.dedup().sortByProfitability().limitToMaxAttestations()
 	}
-	return atts, nil
+
+	return atts, &packingStats{
+		poolSize:   poolSize,
+		candidates: candidates,
+		packed:     atts,
+		duration:   time.Since(start),
+	}, nil
+}
+
+// packAttestationsByReward selects the attestations from the pool which maximize the proposer
+// reward of a block built on top of the given state. Attestations sharing the same data are
+// aggregated first, then the aggregates are selected by the reward of the votes they add to
+// the state's current and previous epoch participation.
+func (vs *Server) packAttestationsByReward(ctx context.Context, latestState *stateTrie.BeaconState) ([]*ethpb.Attestation, *packingStats, error) {
+	start := time.Now()
+	// Participation must be computed before filtering, which processes the candidates
+	// against the state.
+	votes, err := newAttVotes(ctx, latestState)
+	if err != nil {
+		return nil, nil, err
+	}
+	atts := vs.AttPool.AggregatedAttestations()
+	uAtts, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	poolSize := len(atts) + len(uAtts)
+	validAtts, err := vs.validAttestationsForBlockInclusion(ctx, latestState, append(atts, uAtts...))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not filter attestations")
+	}
+	candidates := make([]*ethpb.Attestation, len(validAtts))
+	copy(candidates, validAtts)
+
+	attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation, len(validAtts))
+	for _, att := range validAtts.dedup() {
+		attDataRoot, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		attsByDataRoot[attDataRoot] = append(attsByDataRoot[attDataRoot], att)
+	}
+	aggregated := make([]*ethpb.Attestation, 0, len(attsByDataRoot))
+	for _, as := range attsByDataRoot {
+		as, err := attaggregation.Aggregate(as)
+		if err != nil {
+			return nil, nil, err
+		}
+		aggregated = append(aggregated, as...)
+	}
+	selected, err := votes.selectByReward(aggregated, params.BeaconConfig().MaxAttestations)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not select attestations by reward")
+	}
+
+	return selected, &packingStats{
+		poolSize:   poolSize,
+		candidates: candidates,
+		packed:     selected,
+		duration:   time.Since(start),
+	}, nil
 }
@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/aggregation"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// attVote identifies the vote of a validator for a target epoch.
+type attVote struct {
+	epoch     types.Epoch
+	validator uint64
+}
+
+// attVotes keeps track of which validator votes for the previous and current epochs
+// are already counted in a beacon state, so that the contribution of candidate
+// attestations to a block proposal can be measured.
+type attVotes struct {
+	state        *stateTrie.BeaconState
+	validators   []*precompute.Validator
+	currentEpoch types.Epoch
+	prevEpoch    types.Epoch
+	balanceSqrt  uint64
+}
+
+// newAttVotes computes the epoch participation recorded in the given state.
+func newAttVotes(ctx context.Context, st *stateTrie.BeaconState) (*attVotes, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.newAttVotes")
+	defer span.End()
+
+	vp, bal, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize epoch participation")
+	}
+	vp, bal, err = precompute.ProcessAttestations(ctx, st, vp, bal)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process epoch participation")
+	}
+	balanceSqrt := mathutil.IntegerSquareRoot(bal.ActiveCurrentEpoch)
+	// Balance square root cannot be 0, this prevents division by 0.
+	if balanceSqrt == 0 {
+		balanceSqrt = 1
+	}
+	return &attVotes{
+		state:        st,
+		validators:   vp,
+		currentEpoch: helpers.CurrentEpoch(st),
+		prevEpoch:    helpers.PrevEpoch(st),
+		balanceSqrt:  balanceSqrt,
+	}, nil
+}
+
+// newVotes returns the validator votes of an attestation which are not yet counted
+// in the state's previous or current epoch participation.
+func (v *attVotes) newVotes(att *ethpb.Attestation) ([]attVote, error) {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return nil, errors.New("nil attestation data")
+	}
+	epoch := att.Data.Target.Epoch
+	if epoch != v.currentEpoch && epoch != v.prevEpoch {
+		return nil, nil
+	}
+	committee, err := helpers.BeaconCommitteeFromState(v.state, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]attVote, 0, len(indices))
+	for _, idx := range indices {
+		if idx >= uint64(len(v.validators)) {
+			return nil, errors.Errorf("validator index %d out of range", idx)
+		}
+		val := v.validators[idx]
+		if val.IsSlashed {
+			continue
+		}
+		if epoch == v.currentEpoch && val.IsCurrentEpochAttester {
+			continue
+		}
+		if epoch == v.prevEpoch && epoch != v.currentEpoch && val.IsPrevEpochAttester {
+			continue
+		}
+		votes = append(votes, attVote{epoch: epoch, validator: idx})
+	}
+	return votes, nil
+}
+
+// proposerReward returns the reward in Gwei the proposer receives for including
+// the given validator vote.
+func (v *attVotes) proposerReward(vote attVote) uint64 {
+	cfg := params.BeaconConfig()
+	effectiveBalance := v.validators[vote.validator].CurrentEpochEffectiveBalance
+	baseReward := effectiveBalance * cfg.BaseRewardFactor / v.balanceSqrt / cfg.BaseRewardsPerEpoch
+	return baseReward / cfg.ProposerRewardQuotient
+}
+
+// coverage returns the number of distinct new votes across the given attestations,
+// along with the total proposer reward for including them.
+func (v *attVotes) coverage(atts []*ethpb.Attestation) (uint64, uint64, error) {
+	seen := make(map[attVote]bool)
+	var reward uint64
+	for _, att := range atts {
+		votes, err := v.newVotes(att)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, vote := range votes {
+			if seen[vote] {
+				continue
+			}
+			seen[vote] = true
+			reward += v.proposerReward(vote)
+		}
+	}
+	return uint64(len(seen)), reward, nil
+}
+
+// maxCoverage returns an upper bound of the number of new votes and of the proposer reward
+// achievable by packing at most limit of the given attestations into a block. It is the coverage
+// of all the attestations, capped by the sum of the limit largest coverages of single attestations.
+func (v *attVotes) maxCoverage(atts []*ethpb.Attestation, limit uint64) (uint64, uint64, error) {
+	totalVotes, totalReward, err := v.coverage(atts)
+	if err != nil {
+		return 0, 0, err
+	}
+	if uint64(len(atts)) <= limit {
+		return totalVotes, totalReward, nil
+	}
+	attVotes := make([]uint64, len(atts))
+	attRewards := make([]uint64, len(atts))
+	for i, att := range atts {
+		attVotes[i], attRewards[i], err = v.coverage([]*ethpb.Attestation{att})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return mathutil.Min(totalVotes, sumOfLargest(attVotes, limit)),
+		mathutil.Min(totalReward, sumOfLargest(attRewards, limit)), nil
+}
+
+// sumOfLargest returns the sum of the n largest values.
+func sumOfLargest(values []uint64, n uint64) uint64 {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] > values[j]
+	})
+	var sum uint64
+	for i := uint64(0); i < n && i < uint64(len(values)); i++ {
+		sum += values[i]
+	}
+	return sum
+}
+
+// selectByReward selects up to limit attestations which maximize the total proposer reward of
+// the new votes they include. This is solved as a reward-weighted maximum coverage problem where
+// the universe is the set of new votes across all attestations, so that attestations whose
+// votes are already counted, or covered by other selected attestations, are not packed.
+func (v *attVotes) selectByReward(atts []*ethpb.Attestation, limit uint64) ([]*ethpb.Attestation, error) {
+	voteIndices := make(map[attVote]int)
+	weights := make([]uint64, 0)
+	attVoteIndices := make([][]int, len(atts))
+	for i, att := range atts {
+		votes, err := v.newVotes(att)
+		if err != nil {
+			return nil, err
+		}
+		for _, vote := range votes {
+			idx, ok := voteIndices[vote]
+			if !ok {
+				idx = len(weights)
+				voteIndices[vote] = idx
+				weights = append(weights, v.proposerReward(vote))
+			}
+			attVoteIndices[i] = append(attVoteIndices[i], idx)
+		}
+	}
+	if len(weights) == 0 {
+		return []*ethpb.Attestation{}, nil
+	}
+
+	candidates := make(aggregation.MaxCoverCandidates, len(atts))
+	for i, indices := range attVoteIndices {
+		bits := bitfield.NewBitlist(uint64(len(weights)))
+		for _, idx := range indices {
+			bits.SetBitAt(uint64(idx), true)
+		}
+		candidates[i] = aggregation.NewMaxCoverCandidate(i, &bits)
+	}
+	problem := &aggregation.MaxCoverProblem{Candidates: candidates, Weights: weights}
+	solution, err := problem.Cover(int(limit), true /* allowOverlaps */)
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]*ethpb.Attestation, len(solution.Keys))
+	for i, key := range solution.Keys {
+		selected[i] = atts[key]
+	}
+	return selected, nil
+}
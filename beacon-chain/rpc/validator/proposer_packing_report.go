@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	packedAttestationsCandidates = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_packed_attestations_candidate_pool_size",
+		Help: "The number of attestations in the pool considered for the last block proposal",
+	})
+	packedAttestationsNewVotes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_packed_attestations_new_votes",
+		Help: "The number of uncounted validator votes included in the last block proposal",
+	})
+	packedAttestationsMaxNewVotes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_packed_attestations_max_new_votes",
+		Help: "The number of uncounted validator votes available for the last block proposal",
+	})
+	packedAttestationsExpectedReward = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_packed_attestations_expected_reward_gwei",
+		Help: "The expected proposer reward for the attestations included in the last block proposal",
+	})
+	packedAttestationsMaxExpectedReward = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_packed_attestations_max_expected_reward_gwei",
+		Help: "The proposer reward achievable with every available vote for the last block proposal",
+	})
+	packedAttestationsTime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "proposer_packed_attestations_time_milliseconds",
+		Help:    "Time spent selecting the attestations to pack into a block proposal",
+		Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500},
+	})
+)
+
+// rewardMaxCoverStrategy is the strategy reported when attestations are selected by proposer reward.
+const rewardMaxCoverStrategy = "reward_max_cover"
+
+// packingStats holds the raw measurements taken while packing attestations
+// for a block proposal.
+type packingStats struct {
+	poolSize   int
+	candidates []*ethpb.Attestation
+	packed     []*ethpb.Attestation
+	duration   time.Duration
+}
+
+// attestationPackingReport measures the quality of the attestations packed into a
+// block proposal against the best result achievable from the valid candidates.
+func attestationPackingReport(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	stats *packingStats,
+) (*pbrpc.AttestationPackingReport, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.attestationPackingReport")
+	defer span.End()
+
+	votes, err := newAttVotes(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	newVotes, reward, err := votes.coverage(stats.packed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute votes of packed attestations")
+	}
+	// No more than the maximum number of attestations of a block can be packed.
+	maxNewVotes, maxReward, err := votes.maxCoverage(stats.candidates, params.BeaconConfig().MaxAttestations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute votes of candidate attestations")
+	}
+	strategy := featureconfig.Get().AttestationAggregationStrategy
+	if strategy == "" {
+		strategy = string(attaggregation.NaiveAggregation)
+	}
+	if featureconfig.Get().EnableRewardMaximizingAttestations {
+		strategy = rewardMaxCoverStrategy
+	}
+	return &pbrpc.AttestationPackingReport{
+		Slot:               st.Slot(),
+		CandidatePoolSize:  uint64(stats.poolSize),
+		ValidCandidates:    uint64(len(stats.candidates)),
+		PackedAttestations: uint64(len(stats.packed)),
+		NewVotesIncluded:   newVotes,
+		MaxNewVotes:        maxNewVotes,
+		ExpectedReward:     reward,
+		MaxExpectedReward:  maxReward,
+		PackingTimeMs:      uint64(stats.duration.Milliseconds()),
+		Strategy:           strategy,
+	}, nil
+}
+
+// ReplayAttestationPacking packs a pool of attestations into a block built on top of the given
+// state with the configured aggregation strategy, as a proposer would, and returns the packing
+// report. It does not depend on a running node and is used to compare packing strategies offline.
+func ReplayAttestationPacking(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	pool []*ethpb.Attestation,
+) (*pbrpc.AttestationPackingReport, error) {
+	attPool := attestations.NewPool()
+	for _, att := range pool {
+		if helpers.IsAggregated(att) {
+			if err := attPool.SaveAggregatedAttestation(att); err != nil {
+				return nil, errors.Wrap(err, "could not save aggregated attestation")
+			}
+			continue
+		}
+		if err := attPool.SaveUnaggregatedAttestation(att); err != nil {
+			return nil, errors.Wrap(err, "could not save unaggregated attestation")
+		}
+	}
+	vs := &Server{AttPool: attPool}
+	_, stats, err := vs.packAttestationsWithStats(ctx, st.Copy())
+	if err != nil {
+		return nil, err
+	}
+	return attestationPackingReport(ctx, st, stats)
+}
+
+// recordAttestationPacking computes the packing report of a block proposal, exports
+// it as metrics and stores it for the debug endpoint. It is run in the background so
+// that the proposal critical path is not slowed down.
+func (vs *Server) recordAttestationPacking(ctx context.Context, st *stateTrie.BeaconState, stats *packingStats) {
+	report, err := attestationPackingReport(ctx, st, stats)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute attestation packing report")
+		return
+	}
+	packedAttestationsCandidates.Set(float64(report.CandidatePoolSize))
+	packedAttestationsNewVotes.Set(float64(report.NewVotesIncluded))
+	packedAttestationsMaxNewVotes.Set(float64(report.MaxNewVotes))
+	packedAttestationsExpectedReward.Set(float64(report.ExpectedReward))
+	packedAttestationsMaxExpectedReward.Set(float64(report.MaxExpectedReward))
+	packedAttestationsTime.Observe(float64(report.PackingTimeMs))
+	if vs.AttestationPackingCache != nil {
+		vs.AttestationPackingCache.Add(report)
+	}
+	log.WithFields(logrus.Fields{
+		"slot":              report.Slot,
+		"candidates":        report.ValidCandidates,
+		"packed":            report.PackedAttestations,
+		"newVotes":          report.NewVotesIncluded,
+		"maxNewVotes":       report.MaxNewVotes,
+		"expectedReward":    report.ExpectedReward,
+		"maxExpectedReward": report.MaxExpectedReward,
+		"packingTimeMs":     report.PackingTimeMs,
+	}).Debug("Attestation packing report")
+}
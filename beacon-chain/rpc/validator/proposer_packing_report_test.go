@@ -0,0 +1,205 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttVotes_Coverage(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	testutil.ResetCache()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(1))
+	atts, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(atts))
+
+	votes, err := newAttVotes(ctx, st)
+	require.NoError(t, err)
+
+	newVotes, reward, err := votes.coverage([]*ethpb.Attestation{atts[0], atts[0]})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), newVotes, "Duplicate votes should be counted once")
+	single := reward
+	assert.NotEqual(t, uint64(0), single)
+
+	newVotes, reward, err = votes.coverage(atts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), newVotes)
+	assert.Equal(t, 2*single, reward)
+
+	// Votes already counted in the state do not contribute to the proposer reward.
+	require.NoError(t, st.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+		AggregationBits: atts[0].AggregationBits,
+		Data:            atts[0].Data,
+		InclusionDelay:  1,
+	}))
+	votes, err = newAttVotes(ctx, st)
+	require.NoError(t, err)
+	newVotes, reward, err = votes.coverage(atts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), newVotes)
+	assert.Equal(t, single, reward)
+}
+
+func TestAttVotes_MaxCoverage(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	testutil.ResetCache()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(1))
+	atts, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(atts))
+
+	votes, err := newAttVotes(ctx, st)
+	require.NoError(t, err)
+	_, single, err := votes.coverage(atts[:1])
+	require.NoError(t, err)
+
+	newVotes, reward, err := votes.maxCoverage(atts, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), newVotes)
+	assert.Equal(t, 2*single, reward)
+
+	// No more votes than the ones of the attestations which fit in a block can be packed.
+	newVotes, reward, err = votes.maxCoverage(atts, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), newVotes)
+	assert.Equal(t, single, reward)
+}
+
+func TestReplayAttestationPacking(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	testutil.ResetCache()
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{AttestationAggregationStrategy: "max_cover"})
+	defer resetCfg()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(1))
+	atts, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+
+	report, err := ReplayAttestationPacking(ctx, st, atts)
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), report.Slot)
+	assert.Equal(t, "max_cover", report.Strategy)
+	assert.Equal(t, uint64(1), report.PackedAttestations, "Unaggregated attestations should be aggregated")
+	assert.Equal(t, uint64(2), report.NewVotesIncluded)
+	assert.Equal(t, report.MaxNewVotes, report.NewVotesIncluded)
+	assert.Equal(t, report.MaxExpectedReward, report.ExpectedReward)
+	assert.NotEqual(t, uint64(0), report.ExpectedReward)
+}
+
+func TestServer_RecordAttestationPacking(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	testutil.ResetCache()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(1))
+	atts, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+
+	vs := &Server{AttestationPackingCache: cache.NewAttestationPackingCache()}
+	vs.recordAttestationPacking(ctx, st, &packingStats{
+		poolSize:   len(atts),
+		candidates: atts,
+		packed:     atts[:1],
+	})
+	reports := vs.AttestationPackingCache.Reports()
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, uint64(2), reports[0].CandidatePoolSize)
+	assert.Equal(t, uint64(1), reports[0].NewVotesIncluded)
+	assert.Equal(t, uint64(2), reports[0].MaxNewVotes)
+	assert.Equal(t, reports[0].MaxExpectedReward, 2*reports[0].ExpectedReward)
+}
+
+func TestServer_PackAttestationsByReward(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig()
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+	helpers.ClearCache()
+	testutil.ResetCache()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(4))
+
+	// The latest attestation has the most bits but its votes are already counted in the state.
+	counted, err := testutil.GenerateAttestations(st, privKeys, 1, 2, false)
+	require.NoError(t, err)
+	require.NoError(t, st.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+		AggregationBits: counted[0].AggregationBits,
+		Data:            counted[0].Data,
+		InclusionDelay:  1,
+	}))
+	first, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+	second, err := testutil.GenerateAttestations(st, privKeys, 2, 1, false)
+	require.NoError(t, err)
+	pool := []*ethpb.Attestation{counted[0], first[0], second[0]}
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{})
+	current, err := ReplayAttestationPacking(ctx, st, pool)
+	require.NoError(t, err)
+	resetCfg()
+
+	resetCfg = featureconfig.InitWithReset(&featureconfig.Flags{EnableRewardMaximizingAttestations: true})
+	defer resetCfg()
+	byReward, err := ReplayAttestationPacking(ctx, st, pool)
+	require.NoError(t, err)
+
+	assert.Equal(t, rewardMaxCoverStrategy, byReward.Strategy)
+	assert.Equal(t, uint64(2), current.PackedAttestations)
+	assert.Equal(t, uint64(1), current.NewVotesIncluded, "Expected the current strategy to pack the counted attestation")
+	assert.Equal(t, uint64(2), byReward.PackedAttestations)
+	assert.Equal(t, uint64(2), byReward.NewVotesIncluded)
+	assert.Equal(t, byReward.MaxExpectedReward, byReward.ExpectedReward)
+	assert.Equal(t, true, byReward.ExpectedReward > current.ExpectedReward, "Expected a higher reward than the current strategy")
+}
+
+func TestAttVotes_SelectByReward(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	testutil.ResetCache()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(4))
+	aggregated, err := testutil.GenerateAttestations(st, privKeys, 1, 0, false)
+	require.NoError(t, err)
+	split, err := testutil.GenerateAttestations(st, privKeys, 2, 0, false)
+	require.NoError(t, err)
+
+	votes, err := newAttVotes(ctx, st)
+	require.NoError(t, err)
+
+	// The aggregate covers both unaggregated attestations, which are therefore not selected.
+	atts := []*ethpb.Attestation{split[0], aggregated[0], split[1]}
+	selected, err := votes.selectByReward(atts, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{aggregated[0]}, selected)
+
+	selected, err = votes.selectByReward(nil, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(selected))
+}
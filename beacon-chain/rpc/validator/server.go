@@ -29,6 +29,7 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,31 +39,42 @@ import (
 // and committees in which particular validators need to perform their responsibilities,
 // and more.
 type Server struct {
-	Ctx                    context.Context
-	BeaconDB               db.NoHeadAccessDatabase
-	AttestationCache       *cache.AttestationCache
-	HeadFetcher            blockchain.HeadFetcher
-	ForkFetcher            blockchain.ForkFetcher
-	FinalizationFetcher    blockchain.FinalizationFetcher
-	TimeFetcher            blockchain.TimeFetcher
-	CanonicalStateChan     chan *pbp2p.BeaconState
-	BlockFetcher           powchain.POWBlockFetcher
-	DepositFetcher         depositcache.DepositFetcher
-	ChainStartFetcher      powchain.ChainStartFetcher
-	Eth1InfoFetcher        powchain.ChainInfoFetcher
-	SyncChecker            sync.Checker
-	StateNotifier          statefeed.Notifier
-	BlockNotifier          blockfeed.Notifier
-	P2P                    p2p.Broadcaster
-	AttPool                attestations.Pool
-	SlashingsPool          slashings.PoolManager
-	ExitPool               voluntaryexits.PoolManager
-	BlockReceiver          blockchain.BlockReceiver
-	MockEth1Votes          bool
-	Eth1BlockFetcher       powchain.POWBlockFetcher
-	PendingDepositsFetcher depositcache.PendingDepositsFetcher
-	OperationNotifier      opfeed.Notifier
-	StateGen               *stategen.State
+	Ctx                     context.Context
+	BeaconDB                db.NoHeadAccessDatabase
+	AttestationCache        *cache.AttestationCache
+	AttestationPackingCache *cache.AttestationPackingCache
+	HeadFetcher             blockchain.HeadFetcher
+	ForkFetcher             blockchain.ForkFetcher
+	FinalizationFetcher     blockchain.FinalizationFetcher
+	TimeFetcher             blockchain.TimeFetcher
+	CanonicalStateChan      chan *pbp2p.BeaconState
+	BlockFetcher            powchain.POWBlockFetcher
+	DepositFetcher          depositcache.DepositFetcher
+	ChainStartFetcher       powchain.ChainStartFetcher
+	Eth1InfoFetcher         powchain.ChainInfoFetcher
+	SyncChecker             sync.Checker
+	StateNotifier           statefeed.Notifier
+	BlockNotifier           blockfeed.Notifier
+	P2P                     p2p.Broadcaster
+	AttPool                 attestations.Pool
+	SlashingsPool           slashings.PoolManager
+	ExitPool                voluntaryexits.PoolManager
+	BlockReceiver           blockchain.BlockReceiver
+	MockEth1Votes           bool
+	Eth1BlockFetcher        powchain.POWBlockFetcher
+	PendingDepositsFetcher  depositcache.PendingDepositsFetcher
+	OperationNotifier       opfeed.Notifier
+	StateGen                *stategen.State
+	// Clock returns the current time of the server, the local clock if not set.
+	Clock func() time.Time
+}
+
+// now returns the current time of the server's clock.
+func (vs *Server) now() time.Time {
+	if vs.Clock == nil {
+		return timeutils.Now()
+	}
+	return vs.Clock()
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -0,0 +1,471 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// listChunkSize is the number of elements in a chunk of a chunked list.
+const listChunkSize = 256
+
+// The validators, balances, randao mixes, block roots and state roots of the beacon state are stored
+// in chunked lists: lists split into chunks of a fixed number of elements, which are reference
+// counted and shared by the lists copied from each other.
+//
+// A list is shared as a whole by the copies of a state, tracked by the shared field references of
+// the state like the other large fields. A state changing an element of a list it shares copies
+// the list, which only copies the references to its chunks, and the chunk holding the element is
+// in turn copied if it is shared with another list. Copying a state and changing a few elements of
+// its lists therefore costs proportional to the number of chunks and the changed chunks, rather
+// than to the number of elements of the lists.
+//
+// A list releases its chunks once no state references it anymore, when the last state holding it
+// replaces the list or is dropped. A list is not safe for concurrent use, it is guarded by the
+// lock of the state holding it.
+
+// chunkedList is implemented by the chunked lists.
+type chunkedList interface {
+	len() int
+}
+
+// releaseFieldReference removes the reference of the state to a shared field, and releases the
+// chunked list of the field once no state references it anymore.
+func (b *BeaconState) releaseFieldReference(field fieldIndex) {
+	ref, ok := b.sharedFieldReferences[field]
+	if !ok || !ref.ReleaseRef() {
+		return
+	}
+	switch field {
+	case validators:
+		b.validatorList.release()
+	case balances:
+		b.balanceList.release()
+	case randaoMixes:
+		b.randaoMixList.release()
+	case blockRoots:
+		b.blockRootList.release()
+	case stateRoots:
+		b.stateRootList.release()
+	}
+}
+
+// numOfChunks returns the number of chunks holding the input number of elements.
+func numOfChunks(length int) int {
+	return (length + listChunkSize - 1) / listChunkSize
+}
+
+// chunkShare returns the number of elements of the chunk at the input index of a list of the input
+// length, divided by the number of lists referencing the chunk.
+func chunkShare(ref *reference, chunkIdx, length int) uint64 {
+	elems := listChunkSize
+	if rest := length - chunkIdx*listChunkSize; rest < elems {
+		elems = rest
+	}
+	return perReference(ref, uint64(elems))
+}
+
+// uint64List is a chunked list of uint64 values, used for the balances.
+type uint64List struct {
+	chunks []*uint64Chunk
+	length int
+}
+
+type uint64Chunk struct {
+	*reference
+	values [listChunkSize]uint64
+}
+
+// newUint64List copies the input values into a chunked list. It returns nil for nil values.
+func newUint64List(values []uint64) *uint64List {
+	if values == nil {
+		return nil
+	}
+	l := &uint64List{
+		chunks: make([]*uint64Chunk, numOfChunks(len(values))),
+		length: len(values),
+	}
+	for i := range l.chunks {
+		c := &uint64Chunk{reference: &reference{refs: 1}}
+		copy(c.values[:], values[i*listChunkSize:])
+		l.chunks[i] = c
+	}
+	return l
+}
+
+// copy returns a copy of the list sharing its chunks.
+func (l *uint64List) copy() *uint64List {
+	if l == nil {
+		return nil
+	}
+	dst := &uint64List{
+		chunks: make([]*uint64Chunk, len(l.chunks)),
+		length: l.length,
+	}
+	for i, c := range l.chunks {
+		c.AddRef()
+		dst.chunks[i] = c
+	}
+	return dst
+}
+
+// release removes the references of the list to its chunks, once it is no longer used.
+func (l *uint64List) release() {
+	if l == nil {
+		return
+	}
+	for _, c := range l.chunks {
+		c.MinusRef()
+	}
+}
+
+func (l *uint64List) len() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+func (l *uint64List) at(idx int) uint64 {
+	return l.chunks[idx/listChunkSize].values[idx%listChunkSize]
+}
+
+// set changes the value at the input index, copying its chunk first if it is shared.
+func (l *uint64List) set(idx int, val uint64) {
+	l.writableChunk(idx / listChunkSize).values[idx%listChunkSize] = val
+}
+
+func (l *uint64List) append(val uint64) {
+	if l.length%listChunkSize == 0 {
+		l.chunks = append(l.chunks, &uint64Chunk{reference: &reference{refs: 1}})
+	}
+	l.length++
+	l.set(l.length-1, val)
+}
+
+func (l *uint64List) writableChunk(chunkIdx int) *uint64Chunk {
+	c := l.chunks[chunkIdx]
+	if c.Refs() > 1 {
+		c.MinusRef()
+		c = &uint64Chunk{reference: &reference{refs: 1}, values: c.values}
+		l.chunks[chunkIdx] = c
+	}
+	return c
+}
+
+// slice returns the values of the list in a new slice, nil if the list is nil.
+func (l *uint64List) slice() []uint64 {
+	if l == nil {
+		return nil
+	}
+	res := make([]uint64, l.length)
+	for i, c := range l.chunks {
+		copy(res[i*listChunkSize:], c.values[:])
+	}
+	return res
+}
+
+// sharedLen returns the number of elements of the list, where the elements of the chunks shared
+// with other lists are divided between them.
+func (l *uint64List) sharedLen() uint64 {
+	if l == nil {
+		return 0
+	}
+	var n uint64
+	for i, c := range l.chunks {
+		n += chunkShare(c.reference, i, l.length)
+	}
+	return n
+}
+
+// validatorList is a chunked list of validators. The validators are shared by the copies of the
+// list, a validator is replaced rather than changed in place.
+type validatorList struct {
+	chunks []*validatorChunk
+	length int
+}
+
+type validatorChunk struct {
+	*reference
+	values [listChunkSize]*ethpb.Validator
+}
+
+// newValidatorList copies the input validator references into a chunked list. It returns nil for
+// nil validators.
+func newValidatorList(values []*ethpb.Validator) *validatorList {
+	if values == nil {
+		return nil
+	}
+	l := &validatorList{
+		chunks: make([]*validatorChunk, numOfChunks(len(values))),
+		length: len(values),
+	}
+	for i := range l.chunks {
+		c := &validatorChunk{reference: &reference{refs: 1}}
+		copy(c.values[:], values[i*listChunkSize:])
+		l.chunks[i] = c
+	}
+	return l
+}
+
+// copy returns a copy of the list sharing its chunks.
+func (l *validatorList) copy() *validatorList {
+	if l == nil {
+		return nil
+	}
+	dst := &validatorList{
+		chunks: make([]*validatorChunk, len(l.chunks)),
+		length: l.length,
+	}
+	for i, c := range l.chunks {
+		c.AddRef()
+		dst.chunks[i] = c
+	}
+	return dst
+}
+
+// release removes the references of the list to its chunks, once it is no longer used.
+func (l *validatorList) release() {
+	if l == nil {
+		return
+	}
+	for _, c := range l.chunks {
+		c.MinusRef()
+	}
+}
+
+func (l *validatorList) len() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+func (l *validatorList) at(idx int) *ethpb.Validator {
+	return l.chunks[idx/listChunkSize].values[idx%listChunkSize]
+}
+
+// set replaces the validator at the input index, copying its chunk first if it is shared.
+func (l *validatorList) set(idx int, val *ethpb.Validator) {
+	l.writableChunk(idx / listChunkSize).values[idx%listChunkSize] = val
+}
+
+func (l *validatorList) append(val *ethpb.Validator) {
+	if l.length%listChunkSize == 0 {
+		l.chunks = append(l.chunks, &validatorChunk{reference: &reference{refs: 1}})
+	}
+	l.length++
+	l.set(l.length-1, val)
+}
+
+func (l *validatorList) writableChunk(chunkIdx int) *validatorChunk {
+	c := l.chunks[chunkIdx]
+	if c.Refs() > 1 {
+		c.MinusRef()
+		c = &validatorChunk{reference: &reference{refs: 1}, values: c.values}
+		l.chunks[chunkIdx] = c
+	}
+	return c
+}
+
+// slice returns the validator references of the list in a new slice, nil if the list is nil.
+func (l *validatorList) slice() []*ethpb.Validator {
+	if l == nil {
+		return nil
+	}
+	res := make([]*ethpb.Validator, l.length)
+	for i, c := range l.chunks {
+		copy(res[i*listChunkSize:], c.values[:])
+	}
+	return res
+}
+
+// sharedLen returns the number of elements of the list, where the elements of the chunks shared
+// with other lists are divided between them.
+func (l *validatorList) sharedLen() uint64 {
+	if l == nil {
+		return 0
+	}
+	var n uint64
+	for i, c := range l.chunks {
+		n += chunkShare(c.reference, i, l.length)
+	}
+	return n
+}
+
+// rootList is a chunked list of 32 bytes roots, used for the randao mixes, block roots and state
+// roots. The roots are shared by the copies of the list, a root is replaced rather than changed
+// in place.
+type rootList struct {
+	chunks []*rootChunk
+	length int
+}
+
+type rootChunk struct {
+	*reference
+	values [listChunkSize][]byte
+}
+
+// newRootList copies the input root references into a chunked list. It returns nil for nil roots.
+func newRootList(values [][]byte) *rootList {
+	if values == nil {
+		return nil
+	}
+	l := &rootList{
+		chunks: make([]*rootChunk, numOfChunks(len(values))),
+		length: len(values),
+	}
+	for i := range l.chunks {
+		c := &rootChunk{reference: &reference{refs: 1}}
+		copy(c.values[:], values[i*listChunkSize:])
+		l.chunks[i] = c
+	}
+	return l
+}
+
+// copy returns a copy of the list sharing its chunks.
+func (l *rootList) copy() *rootList {
+	if l == nil {
+		return nil
+	}
+	dst := &rootList{
+		chunks: make([]*rootChunk, len(l.chunks)),
+		length: l.length,
+	}
+	for i, c := range l.chunks {
+		c.AddRef()
+		dst.chunks[i] = c
+	}
+	return dst
+}
+
+// release removes the references of the list to its chunks, once it is no longer used.
+func (l *rootList) release() {
+	if l == nil {
+		return
+	}
+	for _, c := range l.chunks {
+		c.MinusRef()
+	}
+}
+
+func (l *rootList) len() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+func (l *rootList) at(idx int) []byte {
+	return l.chunks[idx/listChunkSize].values[idx%listChunkSize]
+}
+
+// set replaces the root at the input index, copying its chunk first if it is shared.
+func (l *rootList) set(idx int, val []byte) {
+	l.writableChunk(idx / listChunkSize).values[idx%listChunkSize] = val
+}
+
+func (l *rootList) writableChunk(chunkIdx int) *rootChunk {
+	c := l.chunks[chunkIdx]
+	if c.Refs() > 1 {
+		c.MinusRef()
+		c = &rootChunk{reference: &reference{refs: 1}, values: c.values}
+		l.chunks[chunkIdx] = c
+	}
+	return c
+}
+
+// slice returns the root references of the list in a new slice, nil if the list is nil.
+func (l *rootList) slice() [][]byte {
+	if l == nil {
+		return nil
+	}
+	res := make([][]byte, l.length)
+	for i, c := range l.chunks {
+		copy(res[i*listChunkSize:], c.values[:])
+	}
+	return res
+}
+
+// sharedLen returns the number of elements of the list, where the elements of the chunks shared
+// with other lists are divided between them.
+func (l *rootList) sharedLen() uint64 {
+	if l == nil {
+		return 0
+	}
+	var n uint64
+	for i, c := range l.chunks {
+		n += chunkShare(c.reference, i, l.length)
+	}
+	return n
+}
+
+// fieldLength returns the number of elements of a list field, stored in a slice or a chunked list.
+func fieldLength(elements interface{}) int {
+	if l, ok := elements.(chunkedList); ok {
+		return l.len()
+	}
+	return reflect.ValueOf(elements).Len()
+}
+
+// chunkedElements converts the elements of a field stored in a chunked list to a slice, so the
+// roots of the trie leaves can be computed from it. Unless all the elements are converted, the
+// slice only holds the elements of the changed leaves at the input indices, and the returned
+// indices are the positions of the leaves in the slice. Other elements are returned as they are.
+func chunkedElements(field fieldIndex, elements interface{}, indices []uint64, convertAll bool) (interface{}, []uint64, error) {
+	l, ok := elements.(chunkedList)
+	if !ok {
+		return elements, indices, nil
+	}
+	if convertAll {
+		switch l := l.(type) {
+		case *validatorList:
+			return l.slice(), indices, nil
+		case *uint64List:
+			return l.slice(), indices, nil
+		case *rootList:
+			return l.slice(), indices, nil
+		default:
+			return nil, nil, fmt.Errorf("unsupported chunked list %v", reflect.TypeOf(l).Name())
+		}
+	}
+
+	// The positions in the list of the elements of the changed leaves, several per leaf for the
+	// fields packing several elements in a leaf.
+	perLeaf := elementsPerChunk(field)
+	positions := make([]int, 0, len(indices)*perLeaf)
+	leafIndices := make([]uint64, len(indices))
+	for i, idx := range indices {
+		start := int(idx) * perLeaf
+		if start >= l.len() {
+			return nil, nil, fmt.Errorf("index %d greater than number of %s %d", idx, field, l.len())
+		}
+		for j := start; j < start+perLeaf && j < l.len(); j++ {
+			positions = append(positions, j)
+		}
+		leafIndices[i] = uint64(i)
+	}
+	switch l := l.(type) {
+	case *validatorList:
+		res := make([]*ethpb.Validator, len(positions))
+		for i, pos := range positions {
+			res[i] = l.at(pos)
+		}
+		return res, leafIndices, nil
+	case *uint64List:
+		res := make([]uint64, len(positions))
+		for i, pos := range positions {
+			res[i] = l.at(pos)
+		}
+		return res, leafIndices, nil
+	case *rootList:
+		res := make([][]byte, len(positions))
+		for i, pos := range positions {
+			res[i] = l.at(pos)
+		}
+		return res, leafIndices, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported chunked list %v", reflect.TypeOf(l).Name())
+	}
+}
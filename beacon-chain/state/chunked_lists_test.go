@@ -0,0 +1,127 @@
+package state
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestUint64List_CopyOnWrite(t *testing.T) {
+	values := make([]uint64, 2*listChunkSize+10)
+	for i := range values {
+		values[i] = uint64(i)
+	}
+	a := newUint64List(values)
+	require.Equal(t, len(values), a.len())
+	assert.DeepEqual(t, values, a.slice())
+
+	b := a.copy()
+	for _, c := range a.chunks {
+		assert.Equal(t, uint(2), c.Refs(), "Chunk not shared by the copy")
+	}
+
+	b.set(listChunkSize+1, 1000)
+	b.append(2000)
+	assert.Equal(t, uint64(listChunkSize+1), a.at(listChunkSize+1), "Original list changed by its copy")
+	assert.Equal(t, len(values), a.len())
+	assert.Equal(t, uint64(1000), b.at(listChunkSize+1))
+	assert.Equal(t, uint64(2000), b.at(len(values)))
+	assert.Equal(t, len(values)+1, b.len())
+
+	// Only the changed chunks are copied.
+	assert.Equal(t, a.chunks[0], b.chunks[0])
+	assert.NotEqual(t, a.chunks[1], b.chunks[1])
+	assert.NotEqual(t, a.chunks[2], b.chunks[2])
+	assert.Equal(t, uint(2), a.chunks[0].Refs())
+	assert.Equal(t, uint(1), a.chunks[1].Refs())
+	assert.Equal(t, uint(1), b.chunks[1].Refs())
+
+	// The shared chunk is divided between the lists.
+	assert.Equal(t, uint64(listChunkSize/2+listChunkSize+10), a.sharedLen())
+	assert.Equal(t, uint64(listChunkSize/2+listChunkSize+11), b.sharedLen())
+}
+
+func TestUint64List_Nil(t *testing.T) {
+	var l *uint64List
+	assert.Equal(t, true, newUint64List(nil) == nil)
+	assert.Equal(t, 0, l.len())
+	assert.Equal(t, true, l.slice() == nil)
+	assert.Equal(t, true, l.copy() == nil)
+	assert.Equal(t, uint64(0), l.sharedLen())
+
+	l = newUint64List([]uint64{})
+	l.append(5)
+	assert.DeepEqual(t, []uint64{5}, l.slice())
+}
+
+func TestValidatorList_CopyOnWrite(t *testing.T) {
+	values := make([]*ethpb.Validator, listChunkSize)
+	for i := range values {
+		values[i] = &ethpb.Validator{EffectiveBalance: uint64(i)}
+	}
+	a := newValidatorList(values)
+	b := a.copy()
+
+	b.set(3, &ethpb.Validator{Slashed: true})
+	b.append(&ethpb.Validator{EffectiveBalance: 1})
+	assert.Equal(t, values[3], a.at(3), "Original list changed by its copy")
+	assert.Equal(t, true, b.at(3).Slashed)
+	assert.Equal(t, listChunkSize, a.len())
+	assert.Equal(t, listChunkSize+1, b.len())
+	assert.Equal(t, 2, len(b.chunks))
+	assert.Equal(t, 1, len(a.chunks))
+}
+
+func TestRootList_CopyOnWrite(t *testing.T) {
+	values := make([][]byte, 3*listChunkSize)
+	for i := range values {
+		values[i] = []byte{byte(i)}
+	}
+	a := newRootList(values)
+	b := a.copy()
+
+	b.set(2*listChunkSize, []byte("foo"))
+	assert.DeepEqual(t, values, a.slice(), "Original list changed by its copy")
+	assert.DeepEqual(t, []byte("foo"), b.at(2*listChunkSize))
+	assert.Equal(t, a.chunks[1], b.chunks[1])
+	assert.NotEqual(t, a.chunks[2], b.chunks[2])
+}
+
+func TestChunkedElements_ChangedLeaves(t *testing.T) {
+	bals := make([]uint64, 20)
+	for i := range bals {
+		bals[i] = uint64(i)
+	}
+	elements, indices, err := chunkedElements(balances, newUint64List(bals), []uint64{1, 4}, false)
+	require.NoError(t, err)
+	// The balances of the changed leaves are packed four by leaf.
+	assert.DeepEqual(t, []uint64{4, 5, 6, 7, 16, 17, 18, 19}, elements)
+	assert.DeepEqual(t, []uint64{0, 1}, indices)
+
+	_, _, err = chunkedElements(balances, newUint64List(bals), []uint64{5}, false)
+	assert.ErrorContains(t, "greater than number of balances", err)
+
+	elements, indices, err = chunkedElements(balances, newUint64List(bals), []uint64{1}, true)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bals, elements)
+	assert.DeepEqual(t, []uint64{1}, indices)
+}
+
+func TestChunkedLists_ReleasedWhenReplaced(t *testing.T) {
+	a, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{Balances: make([]uint64, 2*listChunkSize)})
+	require.NoError(t, err)
+	b := a.Copy()
+	require.NoError(t, b.UpdateBalancesAtIndex(0, 5))
+	sharedChunk := b.balanceList.chunks[1]
+	assert.Equal(t, uint(2), sharedChunk.Refs(), "Unchanged chunk not shared by the copy")
+
+	// Replacing the list of the only state holding it releases its chunks, so the copy updates
+	// them in place.
+	require.NoError(t, a.SetBalances([]uint64{1}))
+	assert.Equal(t, uint(1), sharedChunk.Refs())
+	require.NoError(t, b.UpdateBalancesAtIndex(listChunkSize, 6))
+	assert.Equal(t, sharedChunk, b.balanceList.chunks[1], "Chunk copied although no longer shared")
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"sync"
@@ -19,7 +20,9 @@ type FieldTrie struct {
 	*sync.Mutex
 	*reference
 	fieldLayers [][]*[32]byte
+	sharedTrie  *stateutil.SharedTrie
 	field       fieldIndex
+	numOfElems  int
 }
 
 // NewFieldTrie is the constructor for the field trie data structure. It creates the corresponding
@@ -51,10 +54,19 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 		}, nil
 	case compositeArray:
 		return &FieldTrie{
-			fieldLayers: stateutil.ReturnTrieLayerVariable(fieldRoots, length),
-			field:       field,
-			reference:   &reference{refs: 1},
-			Mutex:       new(sync.Mutex),
+			sharedTrie: stateutil.NewSharedTrie(fieldRoots, length),
+			field:      field,
+			reference:  &reference{refs: 1},
+			Mutex:      new(sync.Mutex),
+			numOfElems: fieldLength(elements),
+		}, nil
+	case compressedArray:
+		return &FieldTrie{
+			sharedTrie: stateutil.NewSharedTrie(fieldRoots, compressedLength(field, length)),
+			field:      field,
+			reference:  &reference{refs: 1},
+			Mutex:      new(sync.Mutex),
+			numOfElems: fieldLength(elements),
 		}, nil
 	default:
 		return nil, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
@@ -76,6 +88,9 @@ func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]b
 	if !ok {
 		return [32]byte{}, errors.Errorf("unrecognized field in trie")
 	}
+	if datType == compressedArray {
+		indices = compressedIndices(f.field, indices)
+	}
 	fieldRoots, err := fieldConverters(f.field, indices, elements, false)
 	if err != nil {
 		return [32]byte{}, err
@@ -87,12 +102,13 @@ func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]b
 			return [32]byte{}, err
 		}
 		return fieldRoot, nil
-	case compositeArray:
-		fieldRoot, f.fieldLayers, err = stateutil.RecomputeFromLayerVariable(fieldRoots, indices, f.fieldLayers)
+	case compositeArray, compressedArray:
+		fieldRoot, err = f.sharedTrie.Recompute(fieldRoots, indices)
 		if err != nil {
 			return [32]byte{}, err
 		}
-		return stateutil.AddInMixin(fieldRoot, uint64(len(f.fieldLayers[0])))
+		f.numOfElems = fieldLength(elements)
+		return stateutil.AddInMixin(fieldRoot, uint64(f.numOfElems))
 	default:
 		return [32]byte{}, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
@@ -102,6 +118,15 @@ func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]b
 // CopyTrie copies the references to the elements the trie
 // is built on.
 func (f *FieldTrie) CopyTrie() *FieldTrie {
+	if f.sharedTrie != nil {
+		return &FieldTrie{
+			sharedTrie: f.sharedTrie.Copy(),
+			field:      f.field,
+			reference:  &reference{refs: 1},
+			Mutex:      new(sync.Mutex),
+			numOfElems: f.numOfElems,
+		}
+	}
 	if f.fieldLayers == nil {
 		return &FieldTrie{
 			field:     f.field,
@@ -122,6 +147,17 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 	}
 }
 
+// release removes a reference of a state to the trie, and releases the subtries the trie shares
+// with its copies once no state references it anymore.
+func (f *FieldTrie) release() {
+	if f.reference == nil || !f.ReleaseRef() {
+		return
+	}
+	if f.sharedTrie != nil {
+		f.sharedTrie.Release()
+	}
+}
+
 // TrieRoot returns the corresponding root of the trie.
 func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 	datType, ok := fieldMap[f.field]
@@ -131,16 +167,82 @@ func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 	switch datType {
 	case basicArray:
 		return *f.fieldLayers[len(f.fieldLayers)-1][0], nil
-	case compositeArray:
-		trieRoot := *f.fieldLayers[len(f.fieldLayers)-1][0]
-		return stateutil.AddInMixin(trieRoot, uint64(len(f.fieldLayers[0])))
+	case compositeArray, compressedArray:
+		return stateutil.AddInMixin(f.sharedTrie.Root(), uint64(f.numOfElems))
 	default:
 		return [32]byte{}, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
 }
 
+// empty returns true if the trie was not built yet.
+func (f *FieldTrie) empty() bool {
+	return len(f.fieldLayers) == 0 && f.sharedTrie == nil
+}
+
+// nodeCount returns the number of nodes held by the trie, where the nodes shared with copies
+// of the trie are divided between them.
+func (f *FieldTrie) nodeCount() uint64 {
+	if f.sharedTrie != nil {
+		return f.sharedTrie.NodeCount()
+	}
+	var nodes uint64
+	for _, layer := range f.fieldLayers {
+		nodes += uint64(len(layer))
+	}
+	return nodes
+}
+
+// compressedLength returns the maximum number of chunks of a field packing several elements
+// in each chunk, given the maximum number of elements.
+func compressedLength(field fieldIndex, length uint64) uint64 {
+	perChunk := uint64(elementsPerChunk(field))
+	return (length + perChunk - 1) / perChunk
+}
+
+// compressedIndices converts the changed element indices of a field packing several elements
+// in each chunk to the sorted indices of the changed chunks.
+func compressedIndices(field fieldIndex, indices []uint64) []uint64 {
+	perChunk := uint64(elementsPerChunk(field))
+	chunkIndices := make([]uint64, 0, len(indices))
+	for _, idx := range indices {
+		chunkIdx := idx / perChunk
+		if len(chunkIndices) == 0 || chunkIndices[len(chunkIndices)-1] != chunkIdx {
+			chunkIndices = append(chunkIndices, chunkIdx)
+		}
+	}
+	return chunkIndices
+}
+
+// elementsPerChunk returns the number of elements of a compressed field packed in a chunk.
+func elementsPerChunk(field fieldIndex) int {
+	switch field {
+	case balances:
+		// 32 bytes chunks of 8 bytes balances.
+		return 4
+	default:
+		return 1
+	}
+}
+
+// allLeafIndices returns the indices of all the leaves of a field holding the input number of
+// elements.
+func allLeafIndices(field fieldIndex, numOfElems int) []uint64 {
+	indices := make([]uint64, compressedLength(field, uint64(numOfElems)))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+	return indices
+}
+
 // this converts the corresponding field and the provided elements to the appropriate roots.
 func fieldConverters(field fieldIndex, indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
+	elements, indices, err := chunkedElements(field, elements, indices, convertAll)
+	if err != nil {
+		return nil, err
+	}
+	if convertAll && field != validators {
+		indices = allLeafIndices(field, fieldLength(elements))
+	}
 	switch field {
 	case blockRoots, stateRoots, randaoMixes:
 		val, ok := elements.([][]byte)
@@ -148,49 +250,49 @@ func fieldConverters(field fieldIndex, indices []uint64, elements interface{}, c
 			return nil, errors.Errorf("Wanted type of %v but got %v",
 				reflect.TypeOf([][]byte{}).Name(), reflect.TypeOf(elements).Name())
 		}
-		return handleByteArrays(val, indices, convertAll)
+		return handleByteArrays(val, indices)
 	case eth1DataVotes:
 		val, ok := elements.([]*ethpb.Eth1Data)
 		if !ok {
 			return nil, errors.Errorf("Wanted type of %v but got %v",
 				reflect.TypeOf([]*ethpb.Eth1Data{}).Name(), reflect.TypeOf(elements).Name())
 		}
-		return handleEth1DataSlice(val, indices, convertAll)
+		return handleEth1DataSlice(val, indices)
 	case validators:
 		val, ok := elements.([]*ethpb.Validator)
 		if !ok {
 			return nil, errors.Errorf("Wanted type of %v but got %v",
 				reflect.TypeOf([]*ethpb.Validator{}).Name(), reflect.TypeOf(elements).Name())
 		}
-		return handleValidatorSlice(val, indices, convertAll)
+		if convertAll {
+			return stateutil.OptimizedValidatorRoots(val)
+		}
+		return handleValidatorSlice(val, indices)
+	case balances:
+		val, ok := elements.([]uint64)
+		if !ok {
+			return nil, errors.Errorf("Wanted type of %v but got %v",
+				reflect.TypeOf([]uint64{}).Name(), reflect.TypeOf(elements).Name())
+		}
+		return handleBalanceSlice(val, indices)
 	case previousEpochAttestations, currentEpochAttestations:
 		val, ok := elements.([]*pb.PendingAttestation)
 		if !ok {
 			return nil, errors.Errorf("Wanted type of %v but got %v",
 				reflect.TypeOf([]*pb.PendingAttestation{}).Name(), reflect.TypeOf(elements).Name())
 		}
-		return handlePendingAttestation(val, indices, convertAll)
+		return handlePendingAttestation(val, indices)
 	default:
 		return [][32]byte{}, errors.Errorf("got unsupported type of %v", reflect.TypeOf(elements).Name())
 	}
 }
 
-func handleByteArrays(val [][]byte, indices []uint64, convertAll bool) ([][32]byte, error) {
-	length := len(indices)
-	if convertAll {
-		length = len(val)
-	}
-	roots := make([][32]byte, 0, length)
+func handleByteArrays(val [][]byte, indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
 	rootCreator := func(input []byte) {
 		newRoot := bytesutil.ToBytes32(input)
 		roots = append(roots, newRoot)
 	}
-	if convertAll {
-		for i := range val {
-			rootCreator(val[i])
-		}
-		return roots, nil
-	}
 	if len(val) > 0 {
 		for _, idx := range indices {
 			if idx > uint64(len(val))-1 {
@@ -202,12 +304,8 @@ func handleByteArrays(val [][]byte, indices []uint64, convertAll bool) ([][32]by
 	return roots, nil
 }
 
-func handleEth1DataSlice(val []*ethpb.Eth1Data, indices []uint64, convertAll bool) ([][32]byte, error) {
-	length := len(indices)
-	if convertAll {
-		length = len(val)
-	}
-	roots := make([][32]byte, 0, length)
+func handleEth1DataSlice(val []*ethpb.Eth1Data, indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
 	hasher := hashutil.CustomSHA256Hasher()
 	rootCreator := func(input *ethpb.Eth1Data) error {
 		newRoot, err := stateutil.Eth1Root(hasher, input)
@@ -217,15 +315,6 @@ func handleEth1DataSlice(val []*ethpb.Eth1Data, indices []uint64, convertAll boo
 		roots = append(roots, newRoot)
 		return nil
 	}
-	if convertAll {
-		for i := range val {
-			err := rootCreator(val[i])
-			if err != nil {
-				return nil, err
-			}
-		}
-		return roots, nil
-	}
 	if len(val) > 0 {
 		for _, idx := range indices {
 			if idx > uint64(len(val))-1 {
@@ -240,12 +329,8 @@ func handleEth1DataSlice(val []*ethpb.Eth1Data, indices []uint64, convertAll boo
 	return roots, nil
 }
 
-func handleValidatorSlice(val []*ethpb.Validator, indices []uint64, convertAll bool) ([][32]byte, error) {
-	length := len(indices)
-	if convertAll {
-		length = len(val)
-	}
-	roots := make([][32]byte, 0, length)
+func handleValidatorSlice(val []*ethpb.Validator, indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
 	hasher := hashutil.CustomSHA256Hasher()
 	rootCreator := func(input *ethpb.Validator) error {
 		newRoot, err := stateutil.ValidatorRoot(hasher, input)
@@ -255,15 +340,6 @@ func handleValidatorSlice(val []*ethpb.Validator, indices []uint64, convertAll b
 		roots = append(roots, newRoot)
 		return nil
 	}
-	if convertAll {
-		for i := range val {
-			err := rootCreator(val[i])
-			if err != nil {
-				return nil, err
-			}
-		}
-		return roots, nil
-	}
 	if len(val) > 0 {
 		for _, idx := range indices {
 			if idx > uint64(len(val))-1 {
@@ -278,12 +354,27 @@ func handleValidatorSlice(val []*ethpb.Validator, indices []uint64, convertAll b
 	return roots, nil
 }
 
-func handlePendingAttestation(val []*pb.PendingAttestation, indices []uint64, convertAll bool) ([][32]byte, error) {
-	length := len(indices)
-	if convertAll {
-		length = len(val)
+// handleBalanceSlice packs the balances into 32 bytes chunks, converting the chunks of the
+// provided chunk indices.
+func handleBalanceSlice(val []uint64, indices []uint64) ([][32]byte, error) {
+	perChunk := elementsPerChunk(balances)
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		start := int(idx) * perChunk
+		if start >= len(val) {
+			return nil, fmt.Errorf("index %d greater than number of balance chunks %d", idx, (len(val)+perChunk-1)/perChunk)
+		}
+		var chunk [32]byte
+		for i := 0; i < perChunk && start+i < len(val); i++ {
+			binary.LittleEndian.PutUint64(chunk[i*8:(i+1)*8], val[start+i])
+		}
+		roots = append(roots, chunk)
 	}
-	roots := make([][32]byte, 0, length)
+	return roots, nil
+}
+
+func handlePendingAttestation(val []*pb.PendingAttestation, indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
 	hasher := hashutil.CustomSHA256Hasher()
 	rootCreator := func(input *pb.PendingAttestation) error {
 		newRoot, err := stateutil.PendingAttestationRoot(hasher, input)
@@ -293,15 +384,6 @@ func handlePendingAttestation(val []*pb.PendingAttestation, indices []uint64, co
 		roots = append(roots, newRoot)
 		return nil
 	}
-	if convertAll {
-		for i := range val {
-			err := rootCreator(val[i])
-			if err != nil {
-				return nil, err
-			}
-		}
-		return roots, nil
-	}
 	if len(val) > 0 {
 		for _, idx := range indices {
 			if idx > uint64(len(val))-1 {
@@ -76,3 +76,52 @@ func TestFieldTrie_CopyTrieImmutable(t *testing.T) {
 		t.Errorf("Wanted roots to be different, but they are the same: %#x", root)
 	}
 }
+
+func TestFieldTrie_RecomputeTrie_Balances(t *testing.T) {
+	newState, _ := testutil.DeterministicGenesisState(t, 33)
+	// 12 represents the enum value of balances.
+	trie, err := state.NewFieldTrie(12, newState.Balances(), params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	expectedRoot, err := stateutil.ValidatorBalancesRoot(newState.Balances())
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, root)
+
+	changedIdx := []uint64{2, 3, 29, 33, 34}
+	require.NoError(t, newState.UpdateBalancesAtIndex(2, 1))
+	require.NoError(t, newState.UpdateBalancesAtIndex(3, 2))
+	require.NoError(t, newState.UpdateBalancesAtIndex(29, 3))
+	require.NoError(t, newState.AppendBalance(4))
+	require.NoError(t, newState.AppendBalance(5))
+
+	expectedRoot, err = stateutil.ValidatorBalancesRoot(newState.Balances())
+	require.NoError(t, err)
+	root, err = trie.RecomputeTrie(changedIdx, newState.Balances())
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, root)
+}
+
+func TestFieldTrie_CopyTrieImmutable_Validators(t *testing.T) {
+	newState, _ := testutil.DeterministicGenesisState(t, 32)
+	// 11 represents the enum value of validators.
+	trie, err := state.NewFieldTrie(11, newState.Validators(), params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+
+	newTrie := trie.CopyTrie()
+	val, err := newState.ValidatorAtIndex(10)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, newState.UpdateValidatorAtIndex(10, val))
+
+	expectedRoot, err := stateutil.ValidatorRegistryRoot(newState.Validators())
+	require.NoError(t, err)
+	newRoot, err := newTrie.RecomputeTrie([]uint64{10}, newState.Validators())
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, newRoot)
+	oldRoot, err := trie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, oldRoot, "Copied trie changed the original trie")
+}
@@ -99,13 +99,35 @@ func (v ReadOnlyValidator) IsNil() bool {
 	return v.validator == nil
 }
 
-// InnerStateUnsafe returns the pointer value of the underlying
-// beacon state proto object, bypassing immutability. Use with care.
-func (b *BeaconState) InnerStateUnsafe() *pbp2p.BeaconState {
-	if b == nil {
+// ToProtoUnsafe returns the beacon state as a proto object, bypassing immutability: it
+// shares the values of the beacon state rather than copying them. The lists stored in
+// chunks are gathered into new slices on every call, so prefer the field getters when
+// the whole state is not needed. Changes to the lists of the returned object are not
+// reflected in the beacon state. Use with care.
+func (b *BeaconState) ToProtoUnsafe() *pbp2p.BeaconState {
+	if b == nil || b.state == nil {
+		return nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.protoState()
+}
+
+// protoState returns the inner state with the lists stored in chunks gathered
+// back into its slices, sharing their elements.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) protoState() *pbp2p.BeaconState {
+	if b.state == nil {
 		return nil
 	}
-	return b.state
+	st := *b.state
+	st.Validators = b.validatorList.slice()
+	st.Balances = b.balanceList.slice()
+	st.RandaoMixes = b.randaoMixList.slice()
+	st.BlockRoots = b.blockRootList.slice()
+	st.StateRoots = b.stateRootList.slice()
+	return &st
 }
 
 // CloneInnerState the beacon state into a protobuf for usage.
@@ -351,7 +373,7 @@ func (b *BeaconState) BlockRoots() [][]byte {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.BlockRoots == nil {
+	if b.blockRootList == nil {
 		return nil
 	}
 
@@ -367,7 +389,7 @@ func (b *BeaconState) blockRoots() [][]byte {
 	if !b.HasInnerState() {
 		return nil
 	}
-	return b.safeCopy2DByteSlice(b.state.BlockRoots)
+	return b.safeCopyRootList(b.blockRootList)
 }
 
 // BlockRootAtIndex retrieves a specific block root based on an
@@ -376,7 +398,7 @@ func (b *BeaconState) BlockRootAtIndex(idx uint64) ([]byte, error) {
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	if b.state.BlockRoots == nil {
+	if b.blockRootList == nil {
 		return nil, nil
 	}
 
@@ -393,7 +415,7 @@ func (b *BeaconState) blockRootAtIndex(idx uint64) ([]byte, error) {
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	return b.safeCopyBytesAtIndex(b.state.BlockRoots, idx)
+	return b.safeCopyRootAtIndex(b.blockRootList, idx)
 }
 
 // StateRoots kept track of in the beacon state.
@@ -401,7 +423,7 @@ func (b *BeaconState) StateRoots() [][]byte {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.StateRoots == nil {
+	if b.stateRootList == nil {
 		return nil
 	}
 
@@ -417,7 +439,7 @@ func (b *BeaconState) stateRoots() [][]byte {
 	if !b.HasInnerState() {
 		return nil
 	}
-	return b.safeCopy2DByteSlice(b.state.StateRoots)
+	return b.safeCopyRootList(b.stateRootList)
 }
 
 // StateRootAtIndex retrieves a specific state root based on an
@@ -426,7 +448,7 @@ func (b *BeaconState) StateRootAtIndex(idx uint64) ([]byte, error) {
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	if b.state.StateRoots == nil {
+	if b.stateRootList == nil {
 		return nil, nil
 	}
 
@@ -443,7 +465,7 @@ func (b *BeaconState) stateRootAtIndex(idx uint64) ([]byte, error) {
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	return b.safeCopyBytesAtIndex(b.state.StateRoots, idx)
+	return b.safeCopyRootAtIndex(b.stateRootList, idx)
 }
 
 // HistoricalRoots based on epochs stored in the beacon state.
@@ -561,7 +583,7 @@ func (b *BeaconState) Validators() []*ethpb.Validator {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return nil
 	}
 
@@ -577,13 +599,13 @@ func (b *BeaconState) validators() []*ethpb.Validator {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return nil
 	}
 
-	res := make([]*ethpb.Validator, len(b.state.Validators))
+	res := make([]*ethpb.Validator, b.validatorList.len())
 	for i := 0; i < len(res); i++ {
-		val := b.state.Validators[i]
+		val := b.validatorList.at(i)
 		if val == nil {
 			continue
 		}
@@ -599,20 +621,12 @@ func (b *BeaconState) validatorsReferences() []*ethpb.Validator {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return nil
 	}
 
-	res := make([]*ethpb.Validator, len(b.state.Validators))
-	for i := 0; i < len(res); i++ {
-		validator := b.state.Validators[i]
-		if validator == nil {
-			continue
-		}
-		// copy validator reference instead.
-		res[i] = validator
-	}
-	return res
+	// copy validator references instead.
+	return b.validatorList.slice()
 }
 
 // ValidatorAtIndex is the validator at the provided index.
@@ -620,17 +634,17 @@ func (b *BeaconState) ValidatorAtIndex(idx types.ValidatorIndex) (*ethpb.Validat
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return &ethpb.Validator{}, nil
 	}
-	if uint64(len(b.state.Validators)) <= uint64(idx) {
-		return nil, fmt.Errorf("index %d out of range", idx)
-	}
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	val := b.state.Validators[idx]
+	if uint64(b.validatorList.len()) <= uint64(idx) {
+		return nil, fmt.Errorf("index %d out of range", idx)
+	}
+	val := b.validatorList.at(int(idx))
 	return CopyValidator(val), nil
 }
 
@@ -640,17 +654,17 @@ func (b *BeaconState) ValidatorAtIndexReadOnly(idx types.ValidatorIndex) (ReadOn
 	if !b.HasInnerState() {
 		return ReadOnlyValidator{}, ErrNilInnerState
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return ReadOnlyValidator{}, nil
 	}
-	if uint64(len(b.state.Validators)) <= uint64(idx) {
-		return ReadOnlyValidator{}, fmt.Errorf("index %d out of range", idx)
-	}
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	return ReadOnlyValidator{b.state.Validators[idx]}, nil
+	if uint64(b.validatorList.len()) <= uint64(idx) {
+		return ReadOnlyValidator{}, fmt.Errorf("index %d out of range", idx)
+	}
+	return ReadOnlyValidator{b.validatorList.at(int(idx))}, nil
 }
 
 // ValidatorIndexByPubkey returns a given validator by its 48-byte public key.
@@ -680,16 +694,17 @@ func (b *BeaconState) PubkeyAtIndex(idx types.ValidatorIndex) [48]byte {
 	if !b.HasInnerState() {
 		return [48]byte{}
 	}
-	if uint64(idx) >= uint64(len(b.state.Validators)) {
-		return [48]byte{}
-	}
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	if b.state.Validators[idx] == nil {
+	if uint64(idx) >= uint64(b.validatorList.len()) {
 		return [48]byte{}
 	}
-	return bytesutil.ToBytes48(b.state.Validators[idx].PublicKey)
+	val := b.validatorList.at(int(idx))
+	if val == nil {
+		return [48]byte{}
+	}
+	return bytesutil.ToBytes48(val.PublicKey)
 }
 
 // NumValidators returns the size of the validator registry.
@@ -700,7 +715,7 @@ func (b *BeaconState) NumValidators() int {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	return len(b.state.Validators)
+	return b.validatorList.len()
 }
 
 // ReadFromEveryValidator reads values from every validator and applies it to the provided function.
@@ -709,15 +724,15 @@ func (b *BeaconState) ReadFromEveryValidator(f func(idx int, val ReadOnlyValidat
 	if !b.HasInnerState() {
 		return ErrNilInnerState
 	}
-	if b.state.Validators == nil {
+	if b.validatorList == nil {
 		return errors.New("nil validators in state")
 	}
 	b.lock.RLock()
-	validators := b.state.Validators
+	validators := *b.validatorList
 	b.lock.RUnlock()
 
-	for i, v := range validators {
-		err := f(i, ReadOnlyValidator{validator: v})
+	for i := 0; i < validators.length; i++ {
+		err := f(i, ReadOnlyValidator{validator: validators.at(i)})
 		if err != nil {
 			return err
 		}
@@ -730,7 +745,7 @@ func (b *BeaconState) Balances() []uint64 {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.Balances == nil {
+	if b.balanceList == nil {
 		return nil
 	}
 
@@ -746,13 +761,11 @@ func (b *BeaconState) balances() []uint64 {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.Balances == nil {
+	if b.balanceList == nil {
 		return nil
 	}
 
-	res := make([]uint64, len(b.state.Balances))
-	copy(res, b.state.Balances)
-	return res
+	return b.balanceList.slice()
 }
 
 // BalanceAtIndex of validator with the provided index.
@@ -760,17 +773,17 @@ func (b *BeaconState) BalanceAtIndex(idx types.ValidatorIndex) (uint64, error) {
 	if !b.HasInnerState() {
 		return 0, ErrNilInnerState
 	}
-	if b.state.Balances == nil {
+	if b.balanceList == nil {
 		return 0, nil
 	}
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	if uint64(len(b.state.Balances)) <= uint64(idx) {
+	if uint64(b.balanceList.len()) <= uint64(idx) {
 		return 0, fmt.Errorf("index of %d does not exist", idx)
 	}
-	return b.state.Balances[idx], nil
+	return b.balanceList.at(int(idx)), nil
 }
 
 // BalancesLength returns the length of the balances slice.
@@ -778,7 +791,7 @@ func (b *BeaconState) BalancesLength() int {
 	if !b.HasInnerState() {
 		return 0
 	}
-	if b.state.Balances == nil {
+	if b.balanceList == nil {
 		return 0
 	}
 
@@ -794,11 +807,11 @@ func (b *BeaconState) balancesLength() int {
 	if !b.HasInnerState() {
 		return 0
 	}
-	if b.state.Balances == nil {
+	if b.balanceList == nil {
 		return 0
 	}
 
-	return len(b.state.Balances)
+	return b.balanceList.len()
 }
 
 // RandaoMixes of block proposers on the beacon chain.
@@ -806,7 +819,7 @@ func (b *BeaconState) RandaoMixes() [][]byte {
 	if !b.HasInnerState() {
 		return nil
 	}
-	if b.state.RandaoMixes == nil {
+	if b.randaoMixList == nil {
 		return nil
 	}
 
@@ -823,7 +836,7 @@ func (b *BeaconState) randaoMixes() [][]byte {
 		return nil
 	}
 
-	return b.safeCopy2DByteSlice(b.state.RandaoMixes)
+	return b.safeCopyRootList(b.randaoMixList)
 }
 
 // RandaoMixAtIndex retrieves a specific block root based on an
@@ -832,7 +845,7 @@ func (b *BeaconState) RandaoMixAtIndex(idx uint64) ([]byte, error) {
 	if !b.HasInnerState() {
 		return nil, ErrNilInnerState
 	}
-	if b.state.RandaoMixes == nil {
+	if b.randaoMixList == nil {
 		return nil, nil
 	}
 
@@ -850,7 +863,7 @@ func (b *BeaconState) randaoMixAtIndex(idx uint64) ([]byte, error) {
 		return nil, ErrNilInnerState
 	}
 
-	return b.safeCopyBytesAtIndex(b.state.RandaoMixes, idx)
+	return b.safeCopyRootAtIndex(b.randaoMixList, idx)
 }
 
 // RandaoMixesLength returns the length of the randao mixes slice.
@@ -858,7 +871,7 @@ func (b *BeaconState) RandaoMixesLength() int {
 	if !b.HasInnerState() {
 		return 0
 	}
-	if b.state.RandaoMixes == nil {
+	if b.randaoMixList == nil {
 		return 0
 	}
 
@@ -874,11 +887,11 @@ func (b *BeaconState) randaoMixesLength() int {
 	if !b.HasInnerState() {
 		return 0
 	}
-	if b.state.RandaoMixes == nil {
+	if b.randaoMixList == nil {
 		return 0
 	}
 
-	return len(b.state.RandaoMixes)
+	return b.randaoMixList.len()
 }
 
 // Slashings of validators on the beacon chain.
@@ -1126,16 +1139,31 @@ func (b *BeaconState) safeCopy2DByteSlice(input [][]byte) [][]byte {
 	return dst
 }
 
-func (b *BeaconState) safeCopyBytesAtIndex(input [][]byte, idx uint64) ([]byte, error) {
+func (b *BeaconState) safeCopyRootList(input *rootList) [][]byte {
+	if input == nil {
+		return nil
+	}
+
+	dst := make([][]byte, input.len())
+	for i := range dst {
+		r := input.at(i)
+		tmp := make([]byte, len(r))
+		copy(tmp, r)
+		dst[i] = tmp
+	}
+	return dst
+}
+
+func (b *BeaconState) safeCopyRootAtIndex(input *rootList, idx uint64) ([]byte, error) {
 	if input == nil {
 		return nil, nil
 	}
 
-	if uint64(len(input)) <= idx {
+	if uint64(input.len()) <= idx {
 		return nil, fmt.Errorf("index %d out of range", idx)
 	}
 	root := make([]byte, 32)
-	copy(root, input[idx])
+	copy(root, input.at(int(idx)))
 	return root, nil
 }
 
@@ -11,20 +11,20 @@ import (
 func Test_handleValidatorSlice_OutOfRange(t *testing.T) {
 	vals := make([]*ethpb.Validator, 1)
 	indices := []uint64{3}
-	_, err := handleValidatorSlice(vals, indices, false)
+	_, err := handleValidatorSlice(vals, indices)
 	assert.ErrorContains(t, "index 3 greater than number of validators 1", err)
 }
 
 func Test_handlePendingAttestation_OutOfRange(t *testing.T) {
 	items := make([]*pb.PendingAttestation, 1)
 	indices := []uint64{3}
-	_, err := handlePendingAttestation(items, indices, false)
+	_, err := handlePendingAttestation(items, indices)
 	assert.ErrorContains(t, "index 3 greater than number of pending attestations 1", err)
 }
 
 func Test_handleEth1DataSlice_OutOfRange(t *testing.T) {
 	items := make([]*ethpb.Eth1Data, 1)
 	indices := []uint64{3}
-	_, err := handleEth1DataSlice(items, indices, false)
+	_, err := handleEth1DataSlice(items, indices)
 	assert.ErrorContains(t, "index 3 greater than number of items in eth1 data slice 1", err)
 }
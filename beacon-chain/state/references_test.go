@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"reflect"
 	"runtime"
 	"runtime/debug"
@@ -59,14 +60,14 @@ func TestStateReferenceCopy_NoUnexpectedRootsMutation(t *testing.T) {
 	assertRefCount(t, a, stateRoots, 2)
 	assertRefCount(t, b, blockRoots, 2)
 	assertRefCount(t, b, stateRoots, 2)
-	assert.Equal(t, 1, len(b.state.GetBlockRoots()), "No block roots found")
-	assert.Equal(t, 1, len(b.state.GetStateRoots()), "No state roots found")
+	assert.Equal(t, 1, len(b.blockRootList.slice()), "No block roots found")
+	assert.Equal(t, 1, len(b.stateRootList.slice()), "No state roots found")
 
 	// Assert shared state.
-	blockRootsA := a.state.GetBlockRoots()
-	stateRootsA := a.state.GetStateRoots()
-	blockRootsB := b.state.GetBlockRoots()
-	stateRootsB := b.state.GetStateRoots()
+	blockRootsA := a.blockRootList.slice()
+	stateRootsA := a.stateRootList.slice()
+	blockRootsB := b.blockRootList.slice()
+	stateRootsB := b.stateRootList.slice()
 	if len(blockRootsA) != len(blockRootsB) || len(blockRootsA) < 1 {
 		t.Errorf("Unexpected number of block roots, want: %v", 1)
 	}
@@ -83,20 +84,20 @@ func TestStateReferenceCopy_NoUnexpectedRootsMutation(t *testing.T) {
 	require.NoError(t, a.UpdateStateRootAtIndex(0, root2))
 
 	// Assert no shared state mutation occurred only on state a (copy on write).
-	assertValNotFound(t, a.state.GetBlockRoots(), root1[:])
-	assertValNotFound(t, a.state.GetStateRoots(), root1[:])
-	assertValFound(t, a.state.GetBlockRoots(), root2[:])
-	assertValFound(t, a.state.GetStateRoots(), root2[:])
-	assertValFound(t, b.state.GetBlockRoots(), root1[:])
-	assertValFound(t, b.state.GetStateRoots(), root1[:])
+	assertValNotFound(t, a.blockRootList.slice(), root1[:])
+	assertValNotFound(t, a.stateRootList.slice(), root1[:])
+	assertValFound(t, a.blockRootList.slice(), root2[:])
+	assertValFound(t, a.stateRootList.slice(), root2[:])
+	assertValFound(t, b.blockRootList.slice(), root1[:])
+	assertValFound(t, b.stateRootList.slice(), root1[:])
 	if len(blockRootsA) != len(blockRootsB) || len(blockRootsA) < 1 {
 		t.Errorf("Unexpected number of block roots, want: %v", 1)
 	}
 	if len(stateRootsA) != len(stateRootsB) || len(stateRootsA) < 1 {
 		t.Errorf("Unexpected number of state roots, want: %v", 1)
 	}
-	assert.DeepEqual(t, root2[:], a.state.GetBlockRoots()[0], "Expected mutation not found")
-	assert.DeepEqual(t, root2[:], a.state.GetStateRoots()[0], "Expected mutation not found")
+	assert.DeepEqual(t, root2[:], a.blockRootList.slice()[0], "Expected mutation not found")
+	assert.DeepEqual(t, root2[:], a.stateRootList.slice()[0], "Expected mutation not found")
 	assert.DeepEqual(t, root1[:], blockRootsB[0], "Unexpected mutation found")
 	assert.DeepEqual(t, root1[:], stateRootsB[0], "Unexpected mutation found")
 
@@ -122,11 +123,11 @@ func TestStateReferenceCopy_NoUnexpectedRandaoMutation(t *testing.T) {
 	b := a.Copy()
 	assertRefCount(t, a, randaoMixes, 2)
 	assertRefCount(t, b, randaoMixes, 2)
-	assert.Equal(t, 1, len(b.state.GetRandaoMixes()), "No randao mixes found")
+	assert.Equal(t, 1, len(b.randaoMixList.slice()), "No randao mixes found")
 
 	// Assert shared state.
-	mixesA := a.state.GetRandaoMixes()
-	mixesB := b.state.GetRandaoMixes()
+	mixesA := a.randaoMixList.slice()
+	mixesB := b.randaoMixList.slice()
 	if len(mixesA) != len(mixesB) || len(mixesA) < 1 {
 		t.Errorf("Unexpected number of mix values, want: %v", 1)
 	}
@@ -140,13 +141,13 @@ func TestStateReferenceCopy_NoUnexpectedRandaoMutation(t *testing.T) {
 	if len(mixesA) != len(mixesB) || len(mixesA) < 1 {
 		t.Errorf("Unexpected number of mix values, want: %v", 1)
 	}
-	assertValFound(t, a.state.GetRandaoMixes(), val2)
-	assertValNotFound(t, a.state.GetRandaoMixes(), val1)
-	assertValFound(t, b.state.GetRandaoMixes(), val1)
-	assertValNotFound(t, b.state.GetRandaoMixes(), val2)
+	assertValFound(t, a.randaoMixList.slice(), val2)
+	assertValNotFound(t, a.randaoMixList.slice(), val1)
+	assertValFound(t, b.randaoMixList.slice(), val1)
+	assertValNotFound(t, b.randaoMixList.slice(), val2)
 	assertValFound(t, mixesB, val1)
 	assertValNotFound(t, mixesB, val2)
-	assert.DeepEqual(t, val2, a.state.GetRandaoMixes()[0], "Expected mutation not found")
+	assert.DeepEqual(t, val2, a.randaoMixList.slice()[0], "Expected mutation not found")
 	assert.DeepEqual(t, val1, mixesB[0], "Unexpected mutation found")
 
 	// Copy on write happened, reference counters are reset.
@@ -291,7 +292,7 @@ func TestValidatorReferences_RemainsConsistent(t *testing.T) {
 	// Update First Validator.
 	assert.NoError(t, a.UpdateValidatorAtIndex(0, &ethpb.Validator{PublicKey: []byte{'Z'}}))
 
-	assert.DeepNotEqual(t, a.state.Validators[0], b.state.Validators[0], "validators are equal when they are supposed to be different")
+	assert.DeepNotEqual(t, a.validatorList.at(0), b.validatorList.at(0), "validators are equal when they are supposed to be different")
 	// Modify all validators from copied state.
 	assert.NoError(t, b.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
 		return true, &ethpb.Validator{PublicKey: []byte{'V'}}, nil
@@ -333,3 +334,34 @@ func assertValNotFound(t *testing.T, vals [][]byte, val []byte) {
 		}
 	}
 }
+
+func TestBeaconState_ReleaseReferences(t *testing.T) {
+	vals := make([]*ethpb.Validator, 2*listChunkSize)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      uint64(i),
+		}
+	}
+	a, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{
+		Validators: vals,
+		Balances:   make([]uint64, len(vals)),
+	})
+	require.NoError(t, err)
+	_, err = a.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	b := a.Copy()
+	require.NoError(t, b.UpdateBalancesAtIndex(0, 5))
+	_, err = b.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), a.sharedFieldReferences[validators].Refs())
+	assert.Equal(t, uint(2), a.stateFieldLeaves[validators].Refs())
+	assert.Equal(t, uint(2), a.balanceList.chunks[1].Refs())
+
+	// Dropping the copy releases the fields, chunks and field tries it shares.
+	b.releaseReferences()
+	assert.Equal(t, uint(1), a.sharedFieldReferences[validators].Refs())
+	assert.Equal(t, uint(1), a.stateFieldLeaves[validators].Refs())
+	assert.Equal(t, uint(1), a.balanceList.chunks[1].Refs())
+}
@@ -106,10 +106,10 @@ func (b *BeaconState) SetBlockRoots(val [][]byte) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[blockRoots].MinusRef()
+	b.releaseFieldReference(blockRoots)
 	b.sharedFieldReferences[blockRoots] = &reference{refs: 1}
 
-	b.state.BlockRoots = val
+	b.blockRootList = newRootList(val)
 	b.markFieldAsDirty(blockRoots)
 	b.rebuildTrie[blockRoots] = true
 	return nil
@@ -121,23 +121,22 @@ func (b *BeaconState) UpdateBlockRootAtIndex(idx uint64, blockRoot [32]byte) err
 	if !b.HasInnerState() {
 		return ErrNilInnerState
 	}
-	if uint64(len(b.state.BlockRoots)) <= idx {
-		return fmt.Errorf("invalid index provided %d", idx)
-	}
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	if uint64(b.blockRootList.len()) <= idx {
+		return fmt.Errorf("invalid index provided %d", idx)
+	}
 
-	r := b.state.BlockRoots
+	r := b.blockRootList
 	if ref := b.sharedFieldReferences[blockRoots]; ref.Refs() > 1 {
-		// Copy elements in underlying array by reference.
-		r = make([][]byte, len(b.state.BlockRoots))
-		copy(r, b.state.BlockRoots)
+		// Copy the references to the chunks of the list.
+		r = r.copy()
 		ref.MinusRef()
 		b.sharedFieldReferences[blockRoots] = &reference{refs: 1}
 	}
 
-	r[idx] = blockRoot[:]
-	b.state.BlockRoots = r
+	r.set(int(idx), blockRoot[:])
+	b.blockRootList = r
 
 	b.markFieldAsDirty(blockRoots)
 	b.addDirtyIndices(blockRoots, []uint64{idx})
@@ -153,10 +152,10 @@ func (b *BeaconState) SetStateRoots(val [][]byte) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[stateRoots].MinusRef()
+	b.releaseFieldReference(stateRoots)
 	b.sharedFieldReferences[stateRoots] = &reference{refs: 1}
 
-	b.state.StateRoots = val
+	b.stateRootList = newRootList(val)
 	b.markFieldAsDirty(stateRoots)
 	b.rebuildTrie[stateRoots] = true
 	return nil
@@ -169,28 +168,23 @@ func (b *BeaconState) UpdateStateRootAtIndex(idx uint64, stateRoot [32]byte) err
 		return ErrNilInnerState
 	}
 
-	b.lock.RLock()
-	if uint64(len(b.state.StateRoots)) <= idx {
-		b.lock.RUnlock()
-		return errors.Errorf("invalid index provided %d", idx)
-	}
-	b.lock.RUnlock()
-
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	if uint64(b.stateRootList.len()) <= idx {
+		return errors.Errorf("invalid index provided %d", idx)
+	}
 
-	// Check if we hold the only reference to the shared state roots slice.
-	r := b.state.StateRoots
+	// Check if we hold the only reference to the shared state roots list.
+	r := b.stateRootList
 	if ref := b.sharedFieldReferences[stateRoots]; ref.Refs() > 1 {
-		// Copy elements in underlying array by reference.
-		r = make([][]byte, len(b.state.StateRoots))
-		copy(r, b.state.StateRoots)
+		// Copy the references to the chunks of the list.
+		r = r.copy()
 		ref.MinusRef()
 		b.sharedFieldReferences[stateRoots] = &reference{refs: 1}
 	}
 
-	r[idx] = stateRoot[:]
-	b.state.StateRoots = r
+	r.set(int(idx), stateRoot[:])
+	b.stateRootList = r
 
 	b.markFieldAsDirty(stateRoots)
 	b.addDirtyIndices(stateRoots, []uint64{idx})
@@ -291,13 +285,13 @@ func (b *BeaconState) SetValidators(val []*ethpb.Validator) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.state.Validators = val
-	b.sharedFieldReferences[validators].MinusRef()
+	b.releaseFieldReference(validators)
 	b.sharedFieldReferences[validators] = &reference{refs: 1}
+	b.validatorList = newValidatorList(val)
 	b.markFieldAsDirty(validators)
 	b.rebuildTrie[validators] = true
 	b.valMapHandler = &validatorMapHandler{
-		valIdxMap: coreutils.ValidatorIndexMap(b.state.Validators),
+		valIdxMap: coreutils.ValidatorIndexMap(val),
 		mapRef:    &reference{refs: 1},
 	}
 	return nil
@@ -310,29 +304,29 @@ func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator
 		return ErrNilInnerState
 	}
 	b.lock.Lock()
-	v := b.state.Validators
+	v := b.validatorList
 	if ref := b.sharedFieldReferences[validators]; ref.Refs() > 1 {
-		v = b.validatorsReferences()
+		v = v.copy()
 		ref.MinusRef()
 		b.sharedFieldReferences[validators] = &reference{refs: 1}
 	}
 	b.lock.Unlock()
 	var changedVals []uint64
-	for i, val := range v {
-		changed, newVal, err := f(i, val)
+	for i := 0; i < v.len(); i++ {
+		changed, newVal, err := f(i, v.at(i))
 		if err != nil {
 			return err
 		}
 		if changed {
 			changedVals = append(changedVals, uint64(i))
-			v[i] = newVal
+			v.set(i, newVal)
 		}
 	}
 
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.state.Validators = v
+	b.validatorList = v
 	b.markFieldAsDirty(validators)
 	b.addDirtyIndices(validators, changedVals)
 
@@ -345,21 +339,21 @@ func (b *BeaconState) UpdateValidatorAtIndex(idx types.ValidatorIndex, val *ethp
 	if !b.HasInnerState() {
 		return ErrNilInnerState
 	}
-	if uint64(len(b.state.Validators)) <= uint64(idx) {
-		return errors.Errorf("invalid index provided %d", idx)
-	}
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	if uint64(b.validatorList.len()) <= uint64(idx) {
+		return errors.Errorf("invalid index provided %d", idx)
+	}
 
-	v := b.state.Validators
+	v := b.validatorList
 	if ref := b.sharedFieldReferences[validators]; ref.Refs() > 1 {
-		v = b.validatorsReferences()
+		v = v.copy()
 		ref.MinusRef()
 		b.sharedFieldReferences[validators] = &reference{refs: 1}
 	}
 
-	v[idx] = val
-	b.state.Validators = v
+	v.set(int(idx), val)
+	b.validatorList = v
 	b.markFieldAsDirty(validators)
 	b.addDirtyIndices(validators, []uint64{uint64(idx)})
 
@@ -389,11 +383,12 @@ func (b *BeaconState) SetBalances(val []uint64) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[balances].MinusRef()
+	b.releaseFieldReference(balances)
 	b.sharedFieldReferences[balances] = &reference{refs: 1}
 
-	b.state.Balances = val
+	b.balanceList = newUint64List(val)
 	b.markFieldAsDirty(balances)
+	b.rebuildTrie[balances] = true
 	return nil
 }
 
@@ -403,22 +398,23 @@ func (b *BeaconState) UpdateBalancesAtIndex(idx types.ValidatorIndex, val uint64
 	if !b.HasInnerState() {
 		return ErrNilInnerState
 	}
-	if uint64(len(b.state.Balances)) <= uint64(idx) {
-		return errors.Errorf("invalid index provided %d", idx)
-	}
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	if uint64(b.balanceList.len()) <= uint64(idx) {
+		return errors.Errorf("invalid index provided %d", idx)
+	}
 
-	bals := b.state.Balances
+	bals := b.balanceList
 	if b.sharedFieldReferences[balances].Refs() > 1 {
-		bals = b.balances()
+		bals = bals.copy()
 		b.sharedFieldReferences[balances].MinusRef()
 		b.sharedFieldReferences[balances] = &reference{refs: 1}
 	}
 
-	bals[idx] = val
-	b.state.Balances = bals
+	bals.set(int(idx), val)
+	b.balanceList = bals
 	b.markFieldAsDirty(balances)
+	b.addDirtyIndices(balances, []uint64{uint64(idx)})
 	return nil
 }
 
@@ -431,10 +427,10 @@ func (b *BeaconState) SetRandaoMixes(val [][]byte) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[randaoMixes].MinusRef()
+	b.releaseFieldReference(randaoMixes)
 	b.sharedFieldReferences[randaoMixes] = &reference{refs: 1}
 
-	b.state.RandaoMixes = val
+	b.randaoMixList = newRootList(val)
 	b.markFieldAsDirty(randaoMixes)
 	b.rebuildTrie[randaoMixes] = true
 	return nil
@@ -446,23 +442,22 @@ func (b *BeaconState) UpdateRandaoMixesAtIndex(idx uint64, val []byte) error {
 	if !b.HasInnerState() {
 		return ErrNilInnerState
 	}
-	if uint64(len(b.state.RandaoMixes)) <= idx {
-		return errors.Errorf("invalid index provided %d", idx)
-	}
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	if uint64(b.randaoMixList.len()) <= idx {
+		return errors.Errorf("invalid index provided %d", idx)
+	}
 
-	mixes := b.state.RandaoMixes
+	mixes := b.randaoMixList
 	if refs := b.sharedFieldReferences[randaoMixes].Refs(); refs > 1 {
-		// Copy elements in underlying array by reference.
-		mixes = make([][]byte, len(b.state.RandaoMixes))
-		copy(mixes, b.state.RandaoMixes)
+		// Copy the references to the chunks of the list.
+		mixes = mixes.copy()
 		b.sharedFieldReferences[randaoMixes].MinusRef()
 		b.sharedFieldReferences[randaoMixes] = &reference{refs: 1}
 	}
 
-	mixes[idx] = val
-	b.state.RandaoMixes = mixes
+	mixes.set(int(idx), val)
+	b.randaoMixList = mixes
 	b.markFieldAsDirty(randaoMixes)
 	b.addDirtyIndices(randaoMixes, []uint64{idx})
 
@@ -628,16 +623,19 @@ func (b *BeaconState) AppendValidator(val *ethpb.Validator) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	vals := b.state.Validators
-	if b.sharedFieldReferences[validators].Refs() > 1 {
-		vals = b.validatorsReferences()
+	vals := b.validatorList
+	if vals == nil {
+		vals = newValidatorList([]*ethpb.Validator{})
+	} else if b.sharedFieldReferences[validators].Refs() > 1 {
+		vals = vals.copy()
 		b.sharedFieldReferences[validators].MinusRef()
 		b.sharedFieldReferences[validators] = &reference{refs: 1}
 	}
 
-	// append validator to slice
-	b.state.Validators = append(vals, val)
-	valIdx := types.ValidatorIndex(len(b.state.Validators) - 1)
+	// append validator to list
+	vals.append(val)
+	b.validatorList = vals
+	valIdx := types.ValidatorIndex(vals.len() - 1)
 
 	// Copy if this is a shared validator map
 	if ref := b.valMapHandler.mapRef; ref.Refs() > 1 {
@@ -661,15 +659,20 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	bals := b.state.Balances
-	if b.sharedFieldReferences[balances].Refs() > 1 {
-		bals = b.balances()
+	bals := b.balanceList
+	if bals == nil {
+		bals = newUint64List([]uint64{})
+	} else if b.sharedFieldReferences[balances].Refs() > 1 {
+		bals = bals.copy()
 		b.sharedFieldReferences[balances].MinusRef()
 		b.sharedFieldReferences[balances] = &reference{refs: 1}
 	}
 
-	b.state.Balances = append(bals, bal)
+	bals.append(bal)
+	b.balanceList = bals
+	balIdx := bals.len() - 1
 	b.markFieldAsDirty(balances)
+	b.addDirtyIndices(balances, []uint64{uint64(balIdx)})
 	return nil
 }
 
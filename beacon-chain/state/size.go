@@ -0,0 +1,81 @@
+package state
+
+// Approximate in-memory sizes of the beacon state contents, made of the SSZ size of the values and
+// the overhead of the Go slice headers, pointers and protobuf messages holding them.
+const (
+	sliceOverhead       = 24
+	messageOverhead     = 64
+	rootSize            = 32 + sliceOverhead
+	balanceSize         = 8
+	trieNodeSize        = 32 + 8
+	validatorEntrySize  = 48 + 8 + 16
+	beaconStateBaseSize = 1024
+)
+
+// SizeEstimate returns an estimate of the number of bytes of memory held by the beacon state.
+//
+// The fields, list chunks, field tries and validator index map shared with copies of the state
+// through copy-on-write references are accounted for pro rata of the states sharing them, so that the
+// estimates of a state and its copies add up to the memory they hold together.
+func (b *BeaconState) SizeEstimate() uint64 {
+	if !b.HasInnerState() {
+		return 0
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	size := uint64(beaconStateBaseSize)
+	size += b.sharedSize(blockRoots, b.blockRootList.sharedLen()*rootSize)
+	size += b.sharedSize(stateRoots, b.stateRootList.sharedLen()*rootSize)
+	size += b.sharedSize(historicalRoots, uint64(len(b.state.HistoricalRoots))*rootSize)
+	size += b.sharedSize(randaoMixes, b.randaoMixList.sharedLen()*rootSize)
+	size += b.sharedSize(balances, b.balanceList.sharedLen()*balanceSize)
+	size += b.sharedSize(slashings, uint64(len(b.state.Slashings))*balanceSize)
+	if b.validatorList.len() > 0 {
+		validatorSize := uint64(b.validatorList.at(0).SizeSSZ() + 2*sliceOverhead + messageOverhead)
+		size += b.sharedSize(validators, b.validatorList.sharedLen()*validatorSize)
+	}
+	if len(b.state.Eth1DataVotes) > 0 {
+		voteSize := uint64(b.state.Eth1DataVotes[0].SizeSSZ() + 2*sliceOverhead + messageOverhead)
+		size += b.sharedSize(eth1DataVotes, uint64(len(b.state.Eth1DataVotes))*voteSize)
+	}
+	var prevAttsSize, currAttsSize uint64
+	for _, a := range b.state.PreviousEpochAttestations {
+		prevAttsSize += uint64(a.SizeSSZ() + 4*messageOverhead)
+	}
+	for _, a := range b.state.CurrentEpochAttestations {
+		currAttsSize += uint64(a.SizeSSZ() + 4*messageOverhead)
+	}
+	size += b.sharedSize(previousEpochAttestations, prevAttsSize)
+	size += b.sharedSize(currentEpochAttestations, currAttsSize)
+
+	for _, fieldTrie := range b.stateFieldLeaves {
+		if fieldTrie == nil {
+			continue
+		}
+		size += perReference(fieldTrie.reference, fieldTrie.nodeCount()*trieNodeSize)
+	}
+	for _, layer := range b.merkleLayers {
+		size += uint64(len(layer)) * rootSize
+	}
+	if b.valMapHandler != nil {
+		size += perReference(b.valMapHandler.mapRef, uint64(len(b.valMapHandler.valIdxMap))*validatorEntrySize)
+	}
+	return size
+}
+
+// sharedSize returns the share of the input field size attributable to the state.
+func (b *BeaconState) sharedSize(field fieldIndex, size uint64) uint64 {
+	return perReference(b.sharedFieldReferences[field], size)
+}
+
+// perReference divides the size of a value by the number of references to it.
+func perReference(ref *reference, size uint64) uint64 {
+	if ref == nil {
+		return size
+	}
+	if refs := ref.Refs(); refs > 1 {
+		return size / uint64(refs)
+	}
+	return size
+}
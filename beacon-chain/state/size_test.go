@@ -0,0 +1,38 @@
+package state_test
+
+import (
+	"testing"
+
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconState_SizeEstimate(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	size := st.SizeEstimate()
+	// At least the validators and balances.
+	assert.Equal(t, true, size > 256*(121+8), "Size estimate %d is too small", size)
+
+	more, _ := testutil.DeterministicGenesisState(t, 512)
+	assert.Equal(t, true, more.SizeEstimate() > size, "Size estimate did not grow with the validator count")
+
+	assert.Equal(t, uint64(0), (&stateTrie.BeaconState{}).SizeEstimate())
+}
+
+func TestBeaconState_SizeEstimate_SharedReferences(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	size := st.SizeEstimate()
+
+	// The shared fields are split between the state and its copy.
+	cp := st.Copy()
+	shared := st.SizeEstimate()
+	assert.Equal(t, true, shared < size, "Expected the shared state to be smaller than %d, got %d", size, shared)
+	assert.Equal(t, shared, cp.SizeEstimate())
+
+	// Writing to a field of the copy makes it own the field again.
+	require.NoError(t, cp.UpdateBalancesAtIndex(0, 1))
+	assert.Equal(t, true, cp.SizeEstimate() > shared, "Copy on write did not grow the copy")
+	assert.Equal(t, true, st.SizeEstimate() > shared, "Copy on write did not grow the state")
+}
@@ -23,16 +23,29 @@ func InitializeFromProto(st *pbp2p.BeaconState) (*BeaconState, error) {
 	return InitializeFromProtoUnsafe(proto.Clone(st).(*pbp2p.BeaconState))
 }
 
-// InitializeFromProtoUnsafe directly uses the beacon state protobuf pointer
-// and sets it as the inner state of the BeaconState type.
+// InitializeFromProtoUnsafe directly uses the values of the beacon state protobuf
+// as the inner state of the BeaconState type, without copying them.
 func InitializeFromProtoUnsafe(st *pbp2p.BeaconState) (*BeaconState, error) {
 	if st == nil {
 		return nil, errors.New("received nil state")
 	}
 
+	// The lists stored in chunks are moved out of the inner state.
+	inner := *st
+	inner.Validators = nil
+	inner.Balances = nil
+	inner.RandaoMixes = nil
+	inner.BlockRoots = nil
+	inner.StateRoots = nil
+
 	fieldCount := params.BeaconConfig().BeaconStateFieldCount
 	b := &BeaconState{
-		state:                 st,
+		state:                 &inner,
+		validatorList:         newValidatorList(st.Validators),
+		balanceList:           newUint64List(st.Balances),
+		randaoMixList:         newRootList(st.RandaoMixes),
+		blockRootList:         newRootList(st.BlockRoots),
+		stateRootList:         newRootList(st.StateRoots),
 		dirtyFields:           make(map[fieldIndex]interface{}, fieldCount),
 		dirtyIndices:          make(map[fieldIndex][]uint64, fieldCount),
 		stateFieldLeaves:      make(map[fieldIndex]*FieldTrie, fieldCount),
@@ -64,6 +77,8 @@ func InitializeFromProtoUnsafe(st *pbp2p.BeaconState) (*BeaconState, error) {
 	b.sharedFieldReferences[balances] = &reference{refs: 1}
 	b.sharedFieldReferences[historicalRoots] = &reference{refs: 1}
 
+	// Finalizer runs when b is being destroyed in garbage collection.
+	runtime.SetFinalizer(b, (*BeaconState).releaseReferences)
 	return b, nil
 }
 
@@ -84,17 +99,12 @@ func (b *BeaconState) Copy() *BeaconState {
 			Eth1DepositIndex: b.state.Eth1DepositIndex,
 
 			// Large arrays, infrequently changed, constant size.
-			RandaoMixes:               b.state.RandaoMixes,
-			StateRoots:                b.state.StateRoots,
-			BlockRoots:                b.state.BlockRoots,
 			PreviousEpochAttestations: b.state.PreviousEpochAttestations,
 			CurrentEpochAttestations:  b.state.CurrentEpochAttestations,
 			Slashings:                 b.state.Slashings,
 			Eth1DataVotes:             b.state.Eth1DataVotes,
 
 			// Large arrays, increases over time.
-			Validators:      b.state.Validators,
-			Balances:        b.state.Balances,
 			HistoricalRoots: b.state.HistoricalRoots,
 
 			// Everything else, too small to be concerned about, constant size.
@@ -107,6 +117,13 @@ func (b *BeaconState) Copy() *BeaconState {
 			FinalizedCheckpoint:         b.finalizedCheckpoint(),
 			GenesisValidatorsRoot:       b.genesisValidatorRoot(),
 		},
+		// Large lists stored in chunks, shared as a whole until changed.
+		validatorList: b.validatorList,
+		balanceList:   b.balanceList,
+		randaoMixList: b.randaoMixList,
+		blockRootList: b.blockRootList,
+		stateRootList: b.stateRootList,
+
 		dirtyFields:           make(map[fieldIndex]interface{}, fieldCount),
 		dirtyIndices:          make(map[fieldIndex][]uint64, fieldCount),
 		rebuildTrie:           make(map[fieldIndex]bool, fieldCount),
@@ -160,18 +177,22 @@ func (b *BeaconState) Copy() *BeaconState {
 	}
 
 	// Finalizer runs when dst is being destroyed in garbage collection.
-	runtime.SetFinalizer(dst, func(b *BeaconState) {
-		for field, v := range b.sharedFieldReferences {
-			v.MinusRef()
-			if b.stateFieldLeaves[field].reference != nil {
-				b.stateFieldLeaves[field].MinusRef()
-			}
-		}
-	})
+	runtime.SetFinalizer(dst, (*BeaconState).releaseReferences)
 
 	return dst
 }
 
+// releaseReferences releases the references of a dropped state to the fields and field tries it
+// shares with other states, so the states still sharing them update them in place.
+func (b *BeaconState) releaseReferences() {
+	for field := range b.sharedFieldReferences {
+		b.releaseFieldReference(field)
+		if fTrie, ok := b.stateFieldLeaves[field]; ok {
+			fTrie.release()
+		}
+	}
+}
+
 // HashTreeRoot of the beacon state retrieves the Merkle root of the trie
 // representation of the beacon state based on the eth2 Simple Serialize specification.
 func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
@@ -182,7 +203,7 @@ func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
 	defer b.lock.Unlock()
 
 	if b.merkleLayers == nil || len(b.merkleLayers) == 0 {
-		fieldRoots, err := stateutil.ComputeFieldRoots(b.state)
+		fieldRoots, err := stateutil.ComputeFieldRoots(b.protoState())
 		if err != nil {
 			return [32]byte{}, err
 		}
@@ -215,7 +236,7 @@ func (b *BeaconState) FieldReferencesCount() map[string]uint64 {
 	for i, f := range b.stateFieldLeaves {
 		numOfRefs := uint64(f.Refs())
 		f.lock.RLock()
-		if len(f.fieldLayers) != 0 {
+		if !f.empty() {
 			refMap[i.String()+"_trie"] = numOfRefs
 		}
 		f.lock.RUnlock()
@@ -271,7 +292,7 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 		return stateutil.BlockHeaderRoot(b.state.LatestBlockHeader)
 	case blockRoots:
 		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.BlockRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+			err := b.resetFieldTrie(field, b.blockRootList, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
 			if err != nil {
 				return [32]byte{}, err
 			}
@@ -279,10 +300,10 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 			delete(b.rebuildTrie, field)
 			return b.stateFieldLeaves[field].TrieRoot()
 		}
-		return b.recomputeFieldTrie(blockRoots, b.state.BlockRoots)
+		return b.recomputeFieldTrie(blockRoots, b.blockRootList)
 	case stateRoots:
 		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.StateRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+			err := b.resetFieldTrie(field, b.stateRootList, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
 			if err != nil {
 				return [32]byte{}, err
 			}
@@ -290,7 +311,7 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 			delete(b.rebuildTrie, field)
 			return b.stateFieldLeaves[field].TrieRoot()
 		}
-		return b.recomputeFieldTrie(stateRoots, b.state.StateRoots)
+		return b.recomputeFieldTrie(stateRoots, b.stateRootList)
 	case historicalRoots:
 		return htrutils.HistoricalRootsRoot(b.state.HistoricalRoots)
 	case eth1Data:
@@ -308,7 +329,7 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 		return b.recomputeFieldTrie(field, b.state.Eth1DataVotes)
 	case validators:
 		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.Validators, params.BeaconConfig().ValidatorRegistryLimit)
+			err := b.resetFieldTrie(field, b.validatorList, params.BeaconConfig().ValidatorRegistryLimit)
 			if err != nil {
 				return [32]byte{}, err
 			}
@@ -316,12 +337,21 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 			delete(b.rebuildTrie, validators)
 			return b.stateFieldLeaves[field].TrieRoot()
 		}
-		return b.recomputeFieldTrie(validators, b.state.Validators)
+		return b.recomputeFieldTrie(validators, b.validatorList)
 	case balances:
-		return stateutil.ValidatorBalancesRoot(b.state.Balances)
+		if b.rebuildTrie[field] {
+			err := b.resetFieldTrie(field, b.balanceList, params.BeaconConfig().ValidatorRegistryLimit)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			b.dirtyIndices[field] = []uint64{}
+			delete(b.rebuildTrie, field)
+			return b.stateFieldLeaves[field].TrieRoot()
+		}
+		return b.recomputeFieldTrie(balances, b.balanceList)
 	case randaoMixes:
 		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.RandaoMixes, uint64(params.BeaconConfig().EpochsPerHistoricalVector))
+			err := b.resetFieldTrie(field, b.randaoMixList, uint64(params.BeaconConfig().EpochsPerHistoricalVector))
 			if err != nil {
 				return [32]byte{}, err
 			}
@@ -329,7 +359,7 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 			delete(b.rebuildTrie, field)
 			return b.stateFieldLeaves[field].TrieRoot()
 		}
-		return b.recomputeFieldTrie(randaoMixes, b.state.RandaoMixes)
+		return b.recomputeFieldTrie(randaoMixes, b.randaoMixList)
 	case slashings:
 		return htrutils.SlashingsRoot(b.state.Slashings)
 	case previousEpochAttestations:
@@ -395,6 +425,9 @@ func (b *BeaconState) resetFieldTrie(index fieldIndex, elements interface{}, len
 	if err != nil {
 		return err
 	}
+	if oldTrie, ok := b.stateFieldLeaves[index]; ok {
+		oldTrie.release()
+	}
 	b.stateFieldLeaves[index] = fTrie
 	b.dirtyIndices[index] = []uint64{}
 	return nil
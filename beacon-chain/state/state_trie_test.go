@@ -3,8 +3,11 @@ package state_test
 import (
 	"bytes"
 	"context"
+	"math/rand"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -42,7 +45,7 @@ func TestInitializeFromProto(t *testing.T) {
 		},
 		{
 			name:  "full state",
-			state: testState.InnerStateUnsafe(),
+			state: testState.ToProtoUnsafe(),
 		},
 	}
 	for _, tt := range initTests {
@@ -84,7 +87,7 @@ func TestInitializeFromProtoUnsafe(t *testing.T) {
 		},
 		{
 			name:  "full state",
-			state: testState.InnerStateUnsafe(),
+			state: testState.ToProtoUnsafe(),
 		},
 	}
 	for _, tt := range initTests {
@@ -140,6 +143,29 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 			},
 			error: "",
 		},
+		{
+			name: "different balance",
+			stateModify: func(beaconState *state.BeaconState) (*state.BeaconState, error) {
+				if err := beaconState.UpdateBalancesAtIndex(6, 1); err != nil {
+					return nil, err
+				}
+				return beaconState, nil
+			},
+			error: "",
+		},
+		{
+			name: "appended validator and balance",
+			stateModify: func(beaconState *state.BeaconState) (*state.BeaconState, error) {
+				if err := beaconState.AppendValidator(&eth.Validator{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32)}); err != nil {
+					return nil, err
+				}
+				if err := beaconState.AppendBalance(2); err != nil {
+					return nil, err
+				}
+				return beaconState, nil
+			},
+			error: "",
+		},
 	}
 
 	var err error
@@ -152,7 +178,7 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 			if err == nil && tt.error != "" {
 				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
 			}
-			genericHTR, err := testState.InnerStateUnsafe().HashTreeRoot()
+			genericHTR, err := testState.ToProtoUnsafe().HashTreeRoot()
 			if err == nil && tt.error != "" {
 				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
 			}
@@ -219,7 +245,7 @@ func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
 			if err == nil && tt.error != "" {
 				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
 			}
-			genericHTR, err := testState.InnerStateUnsafe().HashTreeRoot()
+			genericHTR, err := testState.ToProtoUnsafe().HashTreeRoot()
 			if err == nil && tt.error != "" {
 				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
 			}
@@ -233,6 +259,35 @@ func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
 	}
 }
 
+func TestBeaconState_HashTreeRoot_SharedFieldTries(t *testing.T) {
+	st0, _ := testutil.DeterministicGenesisState(t, 64)
+	root0, err := st0.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	st1 := st0.Copy()
+	require.NoError(t, st1.UpdateBalancesAtIndex(3, 5))
+	val, err := st1.ValidatorAtIndex(7)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st1.UpdateValidatorAtIndex(7, val))
+	root1, err := st1.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	genericHTR, err := st1.ToProtoUnsafe().HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, genericHTR[:], root1[:], "Expected hash tree root to match generic")
+
+	require.NoError(t, st0.UpdateBalancesAtIndex(4, 5))
+	root0Updated, err := st0.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	genericHTR, err = st0.ToProtoUnsafe().HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, genericHTR[:], root0Updated[:], "Expected hash tree root to match generic")
+	assert.DeepNotEqual(t, root0, root0Updated)
+	root1Again, err := st1.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, root1, root1Again, "Copy mutated by the original state")
+}
+
 func TestBeaconState_AppendValidator_DoesntMutateCopy(t *testing.T) {
 	st0, err := testutil.NewBeaconState()
 	require.NoError(t, err)
@@ -245,3 +300,171 @@ func TestBeaconState_AppendValidator_DoesntMutateCopy(t *testing.T) {
 	_, ok := st1.ValidatorIndexByPubkey(bytesutil.ToBytes48(val.PublicKey))
 	assert.Equal(t, false, ok, "Expected no validator index to be present in st1 for the newly inserted pubkey")
 }
+
+func TestBeaconState_ChunkedLists_CopiesMatchGenericRoot(t *testing.T) {
+	genesis, _ := testutil.DeterministicGenesisState(t, 300)
+	states := []*state.BeaconState{genesis}
+	shadows := []*pbp2p.BeaconState{genesis.CloneInnerState()}
+	randGen := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 16; i++ {
+		// Copy a random state, and change its lists and the ones of its copy.
+		idx := randGen.Intn(len(states))
+		copied := states[idx].Copy()
+		states = append(states, copied)
+		shadows = append(shadows, states[idx].CloneInnerState())
+		for _, j := range []int{idx, len(states) - 1} {
+			st, shadow := states[j], shadows[j]
+			for k := 0; k < 5; k++ {
+				valIdx := randGen.Intn(len(shadow.Validators))
+				switch randGen.Intn(7) {
+				case 0:
+					bal := randGen.Uint64()
+					require.NoError(t, st.UpdateBalancesAtIndex(types.ValidatorIndex(valIdx), bal))
+					shadow.Balances[valIdx] = bal
+				case 1:
+					val := proto.Clone(shadow.Validators[valIdx]).(*eth.Validator)
+					val.ExitEpoch = types.Epoch(randGen.Uint64())
+					require.NoError(t, st.UpdateValidatorAtIndex(types.ValidatorIndex(valIdx), proto.Clone(val).(*eth.Validator)))
+					shadow.Validators[valIdx] = val
+				case 2:
+					val := proto.Clone(shadow.Validators[valIdx]).(*eth.Validator)
+					val.PublicKey = bytesutil.PadTo([]byte{byte(i), byte(k), byte(j)}, 48)
+					require.NoError(t, st.AppendValidator(proto.Clone(val).(*eth.Validator)))
+					require.NoError(t, st.AppendBalance(val.EffectiveBalance))
+					shadow.Validators = append(shadow.Validators, val)
+					shadow.Balances = append(shadow.Balances, val.EffectiveBalance)
+				case 3:
+					root := bytesutil.ToBytes32([]byte{byte(i), byte(k), 'b'})
+					rootIdx := randGen.Intn(len(shadow.BlockRoots))
+					require.NoError(t, st.UpdateBlockRootAtIndex(uint64(rootIdx), root))
+					shadow.BlockRoots[rootIdx] = root[:]
+				case 4:
+					root := bytesutil.ToBytes32([]byte{byte(i), byte(k), 's'})
+					rootIdx := randGen.Intn(len(shadow.StateRoots))
+					require.NoError(t, st.UpdateStateRootAtIndex(uint64(rootIdx), root))
+					shadow.StateRoots[rootIdx] = root[:]
+				case 5:
+					mix := bytesutil.PadTo([]byte{byte(i), byte(k), 'r'}, 32)
+					mixIdx := randGen.Intn(len(shadow.RandaoMixes))
+					require.NoError(t, st.UpdateRandaoMixesAtIndex(uint64(mixIdx), mix))
+					shadow.RandaoMixes[mixIdx] = mix
+				case 6:
+					epoch := types.Epoch(randGen.Uint64())
+					require.NoError(t, st.ApplyToEveryValidator(func(idx int, val *eth.Validator) (bool, *eth.Validator, error) {
+						if idx%50 != valIdx%50 {
+							return false, nil, nil
+						}
+						newVal := proto.Clone(val).(*eth.Validator)
+						newVal.WithdrawableEpoch = epoch
+						return true, newVal, nil
+					}))
+					for v := valIdx % 50; v < len(shadow.Validators); v += 50 {
+						val := proto.Clone(shadow.Validators[v]).(*eth.Validator)
+						val.WithdrawableEpoch = epoch
+						shadow.Validators[v] = val
+					}
+				}
+			}
+		}
+
+		for _, j := range []int{idx, len(states) - 1} {
+			root, err := states[j].HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			want, err := shadows[j].HashTreeRoot()
+			require.NoError(t, err)
+			require.DeepEqual(t, want, root, "Wrong root of state %d after %d copies", j, i+1)
+		}
+	}
+
+	// No state is changed by the changes of the states sharing its lists.
+	for j, st := range states {
+		root, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		want, err := shadows[j].HashTreeRoot()
+		require.NoError(t, err)
+		require.DeepEqual(t, want, root, "Wrong root of state %d", j)
+		require.DeepSSZEqual(t, shadows[j], st.ToProtoUnsafe())
+	}
+}
+
+func TestBeaconState_HashTreeRoot_CompositeFieldsAfterCopyAndAppend(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetEth1DataVotes([]*eth.Eth1Data{
+		{DepositCount: 1, DepositRoot: bytesutil.PadTo([]byte{'a'}, 32), BlockHash: bytesutil.PadTo([]byte{'b'}, 32)},
+		{DepositCount: 2, DepositRoot: bytesutil.PadTo([]byte{'c'}, 32), BlockHash: bytesutil.PadTo([]byte{'d'}, 32)},
+	}))
+	pendingAtt := func(slot types.Slot) *pbp2p.PendingAttestation {
+		return &pbp2p.PendingAttestation{
+			AggregationBits: []byte{0b11},
+			Data: &eth.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &eth.Checkpoint{Root: make([]byte, 32)},
+				Target:          &eth.Checkpoint{Root: make([]byte, 32)},
+			},
+			InclusionDelay: 1,
+		}
+	}
+	require.NoError(t, st.SetPreviousEpochAttestations([]*pbp2p.PendingAttestation{pendingAtt(1), pendingAtt(2)}))
+	require.NoError(t, st.SetCurrentEpochAttestations([]*pbp2p.PendingAttestation{pendingAtt(3), pendingAtt(4)}))
+	// Build the field tries from the existing elements before copying the state.
+	_, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		append func(st *state.BeaconState) error
+	}{
+		{
+			name: "eth1 data votes",
+			append: func(st *state.BeaconState) error {
+				return st.AppendEth1DataVotes(&eth.Eth1Data{DepositCount: 3, DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)})
+			},
+		},
+		{
+			name: "validators",
+			append: func(st *state.BeaconState) error {
+				return st.AppendValidator(&eth.Validator{
+					PublicKey:             bytesutil.PadTo([]byte{'v'}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+				})
+			},
+		},
+		{
+			name: "balances",
+			append: func(st *state.BeaconState) error {
+				return st.AppendBalance(5)
+			},
+		},
+		{
+			name: "previous epoch attestations",
+			append: func(st *state.BeaconState) error {
+				return st.AppendPreviousEpochAttestations(pendingAtt(5))
+			},
+		},
+		{
+			name: "current epoch attestations",
+			append: func(st *state.BeaconState) error {
+				return st.AppendCurrentEpochAttestations(pendingAtt(6))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			copied := st.Copy()
+			require.NoError(t, tt.append(copied))
+			root, err := copied.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			want, err := copied.CloneInnerState().HashTreeRoot()
+			require.NoError(t, err)
+			assert.DeepEqual(t, want, root, "Expected hash tree root to match generic")
+
+			root, err = st.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			want, err = st.CloneInnerState().HashTreeRoot()
+			require.NoError(t, err)
+			assert.DeepEqual(t, want, root, "Original state mutated by its copy")
+		})
+	}
+}
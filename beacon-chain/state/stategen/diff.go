@@ -0,0 +1,191 @@
+package stategen
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// computeStateDiff returns the changes of the target state from the base state. The large fields
+// of the state are diffed field by field, the others are held as is in the diff. The base root
+// of the diff is left for the caller to set.
+func computeStateDiff(base, target *pb.BeaconState) (*dbpb.StateDiff, error) {
+	if base == nil || target == nil {
+		return nil, errors.New("nil state")
+	}
+	if len(target.Validators) < len(base.Validators) {
+		return nil, fmt.Errorf("validator registry shrank from %d to %d", len(base.Validators), len(target.Validators))
+	}
+	if len(target.Balances) < len(base.Balances) {
+		return nil, fmt.Errorf("balances shrank from %d to %d", len(base.Balances), len(target.Balances))
+	}
+	if len(target.HistoricalRoots) < len(base.HistoricalRoots) {
+		return nil, fmt.Errorf("historical roots shrank from %d to %d", len(base.HistoricalRoots), len(target.HistoricalRoots))
+	}
+	for i, r := range base.HistoricalRoots {
+		if !bytes.Equal(r, target.HistoricalRoots[i]) {
+			return nil, fmt.Errorf("historical root %d changed", i)
+		}
+	}
+
+	var err error
+	diff := &dbpb.StateDiff{}
+	if diff.BlockRoots, err = diffRoots(base.BlockRoots, target.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "could not diff block roots")
+	}
+	if diff.StateRoots, err = diffRoots(base.StateRoots, target.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "could not diff state roots")
+	}
+	if diff.RandaoMixes, err = diffRoots(base.RandaoMixes, target.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "could not diff randao mixes")
+	}
+	if len(base.Slashings) != len(target.Slashings) {
+		return nil, fmt.Errorf("slashings length changed from %d to %d", len(base.Slashings), len(target.Slashings))
+	}
+	for i, slashing := range target.Slashings {
+		if slashing != base.Slashings[i] {
+			diff.SlashingIndices = append(diff.SlashingIndices, uint64(i))
+			diff.Slashings = append(diff.Slashings, slashing)
+		}
+	}
+	diff.HistoricalRoots = target.HistoricalRoots[len(base.HistoricalRoots):]
+	for i, v := range target.Validators {
+		if i < len(base.Validators) && validatorsEqual(base.Validators[i], v) {
+			continue
+		}
+		diff.ValidatorIndices = append(diff.ValidatorIndices, uint64(i))
+		diff.Validators = append(diff.Validators, v)
+	}
+	// Balances change by a few increments between states, so their differences
+	// take less space than the balances themselves.
+	diff.BalanceDeltas = make([]int64, len(target.Balances))
+	for i, balance := range target.Balances {
+		if i < len(base.Balances) {
+			balance -= base.Balances[i]
+		}
+		diff.BalanceDeltas[i] = int64(balance)
+	}
+
+	small := *target
+	small.BlockRoots = nil
+	small.StateRoots = nil
+	small.HistoricalRoots = nil
+	small.Validators = nil
+	small.Balances = nil
+	small.RandaoMixes = nil
+	small.Slashings = nil
+	diff.State = &small
+	return diff, nil
+}
+
+// applyStateDiff returns the state resulting from applying the diff to the base state. The
+// base state is not modified, but the returned state shares objects with it and the diff.
+func applyStateDiff(base *pb.BeaconState, diff *dbpb.StateDiff) (*pb.BeaconState, error) {
+	if base == nil || diff == nil || diff.State == nil {
+		return nil, errors.New("nil state diff")
+	}
+
+	st := *diff.State
+	var err error
+	if st.BlockRoots, err = applyRoots(base.BlockRoots, diff.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "could not apply block roots")
+	}
+	if st.StateRoots, err = applyRoots(base.StateRoots, diff.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "could not apply state roots")
+	}
+	if st.RandaoMixes, err = applyRoots(base.RandaoMixes, diff.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "could not apply randao mixes")
+	}
+	if len(diff.SlashingIndices) != len(diff.Slashings) {
+		return nil, errors.New("slashing indices and slashings lengths differ")
+	}
+	st.Slashings = append(make([]uint64, 0, len(base.Slashings)), base.Slashings...)
+	for i, idx := range diff.SlashingIndices {
+		if idx >= uint64(len(st.Slashings)) {
+			return nil, fmt.Errorf("slashing index %d out of range", idx)
+		}
+		st.Slashings[idx] = diff.Slashings[i]
+	}
+	st.HistoricalRoots = make([][]byte, 0, len(base.HistoricalRoots)+len(diff.HistoricalRoots))
+	st.HistoricalRoots = append(append(st.HistoricalRoots, base.HistoricalRoots...), diff.HistoricalRoots...)
+
+	if len(diff.ValidatorIndices) != len(diff.Validators) {
+		return nil, errors.New("validator indices and validators lengths differ")
+	}
+	st.Validators = append(make([]*ethpb.Validator, 0, len(base.Validators)+len(diff.Validators)), base.Validators...)
+	for i, idx := range diff.ValidatorIndices {
+		switch {
+		case idx < uint64(len(st.Validators)):
+			st.Validators[idx] = diff.Validators[i]
+		case idx == uint64(len(st.Validators)):
+			st.Validators = append(st.Validators, diff.Validators[i])
+		default:
+			return nil, fmt.Errorf("validator index %d out of range", idx)
+		}
+	}
+	if len(diff.BalanceDeltas) < len(base.Balances) {
+		return nil, fmt.Errorf("balance deltas %d fewer than balances %d", len(diff.BalanceDeltas), len(base.Balances))
+	}
+	st.Balances = make([]uint64, len(diff.BalanceDeltas))
+	for i, delta := range diff.BalanceDeltas {
+		st.Balances[i] = uint64(delta)
+		if i < len(base.Balances) {
+			st.Balances[i] += base.Balances[i]
+		}
+	}
+	return &st, nil
+}
+
+// diffRoots returns the roots of the target vector which differ from those of the base vector.
+func diffRoots(base, target [][]byte) (*dbpb.SparseRoots, error) {
+	if len(base) != len(target) {
+		return nil, fmt.Errorf("length changed from %d to %d", len(base), len(target))
+	}
+	sparse := &dbpb.SparseRoots{}
+	for i, r := range target {
+		if !bytes.Equal(r, base[i]) {
+			sparse.Indices = append(sparse.Indices, uint64(i))
+			sparse.Roots = append(sparse.Roots, r)
+		}
+	}
+	return sparse, nil
+}
+
+// applyRoots returns a copy of the base vector of roots with the changed roots replaced.
+func applyRoots(base [][]byte, sparse *dbpb.SparseRoots) ([][]byte, error) {
+	roots := append(make([][]byte, 0, len(base)), base...)
+	if sparse == nil {
+		return roots, nil
+	}
+	if len(sparse.Indices) != len(sparse.Roots) {
+		return nil, errors.New("indices and roots lengths differ")
+	}
+	for i, idx := range sparse.Indices {
+		if idx >= uint64(len(roots)) {
+			return nil, fmt.Errorf("index %d out of range", idx)
+		}
+		roots[idx] = sparse.Roots[i]
+	}
+	return roots, nil
+}
+
+func validatorsEqual(a, b *ethpb.Validator) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials) &&
+		a.EffectiveBalance == b.EffectiveBalance &&
+		a.Slashed == b.Slashed &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch
+}
@@ -0,0 +1,99 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateDiff_RoundTrip(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	baseRoot, err := base.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	target := base.Copy()
+	require.NoError(t, target.SetSlot(100))
+	require.NoError(t, target.UpdateBlockRootAtIndex(3, [32]byte{'a'}))
+	require.NoError(t, target.UpdateStateRootAtIndex(4, [32]byte{'b'}))
+	require.NoError(t, target.UpdateRandaoMixesAtIndex(5, bytesutil.PadTo([]byte{'c'}, 32)))
+	require.NoError(t, target.UpdateSlashingsAtIndex(6, 1))
+	require.NoError(t, target.AppendHistoricalRoots([32]byte{'d'}))
+	v, err := target.ValidatorAtIndex(7)
+	require.NoError(t, err)
+	v.Slashed = true
+	require.NoError(t, target.UpdateValidatorAtIndex(7, v))
+	require.NoError(t, target.AppendValidator(&ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte{'e'}, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+	}))
+	require.NoError(t, target.AppendBalance(params.BeaconConfig().MaxEffectiveBalance))
+	require.NoError(t, target.UpdateBalancesAtIndex(0, params.BeaconConfig().MaxEffectiveBalance-1))
+	require.NoError(t, target.UpdateBalancesAtIndex(1, params.BeaconConfig().MaxEffectiveBalance+1))
+
+	diff, err := computeStateDiff(base.ToProtoUnsafe(), target.ToProtoUnsafe())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{3}, diff.BlockRoots.Indices)
+	assert.DeepEqual(t, []uint64{4}, diff.StateRoots.Indices)
+	assert.DeepEqual(t, []uint64{5}, diff.RandaoMixes.Indices)
+	assert.DeepEqual(t, []uint64{6}, diff.SlashingIndices)
+	assert.Equal(t, 1, len(diff.HistoricalRoots))
+	assert.DeepEqual(t, []uint64{7, 32}, diff.ValidatorIndices)
+	assert.Equal(t, int64(-1), diff.BalanceDeltas[0])
+	assert.Equal(t, int64(1), diff.BalanceDeltas[1])
+	assert.Equal(t, int64(0), diff.BalanceDeltas[2])
+
+	// The diff is applied as read from the DB.
+	enc, err := diff.Marshal()
+	require.NoError(t, err)
+	decoded := &dbpb.StateDiff{}
+	require.NoError(t, decoded.Unmarshal(enc))
+	got, err := applyStateDiff(base.ToProtoUnsafe(), decoded)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, target.ToProtoUnsafe(), got)
+
+	// The base state is not modified.
+	root, err := base.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, baseRoot, root)
+}
+
+func TestComputeStateDiff_Errors(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+
+	target := base.Copy()
+	require.NoError(t, target.SetValidators(target.Validators()[:31]))
+	_, err := computeStateDiff(base.ToProtoUnsafe(), target.ToProtoUnsafe())
+	assert.ErrorContains(t, "validator registry shrank", err)
+
+	require.NoError(t, base.AppendHistoricalRoots([32]byte{'a'}))
+	target = base.Copy()
+	require.NoError(t, target.SetHistoricalRoots([][]byte{bytesutil.PadTo([]byte{'b'}, 32)}))
+	_, err = computeStateDiff(base.ToProtoUnsafe(), target.ToProtoUnsafe())
+	assert.ErrorContains(t, "historical root 0 changed", err)
+}
+
+func TestApplyStateDiff_Errors(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	diff, err := computeStateDiff(base.ToProtoUnsafe(), base.ToProtoUnsafe())
+	require.NoError(t, err)
+
+	diff.ValidatorIndices = []uint64{33}
+	diff.Validators = []*ethpb.Validator{{}}
+	_, err = applyStateDiff(base.ToProtoUnsafe(), diff)
+	assert.ErrorContains(t, "validator index 33 out of range", err)
+
+	diff.ValidatorIndices = nil
+	diff.Validators = nil
+	diff.BlockRoots.Indices = []uint64{uint64(params.BeaconConfig().SlotsPerHistoricalRoot)}
+	diff.BlockRoots.Roots = [][]byte{make([]byte, 32)}
+	_, err = applyStateDiff(base.ToProtoUnsafe(), diff)
+	assert.ErrorContains(t, "could not apply block roots", err)
+}
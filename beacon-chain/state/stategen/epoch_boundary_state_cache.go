@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	beaconcache "github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"k8s.io/client-go/tools/cache"
 )
@@ -55,15 +57,27 @@ type epochBoundaryState struct {
 	rootStateCache *cache.FIFO
 	slotRootCache  *cache.FIFO
 	lock           sync.RWMutex
+	size           uint64
+	budget         *beaconcache.BudgetTracker
 }
 
 // newBoundaryStateCache creates a new block newBoundaryStateCache for storing and accessing epoch boundary states from
-// memory.
+// memory. Its states are tracked in the state memory budget.
 func newBoundaryStateCache() *epochBoundaryState {
-	return &epochBoundaryState{
+	e := &epochBoundaryState{
 		rootStateCache: cache.NewFIFO(rootKeyFn),
 		slotRootCache:  cache.NewFIFO(slotKeyFn),
+		size:           uint64(beaconcache.ConfiguredSize(flags.Get().EpochBoundaryStateCacheSize, int(maxCacheSize))),
 	}
+	e.budget = beaconcache.StateMemoryBudget.Track("epoch_boundary_state", beaconcache.EpochBoundaryStateCacheWeight,
+		func(key interface{}) {
+			e.lock.Lock()
+			defer e.lock.Unlock()
+			if err := e.delete(key.([32]byte)); err != nil {
+				log.WithError(err).Error("Could not evict epoch boundary state")
+			}
+		})
+	return e
 }
 
 // get epoch boundary state by its block root. Returns copied state in state info object if exists. Otherwise returns nil.
@@ -76,12 +90,14 @@ func (e *epochBoundaryState) getByRoot(r [32]byte) (*rootStateInfo, bool, error)
 		return nil, false, err
 	}
 	if !exists {
+		e.budget.Miss()
 		return nil, false, nil
 	}
 	s, ok := obj.(*rootStateInfo)
 	if !ok {
 		return nil, false, errNotRootStateInfo
 	}
+	e.budget.Hit(r)
 
 	return &rootStateInfo{
 		root:  r,
@@ -113,32 +129,64 @@ func (e *epochBoundaryState) getBySlot(s types.Slot) (*rootStateInfo, bool, erro
 // least recently added state info if the cache size has reached the max cache
 // size limit.
 func (e *epochBoundaryState) put(r [32]byte, s *stateTrie.BeaconState) error {
+	cp := s.Copy()
 	e.lock.Lock()
-	defer e.lock.Unlock()
-
 	if err := e.slotRootCache.AddIfNotPresent(&slotRootInfo{
 		slot: s.Slot(),
 		root: r,
 	}); err != nil {
+		e.lock.Unlock()
 		return err
 	}
 	if err := e.rootStateCache.AddIfNotPresent(&rootStateInfo{
 		root:  r,
-		state: s.Copy(),
+		state: cp,
 	}); err != nil {
+		e.lock.Unlock()
 		return err
 	}
 
-	trim(e.rootStateCache, maxCacheSize)
-	trim(e.slotRootCache, maxCacheSize)
+	trim(e.rootStateCache, e.size, func(obj interface{}) error {
+		if info, ok := obj.(*rootStateInfo); ok {
+			e.budget.Remove(info.root)
+		}
+		return nil
+	})
+	trim(e.slotRootCache, e.size, popProcessNoopFunc)
+	evicted := e.budget.Add(r, cp.SizeEstimate())
+	e.lock.Unlock()
+	evicted.Evict()
+	return nil
+}
 
+// delete removes the state of the block root, and its slot to root entry, from the cache.
+// It is called with the lock held.
+func (e *epochBoundaryState) delete(r [32]byte) error {
+	obj, exists, err := e.rootStateCache.GetByKey(string(r[:]))
+	if err != nil || !exists {
+		return err
+	}
+	info, ok := obj.(*rootStateInfo)
+	if !ok {
+		return errNotRootStateInfo
+	}
+	if err := e.rootStateCache.Delete(info); err != nil {
+		return err
+	}
+	slotObj, exists, err := e.slotRootCache.GetByKey(slotToString(info.state.Slot()))
+	if err != nil || !exists {
+		return err
+	}
+	if slotInfo, ok := slotObj.(*slotRootInfo); ok && slotInfo.root == r {
+		return e.slotRootCache.Delete(slotInfo)
+	}
 	return nil
 }
 
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize uint64) {
+// trim the FIFO queue to the maxSize, processing the popped items with the input function.
+func trim(queue *cache.FIFO, maxSize uint64, process cache.PopProcessFunc) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
-		if _, err := queue.Pop(popProcessNoopFunc); err != nil { // This never returns an error, but we'll handle anyway for sanity.
+		if _, err := queue.Pop(process); err != nil { // This never returns an error, but we'll handle anyway for sanity.
 			panic(err)
 		}
 	}
@@ -167,6 +167,11 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (*state
 		return s.beaconDB.State(ctx, blockRoot)
 	}
 
+	// Short cut if the state can be reconstructed from state diffs in the DB.
+	if s.hasStateDiff(ctx, blockRoot) {
+		return s.stateFromDiffs(ctx, blockRoot)
+	}
+
 	summary, err := s.stateSummary(ctx, blockRoot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get state summary")
@@ -232,10 +237,11 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (*state.Be
 // It recursively look up block's parent until a corresponding state of the block root
 // is found in the caches or DB.
 //
-// There's three ways to derive block parent state:
+// There's four ways to derive block parent state:
 // 1.) block parent state is the last finalized state
 // 2.) block parent state is the epoch boundary state and exists in epoch boundary cache.
 // 3.) block parent state is in DB.
+// 4.) block parent state is reconstructed from state diffs in DB.
 func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.lastAncestorState")
 	defer span.End()
@@ -285,6 +291,11 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (*state.Be
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
 		}
+
+		// Can the state be reconstructed from state diffs in DB.
+		if s.hasStateDiff(ctx, parentRoot) {
+			return s.stateFromDiffs(ctx, parentRoot)
+		}
 		b, err = s.beaconDB.Block(ctx, parentRoot)
 		if err != nil {
 			return nil, err
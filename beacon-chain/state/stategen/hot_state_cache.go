@@ -6,6 +6,8 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 )
 
@@ -25,19 +27,29 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point..
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache  *lru.Cache
+	lock   sync.RWMutex
+	budget *cache.BudgetTracker
 }
 
-// newHotStateCache initializes the map and underlying cache.
+// newHotStateCache initializes the map and underlying cache. Its states are tracked in the state
+// memory budget.
 func newHotStateCache() *hotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+	c := &hotStateCache{}
+	c.budget = cache.StateMemoryBudget.Track("hot_state", cache.HotStateCacheWeight, func(key interface{}) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.cache.Remove(key)
+	})
+	size := cache.ConfiguredSize(flags.Get().HotStateCacheSize, hotStateCacheSize)
+	lruCache, err := lru.NewWithEvict(size, func(key interface{}, _ interface{}) {
+		c.budget.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &hotStateCache{
-		cache: cache,
-	}
+	c.cache = lruCache
+	return c
 }
 
 // Get returns a cached response via input block root, if any.
@@ -49,9 +61,11 @@ func (c *hotStateCache) get(root [32]byte) *stateTrie.BeaconState {
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.budget.Hit(root)
 		return item.(*stateTrie.BeaconState).Copy()
 	}
 	hotStateCacheMiss.Inc()
+	c.budget.Miss()
 	return nil
 }
 
@@ -62,17 +76,21 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) *stateTrie.BeaconState {
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.budget.Hit(root)
 		return item.(*stateTrie.BeaconState)
 	}
 	hotStateCacheMiss.Inc()
+	c.budget.Miss()
 	return nil
 }
 
 // put the response in the cache.
 func (c *hotStateCache) put(root [32]byte, state *stateTrie.BeaconState) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
 	c.cache.Add(root, state)
+	evicted := c.budget.Add(root, state.SizeEstimate())
+	c.lock.Unlock()
+	evicted.Evict()
 }
 
 // has returns true if the key exists in the cache.
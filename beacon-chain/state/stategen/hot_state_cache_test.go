@@ -1,8 +1,11 @@
 package stategen
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -31,3 +34,70 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_ConfiguredSize(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{HotStateCacheSize: 2})
+	defer flags.Init(resetFlags)
+
+	c := newHotStateCache()
+	for i := byte(0); i < 3; i++ {
+		state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10})
+		require.NoError(t, err)
+		c.put([32]byte{i}, state)
+	}
+	assert.Equal(t, false, c.has([32]byte{0}), "Expected the oldest state to be evicted")
+	assert.Equal(t, true, c.has([32]byte{1}))
+	assert.Equal(t, true, c.has([32]byte{2}))
+}
+
+func TestHotStateCache_MemoryBudget(t *testing.T) {
+	prevBudget := cache.StateMemoryBudget
+	cache.StateMemoryBudget = cache.NewMemoryBudget(0)
+	defer func() {
+		cache.StateMemoryBudget = prevBudget
+	}()
+
+	c := newHotStateCache()
+	var size uint64
+	for i := byte(0); i < 4; i++ {
+		state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10, Balances: make([]uint64, 1000)})
+		require.NoError(t, err)
+		size = state.SizeEstimate()
+		c.put([32]byte{i}, state)
+	}
+	assert.NotNil(t, c.get([32]byte{0}))
+	cache.StateMemoryBudget.SetLimit(2 * size)
+	assert.Equal(t, true, c.has([32]byte{0}), "Expected the recently used state to be kept")
+	assert.Equal(t, false, c.has([32]byte{1}))
+	assert.Equal(t, false, c.has([32]byte{2}))
+	assert.Equal(t, true, c.has([32]byte{3}))
+
+	c.delete([32]byte{3})
+	assert.Equal(t, size, cache.StateMemoryBudget.Used())
+}
+
+func TestHotStateCache_MemoryBudgetConcurrentPuts(t *testing.T) {
+	prevBudget := cache.StateMemoryBudget
+	cache.StateMemoryBudget = cache.NewMemoryBudget(0)
+	defer func() {
+		cache.StateMemoryBudget = prevBudget
+	}()
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{HotStateCacheSize: 2})
+	defer flags.Init(resetFlags)
+
+	c := newHotStateCache()
+	state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10})
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+		go func(i byte) {
+			defer wg.Done()
+			c.put([32]byte{i % 8}, state)
+		}(byte(i))
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(c.cache.Len())*state.SizeEstimate(), cache.StateMemoryBudget.Used())
+}
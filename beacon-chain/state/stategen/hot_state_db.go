@@ -0,0 +1,217 @@
+package stategen
+
+import (
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	beaconcache "github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// maxHotStatesInDB is the number of hot states saved in the DB above which the interval between
+	// them is widened, and the saved states not on the wider interval are deleted.
+	maxHotStatesInDB = 64
+	// maxHotStateDBIntervalEpochs caps the interval between the hot states saved in the DB, which
+	// bounds the number of blocks replayed to regenerate a hot state evicted from the caches.
+	maxHotStateDBIntervalEpochs = types.Epoch(64)
+	// memoryPressureRatio is the ratio of the state cache memory budget used above which the hot
+	// state caches are considered under memory pressure.
+	memoryPressureRatio = 0.75
+)
+
+// AdjustSaveHotStateToDB tunes the interval between the hot epoch boundary states saved in the DB
+// to the number of epochs since finality and to the memory held by the state caches.
+//
+// The interval starts at the default interval and doubles every time the saved states would cover
+// the epochs since finality more than `maxHotStatesInDB` times, which bounds the growth of the DB
+// during long periods of non-finality. It is halved when the state caches are under memory pressure,
+// as the hot states evicted from the caches are then regenerated from the ones saved in the DB.
+// Since the intervals are powers of two multiples of the same number of epochs, the states saved
+// on a wider interval are also on the narrower ones.
+func (s *State) AdjustSaveHotStateToDB(ctx context.Context, epochsSinceFinality types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.AdjustSaveHotStateToDB")
+	defer span.End()
+
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	if !s.saveHotStateDB.enabled {
+		return nil
+	}
+
+	pressure := stateCachesUnderMemoryPressure()
+	duration := hotStateDBInterval(epochsSinceFinality, pressure)
+	if duration != s.saveHotStateDB.duration {
+		log.WithFields(logrus.Fields{
+			"epochsSinceFinality": epochsSinceFinality,
+			"memoryPressure":      pressure,
+			"slotsInterval":       duration,
+		}).Info("Adjusting interval to save hot states in DB")
+		s.saveHotStateDB.duration = duration
+		hotStateDBIntervalSlots.Set(float64(duration))
+	}
+	if len(s.saveHotStateDB.savedStateRoots) <= maxHotStatesInDB {
+		return nil
+	}
+
+	// Thin out the saved states which are not on the current interval.
+	var deleted [][32]byte
+	for _, r := range s.saveHotStateDB.savedStateRoots {
+		summary, err := s.beaconDB.StateSummary(ctx, r)
+		if err != nil {
+			return err
+		}
+		if summary != nil && summary.Slot%duration != 0 {
+			deleted = append(deleted, r)
+		}
+	}
+	s.deleteHotStates(ctx, deleted)
+	return nil
+}
+
+// hotStateDBInterval returns the number of slots between the hot states saved in the DB.
+func hotStateDBInterval(epochsSinceFinality types.Epoch, memoryPressure bool) types.Slot {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	epochs := types.Epoch(defaultHotStateDBInterval / slotsPerEpoch)
+	if epochs == 0 {
+		epochs = 1
+	}
+	for epochsSinceFinality/epochs > types.Epoch(maxHotStatesInDB) && epochs < maxHotStateDBIntervalEpochs {
+		epochs *= 2
+	}
+	if memoryPressure && epochs > 1 {
+		epochs /= 2
+	}
+	return slotsPerEpoch.Mul(uint64(epochs))
+}
+
+// stateCachesUnderMemoryPressure returns true if the state caches hold most of their memory budget.
+// They are never under memory pressure if the budget is unlimited.
+func stateCachesUnderMemoryPressure() bool {
+	limit := beaconcache.StateMemoryBudget.Limit()
+	if limit == 0 {
+		return false
+	}
+	return float64(beaconcache.StateMemoryBudget.Used()) >= memoryPressureRatio*float64(limit)
+}
+
+// pruneHotStateDB deletes the hot states saved in the DB which are no longer needed once the
+// finalized checkpoint advanced to the input block root: the states older than the finalized slot,
+// which are in the cold section, and the states of the forks which do not descend from the
+// finalized block. The states saved as archived points are no longer tracked by then.
+func (s *State) pruneHotStateDB(ctx context.Context, fSlot types.Slot, fRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.pruneHotStateDB")
+	defer span.End()
+
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	if len(s.saveHotStateDB.savedStateRoots) == 0 {
+		return nil
+	}
+
+	type savedState struct {
+		root [32]byte
+		slot types.Slot
+	}
+	saved := make([]savedState, 0, len(s.saveHotStateDB.savedStateRoots))
+	for _, r := range s.saveHotStateDB.savedStateRoots {
+		summary, err := s.beaconDB.StateSummary(ctx, r)
+		if err != nil {
+			return err
+		}
+		if summary != nil {
+			saved = append(saved, savedState{root: r, slot: summary.Slot})
+		}
+	}
+	// Walk the saved states in slot order, so the ancestry of the later ones is resolved by the
+	// blocks walked for the earlier ones.
+	sort.Slice(saved, func(i, j int) bool {
+		return saved[i].slot < saved[j].slot
+	})
+
+	descendants := map[[32]byte]bool{fRoot: true}
+	var deleted [][32]byte
+	for _, st := range saved {
+		if st.root == fRoot {
+			continue
+		}
+		if st.slot < fSlot {
+			deleted = append(deleted, st.root)
+			continue
+		}
+		ok, err := s.descendsFrom(ctx, st.root, fSlot, descendants)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			deleted = append(deleted, st.root)
+		}
+	}
+	if len(deleted) > 0 {
+		log.WithFields(logrus.Fields{
+			"finalizedSlot":    fSlot,
+			"deletedHotStates": len(deleted),
+		}).Info("Pruning hot states saved in DB")
+	}
+	s.deleteHotStates(ctx, deleted)
+	return nil
+}
+
+// descendsFrom returns true if the block of the input root descends from the finalized block of
+// the input slot. The ancestry of the walked blocks is recorded in the input map, which holds the
+// finalized block root.
+func (s *State) descendsFrom(ctx context.Context, root [32]byte, fSlot types.Slot, descendants map[[32]byte]bool) (bool, error) {
+	var walked [][32]byte
+	ok := false
+	for {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if known, seen := descendants[root]; seen {
+			ok = known
+			break
+		}
+		walked = append(walked, root)
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return false, err
+		}
+		if b == nil || b.Block.Slot <= fSlot {
+			break
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	for _, r := range walked {
+		descendants[r] = ok
+	}
+	return ok, nil
+}
+
+// deleteHotStates deletes the input hot states from the DB and stops tracking them. The states
+// which could not be deleted, such as the head state, remain tracked to be deleted later. It is
+// called with the hot state DB lock held.
+func (s *State) deleteHotStates(ctx context.Context, roots [][32]byte) {
+	if len(roots) == 0 {
+		return
+	}
+	deleted := make(map[[32]byte]bool, len(roots))
+	for _, r := range roots {
+		if err := s.beaconDB.DeleteState(ctx, r); err != nil {
+			log.WithError(err).WithField("root", bytesutil.Trunc(r[:])).Debug("Could not delete hot state from DB")
+			continue
+		}
+		deleted[r] = true
+	}
+	remaining := make([][32]byte, 0, len(s.saveHotStateDB.savedStateRoots)-len(deleted))
+	for _, r := range s.saveHotStateDB.savedStateRoots {
+		if !deleted[r] {
+			remaining = append(remaining, r)
+		}
+	}
+	s.saveHotStateDB.savedStateRoots = remaining
+	hotStatesSavedInDB.Set(float64(len(remaining)))
+}
@@ -0,0 +1,206 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestHotStateDBInterval(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	defaultEpochs := uint64(defaultHotStateDBInterval / slotsPerEpoch)
+	tests := []struct {
+		sinceFinality  types.Epoch
+		memoryPressure bool
+		want           types.Slot
+	}{
+		{sinceFinality: 100, want: defaultHotStateDBInterval},
+		{sinceFinality: types.Epoch(defaultEpochs) * types.Epoch(maxHotStatesInDB), want: defaultHotStateDBInterval},
+		{sinceFinality: types.Epoch(defaultEpochs)*types.Epoch(maxHotStatesInDB) + types.Epoch(defaultEpochs), want: 2 * defaultHotStateDBInterval},
+		{sinceFinality: 1 << 20, want: slotsPerEpoch.Mul(uint64(maxHotStateDBIntervalEpochs))},
+		{sinceFinality: 100, memoryPressure: true, want: defaultHotStateDBInterval / 2},
+		{sinceFinality: 1 << 20, memoryPressure: true, want: slotsPerEpoch.Mul(uint64(maxHotStateDBIntervalEpochs / 2))},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, hotStateDBInterval(tt.sinceFinality, tt.memoryPressure),
+			"Wrong interval for %d epochs since finality with memory pressure %v", tt.sinceFinality, tt.memoryPressure)
+	}
+}
+
+func TestStateCachesUnderMemoryPressure(t *testing.T) {
+	prevBudget := cache.StateMemoryBudget
+	cache.StateMemoryBudget = cache.NewMemoryBudget(0)
+	defer func() {
+		cache.StateMemoryBudget = prevBudget
+	}()
+
+	tracker := cache.StateMemoryBudget.Track("test", 1, nil)
+	tracker.Add("a", 80)
+	assert.Equal(t, false, stateCachesUnderMemoryPressure(), "Unlimited budget is under memory pressure")
+	cache.StateMemoryBudget.SetLimit(200)
+	assert.Equal(t, false, stateCachesUnderMemoryPressure())
+	tracker.Add("b", 80)
+	assert.Equal(t, true, stateCachesUnderMemoryPressure())
+}
+
+func TestAdjustSaveHotStateToDB_Disabled(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	require.NoError(t, service.AdjustSaveHotStateToDB(context.Background(), 1<<20))
+	assert.Equal(t, defaultHotStateDBInterval, service.saveHotStateDB.duration)
+}
+
+func TestPruneHotStateDB(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	// Chain of blocks 1 <- 2 <- 4 with a fork 1 <- 3 <- 5, finalized at 2.
+	parents := map[types.Slot]types.Slot{2: 1, 3: 1, 4: 2, 5: 3}
+	roots := make(map[types.Slot][32]byte)
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	for slot := types.Slot(1); slot <= 5; slot++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		if parent, ok := parents[slot]; ok {
+			r := roots[parent]
+			b.Block.ParentRoot = r[:]
+		}
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = r
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, service.saveStateByRoot(ctx, r, st))
+	}
+	require.NoError(t, beaconDB.SaveState(ctx, st, roots[1]))
+	require.NoError(t, beaconDB.SaveState(ctx, st, roots[3]))
+	require.NoError(t, beaconDB.SaveState(ctx, st, roots[4]))
+	require.NoError(t, beaconDB.SaveState(ctx, st, roots[5]))
+	service.saveHotStateDB.savedStateRoots = [][32]byte{roots[5], roots[4], roots[3], roots[1], {'a'}}
+
+	require.NoError(t, service.pruneHotStateDB(ctx, 2, roots[2]))
+	assert.DeepEqual(t, [][32]byte{roots[4], {'a'}}, service.saveHotStateDB.savedStateRoots)
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[1]), "Finalized hot state not deleted")
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[3]), "Orphaned hot state not deleted")
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[5]), "Orphaned hot state not deleted")
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[4]))
+}
+
+// This simulates 100 epochs without finality with a block at the start of every epoch and a fork
+// at the end, and checks the hot states saved in the DB stay bounded, serve to regenerate the hot
+// states, and are migrated or deleted once finality resumes.
+func TestSaveHotStateToDB_LongNonFinality(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 2048
+	service.stateDiffIntervals = nil
+	prevMax := maxHotStatesInDB
+	maxHotStatesInDB = 8
+	defer func() {
+		maxHotStatesInDB = prevMax
+	}()
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	genesisStateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	const epochs = 100
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := make([][32]byte, epochs+1)
+	states := make([]*state.BeaconState, epochs+1)
+	roots[0], states[0] = gRoot, st.Copy()
+	addBlock := func(parent *state.BeaconState, slot types.Slot) ([32]byte, *state.BeaconState) {
+		b, err := testutil.GenerateFullBlock(parent, keys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err := transition.ExecuteStateTransition(ctx, parent.Copy(), b)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, service.SaveState(ctx, r, st))
+		return r, st
+	}
+
+	service.EnableSaveHotStateToDB(ctx)
+	for epoch := types.Epoch(1); epoch <= epochs; epoch++ {
+		require.NoError(t, service.AdjustSaveHotStateToDB(ctx, epoch))
+		roots[epoch], states[epoch] = addBlock(states[epoch-1], slotsPerEpoch.Mul(uint64(epoch)))
+		assert.Equal(t, true, len(service.saveHotStateDB.savedStateRoots) <= maxHotStatesInDB+1,
+			"Too many hot states saved in DB at epoch %d", epoch)
+	}
+	require.LogsContain(t, hook, "Adjusting interval to save hot states in DB")
+	duration := service.saveHotStateDB.duration
+	assert.Equal(t, true, duration > defaultHotStateDBInterval, "Interval was not widened")
+	for _, r := range service.saveHotStateDB.savedStateRoots {
+		require.Equal(t, true, beaconDB.HasState(ctx, r))
+		summary, err := beaconDB.StateSummary(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(0), summary.Slot%defaultHotStateDBInterval, "Saved hot state not on the interval")
+	}
+
+	// A fork off the chain before the finalized checkpoint, up to a block on the interval.
+	forkState := states[88]
+	for slot := slotsPerEpoch.Mul(88) + 1; slot < slotsPerEpoch.Mul(96); slot += slotsPerEpoch {
+		_, forkState = addBlock(forkState, slot)
+	}
+	orphanRoot, _ := addBlock(forkState, slotsPerEpoch.Mul(96))
+	require.Equal(t, true, beaconDB.HasState(ctx, orphanRoot), "Fork state not saved in DB")
+
+	// The hot states evicted from the caches are regenerated from the ones saved in DB.
+	service.hotStateCache = newHotStateCache()
+	service.epochBoundaryStateCache = newBoundaryStateCache()
+	regenerated, err := service.StateByRoot(ctx, roots[97])
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, states[97].ToProtoUnsafe(), regenerated.ToProtoUnsafe())
+
+	// Finality resumes at epoch 90.
+	fRoot := roots[90]
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 90, Root: fRoot[:]}))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+	fSlot := slotsPerEpoch.Mul(90)
+	assert.Equal(t, false, beaconDB.HasState(ctx, orphanRoot), "Orphaned hot state not deleted")
+	archivedRoot := roots[2048/slotsPerEpoch-1]
+	assert.Equal(t, true, beaconDB.HasState(ctx, archivedRoot), "Archived point not saved in DB")
+	for _, r := range service.saveHotStateDB.savedStateRoots {
+		summary, err := beaconDB.StateSummary(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, true, summary.Slot >= fSlot, "Finalized hot state not deleted")
+	}
+	for epoch := types.Epoch(1); epoch < 90; epoch++ {
+		if roots[epoch] != archivedRoot {
+			assert.Equal(t, false, beaconDB.HasState(ctx, roots[epoch]), "Finalized hot state of epoch %d not deleted", epoch)
+		}
+	}
+	tracked := len(service.saveHotStateDB.savedStateRoots)
+	require.Equal(t, true, tracked > 0, "Expected hot states after the finalized checkpoint")
+
+	require.NoError(t, service.DisableSaveHotStateToDB(ctx))
+	assert.Equal(t, 0, len(service.saveHotStateDB.savedStateRoots))
+	assert.Equal(t, defaultHotStateDBInterval, service.saveHotStateDB.duration)
+	for epoch := types.Epoch(91); epoch <= epochs; epoch++ {
+		assert.Equal(t, false, beaconDB.HasState(ctx, roots[epoch]), "Hot state of epoch %d not deleted", epoch)
+	}
+	headState, err := service.StateByRoot(ctx, roots[epochs])
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, states[epochs].ToProtoUnsafe(), headState.ToProtoUnsafe())
+}
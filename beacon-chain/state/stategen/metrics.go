@@ -13,4 +13,19 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	stateDiffCount = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "state_diffs_count",
+			Help:    "The number of state diffs to apply to reconstruct a state",
+			Buckets: []float64{1, 2, 3, 4, 8},
+		},
+	)
+	hotStatesSavedInDB = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_states_saved_in_db",
+		Help: "The number of hot states saved in the DB during a long period of non-finality",
+	})
+	hotStateDBIntervalSlots = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_state_db_interval_slots",
+		Help: "The number of slots between the hot states saved in the DB during a long period of non-finality",
+	})
 )
@@ -33,11 +33,19 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot.
 	// If the slot is on archived point, save the state of that slot to the DB.
+	// If the slot is on a state diff, save the diff of the state of that slot to the DB.
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
+		// State diffs only speed up historical state access, failing to save one is not fatal.
+		if s.isStateDiffSlot(slot) {
+			if err := s.saveStateDiff(ctx, slot); err != nil {
+				log.WithError(err).WithField("slot", slot).Warn("Could not save state diff")
+			}
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
@@ -115,5 +123,6 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		s.SaveFinalizedState(fSlot, fRoot, fInfo.state)
 	}
 
-	return nil
+	// Delete the hot states saved in DB which are finalized or orphaned by the new finalized checkpoint.
+	return s.pruneHotStateDB(ctx, fSlot, fRoot)
 }
@@ -110,6 +110,11 @@ func (m *MockStateManager) DisableSaveHotStateToDB(ctx context.Context) error {
 	panic("implement me")
 }
 
+// AdjustSaveHotStateToDB --
+func (m *MockStateManager) AdjustSaveHotStateToDB(ctx context.Context, epochsSinceFinality types.Epoch) error {
+	panic("implement me")
+}
+
 // AddStateForRoot --
 func (m *MockStateManager) AddStateForRoot(state *state.BeaconState, blockRoot [32]byte) {
 	m.StatesByRoot[blockRoot] = state
@@ -51,6 +51,33 @@ func (s *State) ReplayBlocks(ctx context.Context, state *stateTrie.BeaconState,
 	return state, nil
 }
 
+// AdvanceState advances the input canonical state to the target slot by replaying the canonical
+// blocks saved in between. This lets callers walk historical states in slot order without
+// regenerating each of them from the last saved state. The input state is mutated.
+func (s *State) AdvanceState(ctx context.Context, state *stateTrie.BeaconState, targetSlot types.Slot) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.AdvanceState")
+	defer span.End()
+
+	if targetSlot < state.Slot() {
+		return nil, fmt.Errorf("target slot %d < state slot %d", targetSlot, state.Slot())
+	}
+	if targetSlot == state.Slot() {
+		return state, nil
+	}
+	lastRoot, lastSlot, err := s.lastSavedBlock(ctx, targetSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last saved block")
+	}
+	var blks []*ethpb.SignedBeaconBlock
+	if lastSlot > state.Slot() {
+		blks, err = s.LoadBlocks(ctx, state.Slot()+1, lastSlot, lastRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load blocks")
+		}
+	}
+	return s.ReplayBlocks(ctx, state, blks, targetSlot)
+}
+
 // LoadBlocks loads the blocks between start slot and end slot by recursively fetching from end block root.
 // The Blocks are returned in slot-descending order.
 func (s *State) LoadBlocks(ctx context.Context, startSlot, endSlot types.Slot, endBlockRoot [32]byte) ([]*ethpb.SignedBeaconBlock, error) {
@@ -364,7 +364,7 @@ func TestLastSavedState_Genesis(t *testing.T) {
 
 	savedState, err := s.lastSavedState(ctx, 0)
 	require.NoError(t, err)
-	require.DeepSSZEqual(t, gState.InnerStateUnsafe(), savedState.InnerStateUnsafe())
+	require.DeepSSZEqual(t, gState.ToProtoUnsafe(), savedState.ToProtoUnsafe())
 }
 
 func TestLastSavedState_CanGet(t *testing.T) {
@@ -394,7 +394,7 @@ func TestLastSavedState_CanGet(t *testing.T) {
 
 	savedState, err := s.lastSavedState(ctx, s.finalizedInfo.slot+100)
 	require.NoError(t, err)
-	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedState.InnerStateUnsafe())
+	require.DeepSSZEqual(t, st.ToProtoUnsafe(), savedState.ToProtoUnsafe())
 }
 
 func TestLastSavedState_NoSavedBlockState(t *testing.T) {
@@ -745,3 +745,29 @@ func TestLoadFinalizedBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 10, len(filteredBlocks))
 }
+
+func TestAdvanceState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	roots := saveFullChain(t, ctx, beaconDB, 10)
+	service := New(beaconDB)
+
+	st, err := beaconDB.GenesisState(ctx)
+	require.NoError(t, err)
+	for _, slot := range []types.Slot{0, 4, 10, 12} {
+		st, err = service.AdvanceState(ctx, st, slot)
+		require.NoError(t, err)
+		assert.Equal(t, slot, st.Slot())
+		want, err := service.StateBySlot(ctx, slot)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, want.ToProtoUnsafe(), st.ToProtoUnsafe(), "Wrong state of slot %d", slot)
+	}
+	want, err := service.StateByRoot(ctx, roots[10])
+	require.NoError(t, err)
+	wantRoot, err := want.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, bytesutil.ToBytes32(st.LatestBlockHeader().StateRoot))
+
+	_, err = service.AdvanceState(ctx, st, 11)
+	assert.ErrorContains(t, "target slot 11 < state slot 12", err)
+}
@@ -38,12 +38,14 @@ type StateManager interface {
 	ForceCheckpoint(ctx context.Context, root []byte) error
 	EnableSaveHotStateToDB(_ context.Context)
 	DisableSaveHotStateToDB(ctx context.Context) error
+	AdjustSaveHotStateToDB(ctx context.Context, epochsSinceFinality types.Epoch) error
 }
 
 // State is a concrete implementation of StateManager.
 type State struct {
 	beaconDB                db.NoHeadAccessDatabase
 	slotsPerArchivedPoint   types.Slot
+	stateDiffIntervals      []types.Slot
 	hotStateCache           *hotStateCache
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
@@ -76,6 +78,7 @@ func New(beaconDB db.NoHeadAccessDatabase) *State {
 		hotStateCache:           newHotStateCache(),
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
+		stateDiffIntervals:      stateDiffIntervals(params.BeaconConfig().SlotsPerArchivedPoint, params.BeaconConfig().SlotsPerStateDiff),
 		epochBoundaryStateCache: newBoundaryStateCache(),
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
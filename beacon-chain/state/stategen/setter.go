@@ -49,16 +49,16 @@ func (s *State) saveStateByRoot(ctx context.Context, blockRoot [32]byte, st *sta
 	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateByRoot")
 	defer span.End()
 
+	s.saveHotStateDB.lock.Lock()
 	// Duration can't be 0 to prevent panic for division.
 	duration := uint64(math.Max(float64(s.saveHotStateDB.duration), 1))
-
-	s.saveHotStateDB.lock.Lock()
-	if s.saveHotStateDB.enabled && st.Slot().Mod(duration) == 0 {
+	if s.saveHotStateDB.enabled && st.Slot().Mod(duration) == 0 && !s.beaconDB.HasState(ctx, blockRoot) {
 		if err := s.beaconDB.SaveState(ctx, st, blockRoot); err != nil {
 			s.saveHotStateDB.lock.Unlock()
 			return err
 		}
 		s.saveHotStateDB.savedStateRoots = append(s.saveHotStateDB.savedStateRoots, blockRoot)
+		hotStatesSavedInDB.Set(float64(len(s.saveHotStateDB.savedStateRoots)))
 
 		log.WithFields(logrus.Fields{
 			"slot":                   st.Slot(),
@@ -103,6 +103,7 @@ func (s *State) EnableSaveHotStateToDB(_ context.Context) {
 	}
 
 	s.saveHotStateDB.enabled = true
+	hotStateDBIntervalSlots.Set(float64(s.saveHotStateDB.duration))
 
 	log.WithFields(logrus.Fields{
 		"enabled":       s.saveHotStateDB.enabled,
@@ -124,12 +125,19 @@ func (s *State) DisableSaveHotStateToDB(ctx context.Context) error {
 		"deletedHotStates": len(s.saveHotStateDB.savedStateRoots),
 	}).Warn("Exiting mode to save hot states in DB")
 
-	// Delete previous saved states in DB as we are turning this mode off.
+	// Delete previous saved states in DB as we are turning this mode off. The finalized state is
+	// kept, it is the state the hot section starts from.
 	s.saveHotStateDB.enabled = false
-	if err := s.beaconDB.DeleteStates(ctx, s.saveHotStateDB.savedStateRoots); err != nil {
-		return err
+	s.saveHotStateDB.duration = defaultHotStateDBInterval
+	hotStateDBIntervalSlots.Set(0)
+	roots := make([][32]byte, 0, len(s.saveHotStateDB.savedStateRoots))
+	for _, r := range s.saveHotStateDB.savedStateRoots {
+		if !s.isFinalizedRoot(r) {
+			roots = append(roots, r)
+		}
 	}
-	s.saveHotStateDB.savedStateRoots = nil
+	s.saveHotStateDB.savedStateRoots = roots
+	s.deleteHotStates(ctx, roots)
 
 	return nil
 }
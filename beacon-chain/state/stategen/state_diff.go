@@ -0,0 +1,242 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Between archived points, the cold section of the DB can hold diffs of states from base
+// states, to reconstruct historical states by applying a few diffs to an archived state
+// rather than replaying up to an archived point worth of blocks. Diffs are saved at levels of
+// decreasing durations: the base state of a diff is the previous state of the coarser level,
+// the coarsest level using the archived states as base states. The hot states saved in the DB
+// are deleted once finalized, so they are never used as base states.
+
+// stateDiffFactor is the ratio of the durations between the state diffs of consecutive levels.
+const stateDiffFactor = 8
+
+// maxStateDiffDepth bounds the number of diffs applied to reconstruct a state, which the
+// levels of diffs keep far lower, in case of a corrupted DB.
+const maxStateDiffDepth = 16
+
+// stateDiffIntervals returns the durations between the state diffs of each level, from the
+// coarsest to the finest level: the multiples of slots per state diff by powers of the state
+// diff factor which divide slots per archived point. It returns no levels if state diffs
+// are disabled or slots per state diff do not divide slots per archived point.
+func stateDiffIntervals(slotsPerArchivedPoint, slotsPerStateDiff types.Slot) []types.Slot {
+	if slotsPerStateDiff == 0 || slotsPerArchivedPoint%slotsPerStateDiff != 0 {
+		return nil
+	}
+	var intervals []types.Slot
+	for i := slotsPerStateDiff; i < slotsPerArchivedPoint && slotsPerArchivedPoint%i == 0; i *= stateDiffFactor {
+		intervals = append([]types.Slot{i}, intervals...)
+	}
+	return intervals
+}
+
+// isStateDiffSlot returns true if the diff of the state of the slot is saved in the DB.
+func (s *State) isStateDiffSlot(slot types.Slot) bool {
+	if len(s.stateDiffIntervals) == 0 || slot == 0 || slot%s.slotsPerArchivedPoint == 0 {
+		return false
+	}
+	return slot%s.stateDiffIntervals[len(s.stateDiffIntervals)-1] == 0
+}
+
+// stateDiffBaseSlot returns the slot of the base state of the diff of the state of the slot.
+func (s *State) stateDiffBaseSlot(slot types.Slot) types.Slot {
+	interval := s.slotsPerArchivedPoint
+	for _, i := range s.stateDiffIntervals {
+		if slot%i == 0 {
+			break
+		}
+		interval = i
+	}
+	return slot - slot%interval
+}
+
+// hasStateDiff returns true if state diffs are enabled and the diff of the state of the block
+// root is in the DB.
+func (s *State) hasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	return len(s.stateDiffIntervals) > 0 && s.beaconDB.HasStateDiff(ctx, blockRoot)
+}
+
+// saveStateDiff saves the diff of the state of the slot from its base state to the DB, unless
+// the diff is already in the DB or the state is the base state. Like archived states, the state
+// of a slot is the state of the epoch boundary state cache, or the state of the highest block at
+// or below the slot.
+func (s *State) saveStateDiff(ctx context.Context, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateDiff")
+	defer span.End()
+
+	var root [32]byte
+	var st *state.BeaconState
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return err
+	}
+	if exists {
+		root = cached.root
+		st = cached.state
+	} else {
+		blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, slot+1)
+		if err != nil {
+			return err
+		}
+		// Given the block has been finalized, the db should not have more than one block in a given slot.
+		if len(blks) != 1 {
+			return errUnknownBlock
+		}
+		root, err = blks[0].Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+	}
+	if s.beaconDB.HasStateDiff(ctx, root) {
+		return nil
+	}
+
+	baseRoot, err := s.stateDiffBaseRoot(ctx, root, s.stateDiffBaseSlot(slot))
+	if err != nil {
+		return errors.Wrap(err, "could not get base state root")
+	}
+	if baseRoot == root {
+		return nil
+	}
+	base, err := s.beaconDB.State(ctx, baseRoot)
+	if err != nil {
+		return err
+	}
+	if base == nil {
+		base, err = s.stateFromDiffs(ctx, baseRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get base state")
+		}
+	}
+	if st == nil {
+		st, err = s.StateByRoot(ctx, root)
+		if err != nil {
+			return err
+		}
+	}
+
+	diff, err := computeStateDiff(base.ToProtoUnsafe(), st.ToProtoUnsafe())
+	if err != nil {
+		return errors.Wrap(err, "could not compute state diff")
+	}
+	diff.BaseRoot = baseRoot[:]
+	if err := s.beaconDB.SaveStateDiff(ctx, root, diff); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":     st.Slot(),
+		"root":     hex.EncodeToString(bytesutil.Trunc(root[:])),
+		"baseRoot": hex.EncodeToString(bytesutil.Trunc(baseRoot[:])),
+	}).Debug("Saved state diff in DB")
+	return nil
+}
+
+// stateDiffBaseRoot returns the root of the highest ancestor of the block root at or below the
+// base slot, with an archived state or a state diff in the DB.
+func (s *State) stateDiffBaseRoot(ctx context.Context, blockRoot [32]byte, baseSlot types.Slot) ([32]byte, error) {
+	b, err := s.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for {
+		if ctx.Err() != nil {
+			return [32]byte{}, ctx.Err()
+		}
+		if b == nil {
+			return [32]byte{}, errUnknownBlock
+		}
+		if b.Block.Slot <= baseSlot {
+			root, err := b.Block.HashTreeRoot()
+			if err != nil {
+				return [32]byte{}, err
+			}
+			if s.isStateDiffBase(ctx, root) {
+				return root, nil
+			}
+			// The state of a slot without block is the state of the highest block below the
+			// slot, so the base state can only be the state of the parent block.
+			if b.Block.Slot < baseSlot || b.Block.Slot == 0 {
+				return [32]byte{}, errUnknownState
+			}
+		}
+		b, err = s.beaconDB.Block(ctx, bytesutil.ToBytes32(b.Block.ParentRoot))
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+}
+
+// isStateDiffBase returns true if the state of the block root can be the base state of a diff:
+// it has a diff, or it is saved in the DB and is not a hot state which is deleted once finalized.
+func (s *State) isStateDiffBase(ctx context.Context, blockRoot [32]byte) bool {
+	if s.beaconDB.HasStateDiff(ctx, blockRoot) {
+		return true
+	}
+	if !s.beaconDB.HasState(ctx, blockRoot) {
+		return false
+	}
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	for _, r := range s.saveHotStateDB.savedStateRoots {
+		if r == blockRoot {
+			return false
+		}
+	}
+	return true
+}
+
+// stateFromDiffs reconstructs the state of the block root by applying its diff, and the diffs of
+// its base states, to the first state in the DB.
+func (s *State) stateFromDiffs(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.stateFromDiffs")
+	defer span.End()
+
+	var diffs []*dbpb.StateDiff
+	root := blockRoot
+	for !s.beaconDB.HasState(ctx, root) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if len(diffs) == maxStateDiffDepth {
+			return nil, errors.New("too many state diffs to reconstruct state")
+		}
+		diff, err := s.beaconDB.StateDiff(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if diff == nil {
+			return nil, errUnknownState
+		}
+		diffs = append(diffs, diff)
+		root = bytesutil.ToBytes32(diff.BaseRoot)
+	}
+
+	base, err := s.beaconDB.State(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, errUnknownState
+	}
+	st := base.ToProtoUnsafe()
+	for i := len(diffs) - 1; i >= 0; i-- {
+		st, err = applyStateDiff(st, diffs[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not apply state diff")
+		}
+	}
+	stateDiffCount.Observe(float64(len(diffs)))
+	return state.InitializeFromProtoUnsafe(st)
+}
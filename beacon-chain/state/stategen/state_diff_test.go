@@ -0,0 +1,176 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateDiffIntervals(t *testing.T) {
+	tests := []struct {
+		archivedPoint types.Slot
+		stateDiff     types.Slot
+		want          []types.Slot
+	}{
+		{archivedPoint: 2048, stateDiff: 0, want: nil},
+		{archivedPoint: 2048, stateDiff: 100, want: nil},
+		{archivedPoint: 2048, stateDiff: 2048, want: nil},
+		{archivedPoint: 2048, stateDiff: 512, want: []types.Slot{512}},
+		{archivedPoint: 2048, stateDiff: 32, want: []types.Slot{256, 32}},
+		{archivedPoint: 2048, stateDiff: 4, want: []types.Slot{256, 32, 4}},
+		{archivedPoint: 2000, stateDiff: 250, want: []types.Slot{250}},
+	}
+	for _, tt := range tests {
+		assert.DeepEqual(t, tt.want, stateDiffIntervals(tt.archivedPoint, tt.stateDiff))
+	}
+}
+
+func TestState_StateDiffBaseSlot(t *testing.T) {
+	s := &State{slotsPerArchivedPoint: 2048, stateDiffIntervals: stateDiffIntervals(2048, 32)}
+	tests := []struct {
+		slot     types.Slot
+		isDiff   bool
+		baseSlot types.Slot
+	}{
+		{slot: 2048 + 256, isDiff: true, baseSlot: 2048},
+		{slot: 2048 + 256 + 32, isDiff: true, baseSlot: 2048 + 256},
+		{slot: 2048 + 1, isDiff: false},
+		{slot: 2048, isDiff: false},
+		{slot: 0, isDiff: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.isDiff, s.isStateDiffSlot(tt.slot), "Wrong state diff slot %d", tt.slot)
+		if tt.isDiff {
+			assert.Equal(t, tt.baseSlot, s.stateDiffBaseSlot(tt.slot), "Wrong base slot of %d", tt.slot)
+		}
+	}
+}
+
+func TestMigrateToCold_StateDiffsMatchReplay(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	roots := saveFullChain(t, ctx, beaconDB, 40)
+
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 32
+	service.stateDiffIntervals = stateDiffIntervals(32, 2)
+	require.NoError(t, service.MigrateToCold(ctx, roots[40]))
+
+	// The state of the highest block below the archived point is saved, with the diffs of
+	// the states of every other slot.
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[31]))
+	for slot := types.Slot(1); slot < 40; slot++ {
+		want := slot%2 == 0 && slot != 32
+		assert.Equal(t, want, beaconDB.HasStateDiff(ctx, roots[slot]), "Wrong state diff of slot %d", slot)
+	}
+	diff, err := beaconDB.StateDiff(ctx, roots[18])
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[16][:], diff.BaseRoot)
+	diff, err = beaconDB.StateDiff(ctx, roots[34])
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[31][:], diff.BaseRoot)
+
+	// The states reconstructed from state diffs are the states replayed from archived states.
+	replayed := New(beaconDB)
+	replayed.slotsPerArchivedPoint = 32
+	fromDiffs := New(beaconDB)
+	fromDiffs.slotsPerArchivedPoint = 32
+	fromDiffs.stateDiffIntervals = stateDiffIntervals(32, 2)
+	for slot := types.Slot(1); slot <= 40; slot++ {
+		want, err := replayed.StateByRoot(ctx, roots[slot])
+		require.NoError(t, err)
+		got, err := fromDiffs.StateByRoot(ctx, roots[slot])
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, want.ToProtoUnsafe(), got.ToProtoUnsafe(), "Wrong state of slot %d", slot)
+	}
+	for _, slot := range []types.Slot{18, 34} {
+		want, err := replayed.StateByRoot(ctx, roots[slot])
+		require.NoError(t, err)
+		got, err := fromDiffs.stateFromDiffs(ctx, roots[slot])
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, want.ToProtoUnsafe(), got.ToProtoUnsafe(), "Wrong state of slot %d", slot)
+	}
+}
+
+func TestMigrateToCold_StateDiffsWithPrunedHotStates(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	roots := saveFullChain(t, ctx, beaconDB, 40)
+
+	replayed := New(beaconDB)
+	replayed.slotsPerArchivedPoint = 32
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 32
+	service.stateDiffIntervals = stateDiffIntervals(32, 2)
+	// Hot states were saved in the DB during a period of non-finality.
+	service.saveHotStateDB.enabled = true
+	for _, slot := range []types.Slot{8, 16, 24} {
+		st, err := replayed.StateByRoot(ctx, roots[slot])
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveState(ctx, st, roots[slot]))
+		service.saveHotStateDB.savedStateRoots = append(service.saveHotStateDB.savedStateRoots, roots[slot])
+	}
+	require.NoError(t, service.MigrateToCold(ctx, roots[40]))
+
+	// The finalized hot states are pruned, and were not used as base states.
+	for _, slot := range []types.Slot{8, 16, 24} {
+		assert.Equal(t, false, beaconDB.HasState(ctx, roots[slot]), "Hot state of slot %d not pruned", slot)
+		assert.Equal(t, true, beaconDB.HasStateDiff(ctx, roots[slot]), "No state diff of slot %d", slot)
+	}
+	diff, err := beaconDB.StateDiff(ctx, roots[16])
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[0][:], diff.BaseRoot)
+
+	for _, slot := range []types.Slot{16, 18, 26} {
+		want, err := replayed.StateByRoot(ctx, roots[slot])
+		require.NoError(t, err)
+		got, err := service.stateFromDiffs(ctx, roots[slot])
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, want.ToProtoUnsafe(), got.ToProtoUnsafe(), "Wrong state of slot %d", slot)
+	}
+}
+
+func TestStateFromDiffs_UnknownState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	_, err := service.stateFromDiffs(ctx, [32]byte{'a'})
+	assert.ErrorContains(t, errUnknownState.Error(), err)
+}
+
+// saveFullChain saves the genesis state and a chain of full blocks, with a block at every slot
+// up to the end slot, and returns the roots of the blocks by slot.
+func saveFullChain(t *testing.T, ctx context.Context, beaconDB db.Database, endSlot types.Slot) [][32]byte {
+	st, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	roots := [][32]byte{gRoot}
+	for slot := types.Slot(1); slot <= endSlot; slot++ {
+		b, err := testutil.GenerateFullBlock(st, pks, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: r[:]}))
+		roots = append(roots, r)
+	}
+	return roots
+}
@@ -3,8 +3,11 @@ package stateutil_test
 import (
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/benchutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -28,3 +31,117 @@ func BenchmarkMerkleize_Buffered(b *testing.B) {
 		require.NoError(b, err)
 	}
 }
+
+func BenchmarkValidatorRoots_Serial(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	validators := beaconState.Validators()
+	hasher := hashutil.CustomSHA256Hasher()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, val := range validators {
+			_, err := stateutil.ValidatorRoot(hasher, val)
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkValidatorRoots_Parallel(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	validators := beaconState.Validators()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := stateutil.OptimizedValidatorRoots(validators)
+		require.NoError(b, err)
+	}
+}
+
+// The benchmarks below copy the validator registry trie and update a few validators of the copy,
+// as done when processing a block on a copy of the head state.
+
+func BenchmarkValidatorTrie_CopyAndRecompute_Layers(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	roots, err := stateutil.OptimizedValidatorRoots(beaconState.Validators())
+	require.NoError(b, err)
+	layers := stateutil.ReturnTrieLayerVariable(roots, params.BeaconConfig().ValidatorRegistryLimit)
+	changedIdx, changedLeaves := benchmarkChangedLeaves(len(roots))
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copied := make([][]*[32]byte, len(layers))
+		for j, layer := range layers {
+			copied[j] = make([]*[32]byte, len(layer))
+			copy(copied[j], layer)
+		}
+		_, _, err := stateutil.RecomputeFromLayerVariable(changedLeaves, changedIdx, copied)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkValidatorTrie_CopyAndRecompute_Shared(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	roots, err := stateutil.OptimizedValidatorRoots(beaconState.Validators())
+	require.NoError(b, err)
+	trie := stateutil.NewSharedTrie(roots, params.BeaconConfig().ValidatorRegistryLimit)
+	changedIdx, changedLeaves := benchmarkChangedLeaves(len(roots))
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := trie.Copy().Recompute(changedLeaves, changedIdx)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkBalancesRoot_Full(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	balances := beaconState.Balances()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		balances[i%len(balances)]++
+		_, err := stateutil.ValidatorBalancesRoot(balances)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkBalancesRoot_Shared(b *testing.B) {
+	benchutil.SetBenchmarkConfig()
+	beaconState, err := benchutil.PreGenState2FullEpochs()
+	require.NoError(b, err)
+	numOfChunks := (beaconState.NumValidators() + 3) / 4
+	trie := stateutil.NewSharedTrie(make([][32]byte, numOfChunks), (params.BeaconConfig().ValidatorRegistryLimit*8+31)/32)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := trie.Copy().Recompute([][32]byte{{byte(i)}}, []uint64{uint64(i % numOfChunks)})
+		require.NoError(b, err)
+	}
+}
+
+// benchmarkChangedLeaves returns the leaves of 16 validators spread across the registry.
+func benchmarkChangedLeaves(numOfLeaves int) ([]uint64, [][32]byte) {
+	changedIdx := make([]uint64, 0, 16)
+	changedLeaves := make([][32]byte, 0, 16)
+	for i := 0; i < 16; i++ {
+		changedIdx = append(changedIdx, uint64(i*numOfLeaves/16))
+		changedLeaves = append(changedLeaves, [32]byte{byte(i)})
+	}
+	return changedIdx, changedLeaves
+}
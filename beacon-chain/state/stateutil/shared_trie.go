@@ -0,0 +1,256 @@
+package stateutil
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+)
+
+const (
+	// sharedSubtrieDepth is the depth of the subtries of a shared trie, which hold 1024 leaves each.
+	sharedSubtrieDepth = 10
+	// minParallelLeaves is the number of changed leaves from which the subtries of a shared trie
+	// are recomputed in parallel.
+	minParallelLeaves = 256
+)
+
+// SharedTrie is the merkle trie of a variable sized list of leaves, such as the validator registry,
+// split into subtries of a fixed number of leaves. The subtries are reference counted and shared
+// by the copies of the trie: copying the trie only copies the references to its subtries, and a
+// subtrie is copied the first time one of its leaves changes in a trie sharing it. A trie no
+// longer used must be released, to stop sharing its subtries. The subtries
+// with changed leaves are recomputed in parallel, before the layers above them.
+//
+// The leaves of the trie can be changed or appended, but not removed.
+type SharedTrie struct {
+	subtries     []*subtrie
+	topLayers    [][]*[32]byte
+	subtrieDepth int
+	depth        int
+	numLeaves    int
+}
+
+// subtrie holds the layers of a subtrie of a shared trie.
+type subtrie struct {
+	layers [][]*[32]byte
+	refs   int32
+}
+
+// NewSharedTrie creates the shared trie of the leaves of a list with the given limit, hashing its
+// subtries in parallel.
+func NewSharedTrie(leaves [][32]byte, limit uint64) *SharedTrie {
+	depth := int(htrutils.Depth(limit))
+	subtrieDepth := sharedSubtrieDepth
+	if subtrieDepth > depth {
+		subtrieDepth = depth
+	}
+	subtrieSize := 1 << subtrieDepth
+	subtries := make([]*subtrie, (len(leaves)+subtrieSize-1)/subtrieSize)
+	build := func(i int) {
+		end := (i + 1) * subtrieSize
+		if end > len(leaves) {
+			end = len(leaves)
+		}
+		subtries[i] = &subtrie{
+			layers: returnTrieLayerVariable(leaves[i*subtrieSize:end], subtrieDepth, 0),
+			refs:   1,
+		}
+	}
+	forEachParallel(len(subtries), build)
+
+	subtrieRoots := make([][32]byte, len(subtries))
+	for i, s := range subtries {
+		subtrieRoots[i] = *s.layers[subtrieDepth][0]
+	}
+	t := &SharedTrie{
+		subtries:     subtries,
+		topLayers:    returnTrieLayerVariable(subtrieRoots, depth-subtrieDepth, subtrieDepth),
+		subtrieDepth: subtrieDepth,
+		depth:        depth,
+		numLeaves:    len(leaves),
+	}
+	return t
+}
+
+// Copy returns a copy of the trie sharing its subtries.
+func (t *SharedTrie) Copy() *SharedTrie {
+	subtries := make([]*subtrie, len(t.subtries))
+	for i, s := range t.subtries {
+		atomic.AddInt32(&s.refs, 1)
+		subtries[i] = s
+	}
+	topLayers := make([][]*[32]byte, len(t.topLayers))
+	for i, layer := range t.topLayers {
+		topLayers[i] = make([]*[32]byte, len(layer))
+		copy(topLayers[i], layer)
+	}
+	dst := &SharedTrie{
+		subtries:     subtries,
+		topLayers:    topLayers,
+		subtrieDepth: t.subtrieDepth,
+		depth:        t.depth,
+		numLeaves:    t.numLeaves,
+	}
+	return dst
+}
+
+// Root returns the root of the trie, without the length of the list mixed in.
+func (t *SharedTrie) Root() [32]byte {
+	return *t.topLayers[len(t.topLayers)-1][0]
+}
+
+// NumLeaves returns the number of leaves of the trie.
+func (t *SharedTrie) NumLeaves() int {
+	return t.numLeaves
+}
+
+// NodeCount returns the number of nodes held by the trie, where the nodes of a subtrie shared by
+// several tries are divided between them.
+func (t *SharedTrie) NodeCount() uint64 {
+	var count uint64
+	for _, layer := range t.topLayers {
+		count += uint64(len(layer))
+	}
+	for _, s := range t.subtries {
+		var nodes uint64
+		for _, layer := range s.layers {
+			nodes += uint64(len(layer))
+		}
+		if refs := atomic.LoadInt32(&s.refs); refs > 1 {
+			nodes /= uint64(refs)
+		}
+		count += nodes
+	}
+	return count
+}
+
+// Recompute updates the leaves at the given sorted and unique indices, which may append leaves to
+// the trie, and returns the new root of the trie. The subtries shared with other tries are copied
+// before being updated.
+func (t *SharedTrie) Recompute(changedLeaves [][32]byte, changedIdx []uint64) ([32]byte, error) {
+	if len(changedLeaves) != len(changedIdx) {
+		return [32]byte{}, errors.Errorf("%d changed leaves for %d changed indices", len(changedLeaves), len(changedIdx))
+	}
+	if len(changedIdx) == 0 {
+		return t.Root(), nil
+	}
+	// Appended leaves must follow the last leaf of the trie.
+	maxIdx := changedIdx[len(changedIdx)-1]
+	appended := uint64(0)
+	for _, idx := range changedIdx {
+		if idx >= uint64(t.numLeaves) {
+			appended++
+		}
+	}
+	if maxIdx >= uint64(t.numLeaves) && maxIdx-uint64(t.numLeaves)+1 != appended {
+		return [32]byte{}, errors.Errorf("changed index %d is beyond the next leaf %d", maxIdx, t.numLeaves)
+	}
+	if t.depth < 64 && maxIdx >= 1<<uint(t.depth) {
+		return [32]byte{}, errors.Errorf("changed index %d is beyond the trie limit", maxIdx)
+	}
+
+	// Group the changed leaves by subtrie.
+	type subtrieChange struct {
+		index   int
+		leaves  [][32]byte
+		indices []uint64
+	}
+	var changes []*subtrieChange
+	mask := uint64(1)<<uint(t.subtrieDepth) - 1
+	for i, idx := range changedIdx {
+		subtrieIdx := int(idx >> uint(t.subtrieDepth))
+		if len(changes) == 0 || changes[len(changes)-1].index != subtrieIdx {
+			changes = append(changes, &subtrieChange{index: subtrieIdx})
+		}
+		c := changes[len(changes)-1]
+		c.leaves = append(c.leaves, changedLeaves[i])
+		c.indices = append(c.indices, idx&mask)
+	}
+	for _, c := range changes {
+		if c.index == len(t.subtries) {
+			t.subtries = append(t.subtries, &subtrie{
+				layers: returnTrieLayerVariable(nil, t.subtrieDepth, 0),
+				refs:   1,
+			})
+		}
+	}
+
+	subtrieRoots := make([][32]byte, len(changes))
+	errs := make([]error, len(changes))
+	recompute := func(i int) {
+		c := changes[i]
+		s := t.subtries[c.index]
+		if atomic.LoadInt32(&s.refs) > 1 {
+			s = s.copy()
+			t.subtries[c.index] = s
+		}
+		root, layers, err := recomputeFromLayerVariable(c.leaves, c.indices, s.layers, 0)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		s.layers = layers
+		subtrieRoots[i] = root
+	}
+	if len(changedIdx) < minParallelLeaves {
+		for i := range changes {
+			recompute(i)
+		}
+	} else {
+		forEachParallel(len(changes), recompute)
+	}
+	for _, err := range errs {
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+
+	topIndices := make([]uint64, len(changes))
+	for i, c := range changes {
+		topIndices[i] = uint64(c.index)
+	}
+	root, topLayers, err := recomputeFromLayerVariable(subtrieRoots, topIndices, t.topLayers, t.subtrieDepth)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	t.topLayers = topLayers
+	t.numLeaves += int(appended)
+	return root, nil
+}
+
+// copy returns a copy of the subtrie, released by the trie it is copied for.
+func (s *subtrie) copy() *subtrie {
+	layers := make([][]*[32]byte, len(s.layers))
+	for i, layer := range s.layers {
+		layers[i] = make([]*[32]byte, len(layer))
+		copy(layers[i], layer)
+	}
+	atomic.AddInt32(&s.refs, -1)
+	return &subtrie{layers: layers, refs: 1}
+}
+
+// Release removes the references of the trie to its subtries, once the trie is no longer used, so
+// that the tries still sharing them update them in place. The trie must not be used afterwards.
+func (t *SharedTrie) Release() {
+	for _, s := range t.subtries {
+		atomic.AddInt32(&s.refs, -1)
+	}
+	t.subtries = nil
+}
+
+// forEachParallel calls the function with the indices from 0 to n, spread across workers.
+func forEachParallel(n int, f func(i int)) {
+	if n == 0 {
+		return
+	}
+	// The function does not fail, so neither does scatter.
+	_, _ = mputil.Scatter(n, func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		for i := offset; i < offset+entries; i++ {
+			f(i)
+		}
+		return nil, nil
+	})
+}
@@ -0,0 +1,126 @@
+package stateutil_test
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func sharedTrieValidators(n int) []*ethpb.Validator {
+	vals := make([]*ethpb.Validator, n)
+	for i := range vals {
+		pubKey := make([]byte, 48)
+		pubKey[0], pubKey[1] = byte(i), byte(i>>8)
+		vals[i] = &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      uint64(i),
+		}
+	}
+	return vals
+}
+
+func sharedTrieRoot(t *testing.T, trie *stateutil.SharedTrie) [32]byte {
+	root, err := stateutil.AddInMixin(trie.Root(), uint64(trie.NumLeaves()))
+	require.NoError(t, err)
+	return root
+}
+
+func TestOptimizedValidatorRoots(t *testing.T) {
+	vals := sharedTrieValidators(2500)
+	roots, err := stateutil.OptimizedValidatorRoots(vals)
+	require.NoError(t, err)
+	require.Equal(t, len(vals), len(roots))
+	hasher := hashutil.CustomSHA256Hasher()
+	for i, val := range vals {
+		root, err := stateutil.ValidatorRoot(hasher, val)
+		require.NoError(t, err)
+		assert.Equal(t, root, roots[i], "Wrong root of validator %d", i)
+	}
+
+	roots, err = stateutil.OptimizedValidatorRoots(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+}
+
+func TestSharedTrie_MatchesRegistryRoot(t *testing.T) {
+	limit := params.BeaconConfig().ValidatorRegistryLimit
+	for _, n := range []int{0, 1, 1024, 1025, 2500} {
+		vals := sharedTrieValidators(n)
+		roots, err := stateutil.OptimizedValidatorRoots(vals)
+		require.NoError(t, err)
+		trie := stateutil.NewSharedTrie(roots, limit)
+		want, err := stateutil.ValidatorRegistryRoot(vals)
+		require.NoError(t, err)
+		assert.Equal(t, want, sharedTrieRoot(t, trie), "Wrong root of %d validators", n)
+	}
+}
+
+func TestSharedTrie_Recompute(t *testing.T) {
+	vals := sharedTrieValidators(2100)
+	roots, err := stateutil.OptimizedValidatorRoots(vals[:2047])
+	require.NoError(t, err)
+	trie := stateutil.NewSharedTrie(roots, params.BeaconConfig().ValidatorRegistryLimit)
+
+	// Change leaves in several subtries, and append leaves across a subtrie boundary.
+	vals[3].Slashed = true
+	vals[1500].ExitEpoch = 10
+	changedIdx := []uint64{3, 1500}
+	for i := uint64(2047); i < 2100; i++ {
+		changedIdx = append(changedIdx, i)
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	changedLeaves := make([][32]byte, len(changedIdx))
+	for i, idx := range changedIdx {
+		changedLeaves[i], err = stateutil.ValidatorRoot(hasher, vals[idx])
+		require.NoError(t, err)
+	}
+	_, err = trie.Recompute(changedLeaves, changedIdx)
+	require.NoError(t, err)
+	assert.Equal(t, 2100, trie.NumLeaves())
+	want, err := stateutil.ValidatorRegistryRoot(vals)
+	require.NoError(t, err)
+	assert.Equal(t, want, sharedTrieRoot(t, trie))
+
+	_, err = trie.Recompute([][32]byte{{'a'}}, []uint64{2101})
+	assert.ErrorContains(t, "beyond the next leaf", err)
+}
+
+func TestSharedTrie_CopyImmutable(t *testing.T) {
+	vals := sharedTrieValidators(2000)
+	roots, err := stateutil.OptimizedValidatorRoots(vals)
+	require.NoError(t, err)
+	trie := stateutil.NewSharedTrie(roots, params.BeaconConfig().ValidatorRegistryLimit)
+	root := trie.Root()
+	nodes := trie.NodeCount()
+
+	copied := trie.Copy()
+	assert.Equal(t, true, copied.NodeCount() < nodes, "Shared nodes not divided between copies")
+	copiedRoot, err := copied.Recompute([][32]byte{{'a'}, {'b'}}, []uint64{5, 1999})
+	require.NoError(t, err)
+	assert.Equal(t, root, trie.Root(), "Original trie changed by its copy")
+	assert.DeepNotEqual(t, root, copiedRoot)
+
+	newRoot, err := trie.Recompute([][32]byte{{'a'}, {'b'}}, []uint64{5, 1999})
+	require.NoError(t, err)
+	assert.Equal(t, copiedRoot, newRoot)
+	assert.Equal(t, copiedRoot, copied.Root())
+}
+
+func TestSharedTrie_Release(t *testing.T) {
+	vals := sharedTrieValidators(2000)
+	roots, err := stateutil.OptimizedValidatorRoots(vals)
+	require.NoError(t, err)
+	trie := stateutil.NewSharedTrie(roots, params.BeaconConfig().ValidatorRegistryLimit)
+	nodes := trie.NodeCount()
+
+	copied := trie.Copy()
+	assert.Equal(t, true, trie.NodeCount() < nodes, "Shared nodes not divided between copies")
+	copied.Release()
+	assert.Equal(t, nodes, trie.NodeCount(), "Subtries still shared after the copy was released")
+}
@@ -37,12 +37,18 @@ func ReturnTrieLayer(elements [][32]byte, length uint64) [][]*[32]byte {
 // provided with the elements of a variable sized trie and the corresponding depth of
 // it.
 func ReturnTrieLayerVariable(elements [][32]byte, length uint64) [][]*[32]byte {
+	return returnTrieLayerVariable(elements, int(htrutils.Depth(length)), 0)
+}
+
+// returnTrieLayerVariable returns the layers of a variable sized trie of the given depth, whose
+// elements are nodes at the given height of a larger trie, so missing nodes are the zerohashes
+// of their height in the larger trie.
+func returnTrieLayerVariable(elements [][32]byte, depth, height int) [][]*[32]byte {
 	hasher := hashutil.CustomSHA256Hasher()
-	depth := htrutils.Depth(length)
 	layers := make([][]*[32]byte, depth+1)
 	// Return zerohash at depth
 	if len(elements) == 0 {
-		zerohash := trieutil.ZeroHashes[depth]
+		zerohash := trieutil.ZeroHashes[depth+height]
 		layers[len(layers)-1] = []*[32]byte{&zerohash}
 		return layers
 	}
@@ -54,10 +60,10 @@ func ReturnTrieLayerVariable(elements [][32]byte, length uint64) [][]*[32]byte {
 	layers[0] = transformedLeaves
 	buffer := bytes.NewBuffer([]byte{})
 	buffer.Grow(64)
-	for i := 0; i < int(depth); i++ {
+	for i := 0; i < depth; i++ {
 		oddNodeLength := len(layers[i])%2 == 1
 		if oddNodeLength {
-			zerohash := trieutil.ZeroHashes[i]
+			zerohash := trieutil.ZeroHashes[i+height]
 			layers[i] = append(layers[i], &zerohash)
 		}
 		updatedValues := make([]*[32]byte, 0, len(layers[i])/2)
@@ -112,6 +118,12 @@ func RecomputeFromLayer(changedLeaves [][32]byte, changedIdx []uint64, layer [][
 
 // RecomputeFromLayerVariable recomputes specific branches of a variable sized trie depending on the provided changed indexes.
 func RecomputeFromLayerVariable(changedLeaves [][32]byte, changedIdx []uint64, layer [][]*[32]byte) ([32]byte, [][]*[32]byte, error) {
+	return recomputeFromLayerVariable(changedLeaves, changedIdx, layer, 0)
+}
+
+// recomputeFromLayerVariable recomputes specific branches of a variable sized trie whose leaves are
+// nodes at the given height of a larger trie.
+func recomputeFromLayerVariable(changedLeaves [][32]byte, changedIdx []uint64, layer [][]*[32]byte, height int) ([32]byte, [][]*[32]byte, error) {
 	hasher := hashutil.CustomSHA256Hasher()
 	if len(changedIdx) == 0 {
 		return *layer[0][0], layer, nil
@@ -120,7 +132,7 @@ func RecomputeFromLayerVariable(changedLeaves [][32]byte, changedIdx []uint64, l
 	var err error
 
 	for i, idx := range changedIdx {
-		root, layer, err = recomputeRootFromLayerVariable(int(idx), changedLeaves[i], layer, hasher)
+		root, layer, err = recomputeRootFromLayerVariable(int(idx), changedLeaves[i], layer, height, hasher)
 		if err != nil {
 			return [32]byte{}, nil, err
 		}
@@ -172,12 +184,12 @@ func recomputeRootFromLayer(idx int, layers [][]*[32]byte, chunks []*[32]byte,
 }
 
 // this method assumes that the base branch does not consist of all leaves of the
-// trie. Instead missing leaves are assumed to be zerohashes, following the structure
-// of a sparse merkle trie.
-func recomputeRootFromLayerVariable(idx int, item [32]byte, layers [][]*[32]byte,
+// trie. Instead missing leaves are assumed to be zerohashes of the height of the leaves,
+// following the structure of a sparse merkle trie.
+func recomputeRootFromLayerVariable(idx int, item [32]byte, layers [][]*[32]byte, height int,
 	hasher func([]byte) [32]byte) ([32]byte, [][]*[32]byte, error) {
 	for idx >= len(layers[0]) {
-		zerohash := trieutil.ZeroHashes[0]
+		zerohash := trieutil.ZeroHashes[height]
 		layers[0] = append(layers[0], &zerohash)
 	}
 	layers[0][idx] = &item
@@ -190,7 +202,7 @@ func recomputeRootFromLayerVariable(idx int, item [32]byte, layers [][]*[32]byte
 
 		neighbor := [32]byte{}
 		if neighborIdx >= len(layers[i]) {
-			neighbor = trieutil.ZeroHashes[i]
+			neighbor = trieutil.ZeroHashes[i+height]
 		} else {
 			neighbor = *layers[i][neighborIdx]
 		}
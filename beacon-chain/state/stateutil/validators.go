@@ -3,6 +3,7 @@ package stateutil
 import (
 	"bytes"
 	"encoding/binary"
+	"sync"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -10,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/mputil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -23,6 +25,15 @@ func ValidatorRegistryRoot(vals []*ethpb.Validator) ([32]byte, error) {
 	return nocachedHasher.validatorRegistryRoot(vals)
 }
 
+// OptimizedValidatorRoots returns the hash tree roots of the validators, which are the leaves of
+// the validator registry trie. The roots are computed in parallel.
+func OptimizedValidatorRoots(validators []*ethpb.Validator) ([][32]byte, error) {
+	if featureconfig.Get().EnableSSZCache {
+		return cachedHasher.validatorRoots(validators)
+	}
+	return nocachedHasher.validatorRoots(validators)
+}
+
 // ValidatorBalancesRoot computes the HashTreeRoot Merkleization of
 // a list of validator uint64 balances according to the eth2
 // Simple Serialize specification.
@@ -106,18 +117,14 @@ func ValidatorRoot(hasher htrutils.HashFn, validator *ethpb.Validator) ([32]byte
 
 func (h *stateRootHasher) validatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
 	hashKeyElements := make([]byte, len(validators)*32)
-	roots := make([][32]byte, len(validators))
 	emptyKey := hashutil.FastSum256(hashKeyElements)
 	hasher := hashutil.CustomSHA256Hasher()
-	bytesProcessed := 0
-	for i := 0; i < len(validators); i++ {
-		val, err := h.validatorRoot(hasher, validators[i])
-		if err != nil {
-			return [32]byte{}, errors.Wrap(err, "could not compute validators merkleization")
-		}
-		copy(hashKeyElements[bytesProcessed:bytesProcessed+32], val[:])
-		roots[i] = val
-		bytesProcessed += 32
+	roots, err := h.validatorRoots(validators)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for i, val := range roots {
+		copy(hashKeyElements[i*32:(i+1)*32], val[:])
 	}
 
 	hashKey := hashutil.FastSum256(hashKeyElements)
@@ -145,6 +152,38 @@ func (h *stateRootHasher) validatorRegistryRoot(validators []*ethpb.Validator) (
 	return res, nil
 }
 
+// validatorRoots computes the roots of the validators in parallel, with a hasher per worker.
+func (h *stateRootHasher) validatorRoots(validators []*ethpb.Validator) ([][32]byte, error) {
+	roots := make([][32]byte, len(validators))
+	if len(validators) == 0 {
+		return roots, nil
+	}
+	var errs []error
+	_, err := mputil.Scatter(len(validators), func(offset int, entries int, lock *sync.RWMutex) (interface{}, error) {
+		hasher := hashutil.CustomSHA256Hasher()
+		for i := offset; i < offset+entries; i++ {
+			val, err := h.validatorRoot(hasher, validators[i])
+			if err != nil {
+				// Errors are collected rather than returned, so all the workers are done when
+				// scatter returns.
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+				return nil, nil
+			}
+			roots[i] = val
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, errors.Wrap(errs[0], "could not compute validators merkleization")
+	}
+	return roots, nil
+}
+
 func (h *stateRootHasher) validatorRoot(hasher htrutils.HashFn, validator *ethpb.Validator) ([32]byte, error) {
 	// Validator marshaling for caching.
 	enc := make([]byte, 122)
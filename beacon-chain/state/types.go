@@ -24,6 +24,9 @@ func init() {
 	fieldMap[validators] = compositeArray
 	fieldMap[previousEpochAttestations] = compositeArray
 	fieldMap[currentEpochAttestations] = compositeArray
+
+	// Initialize the compressed arrays.
+	fieldMap[balances] = compressedArray
 }
 
 type fieldIndex int
@@ -64,6 +67,7 @@ const (
 const (
 	basicArray dataType = iota
 	compositeArray
+	compressedArray
 )
 
 // fieldMap keeps track of each field
@@ -88,6 +92,11 @@ var ErrNilInnerState = errors.New("nil inner state")
 // getters and setters for its respective values and helpful functions such as HashTreeRoot().
 type BeaconState struct {
 	state                 *pbp2p.BeaconState
+	validatorList         *validatorList
+	balanceList           *uint64List
+	randaoMixList         *rootList
+	blockRootList         *rootList
+	stateRootList         *rootList
 	lock                  sync.RWMutex
 	dirtyFields           map[fieldIndex]interface{}
 	dirtyIndices          map[fieldIndex][]uint64
@@ -176,6 +185,18 @@ func (r *reference) MinusRef() {
 	r.lock.Unlock()
 }
 
+// ReleaseRef removes a reference and reports whether it was the last one, in which case the
+// referenced object is no longer used and can be released.
+func (r *reference) ReleaseRef() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.refs == 0 {
+		return false
+	}
+	r.refs--
+	return r.refs == 0
+}
+
 // a container to hold the map and a reference tracker for how many
 // states shared this.
 type validatorMapHandler struct {
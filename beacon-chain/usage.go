@@ -109,6 +109,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.SlotsPerStateDiff,
+			flags.ArchiveFileFlag,
+			flags.ArchiveStateIntervalFlag,
+			flags.InMemoryDBFlag,
+			flags.HotStateCacheSize,
+			flags.EpochBoundaryStateCacheSize,
+			flags.CheckpointStateCacheSize,
+			flags.SkipSlotCacheSize,
+			flags.CommitteeCacheSize,
+			flags.StateCacheMemoryBudget,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
@@ -17,6 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// beaconNodeReadyText is logged by a beacon node once it serves RPC requests.
+const beaconNodeReadyText = "gRPC server listening on port"
+
 // StartBeaconNodes starts the requested amount of beacon nodes.
 func StartBeaconNodes(t *testing.T, config *types.E2EConfig, enr string) {
 	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
@@ -67,8 +70,9 @@ func StartNewBeaconNode(t *testing.T, config *types.E2EConfig, index int, enr st
 	if err = cmd.Start(); err != nil {
 		t.Fatalf("Failed to start beacon node: %v", err)
 	}
+	processes.register(processes.beaconNodes, index, &process{binaryPath: binaryPath, args: args, cmd: cmd})
 
-	if err = helpers.WaitForTextInFile(stdOutFile, "gRPC server listening on port"); err != nil {
+	if err = helpers.WaitForTextInFile(stdOutFile, beaconNodeReadyText); err != nil {
 		t.Fatalf("could not find multiaddr for node %d, this means the node had issues starting: %v", index, err)
 	}
 }
@@ -0,0 +1,264 @@
+package components
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/endtoend/params"
+	"github.com/prysmaticlabs/prysm/endtoend/types"
+)
+
+// processStopTimeout is how long a process is given to shut down gracefully before it is killed.
+const processStopTimeout = 30 * time.Second
+
+// process is a beacon node or validator client process started by this package.
+type process struct {
+	binaryPath string
+	args       []string
+	cmd        *exec.Cmd
+	restarts   int
+}
+
+// processRegistry keeps track of the started processes by index, so faults can stop and
+// restart them.
+type processRegistry struct {
+	lock        sync.Mutex
+	beaconNodes map[int]*process
+	validators  map[int]*process
+}
+
+var processes = &processRegistry{
+	beaconNodes: make(map[int]*process),
+	validators:  make(map[int]*process),
+}
+
+func (r *processRegistry) register(m map[int]*process, index int, p *process) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	m[index] = p
+}
+
+func (r *processRegistry) get(m map[int]*process, index int) (*process, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	p, ok := m[index]
+	if !ok {
+		return nil, fmt.Errorf("no process was started with index %d", index)
+	}
+	return p, nil
+}
+
+// stop interrupts the process and waits for it to exit, killing it if it does not in time.
+func (p *process) stop() error {
+	if p.cmd == nil {
+		return errors.New("process is not running")
+	}
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		// The exit status of an interrupted process is not relevant.
+		_ = p.cmd.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(processStopTimeout):
+		if err := p.cmd.Process.Kill(); err != nil {
+			return err
+		}
+		<-done
+	}
+	p.cmd = nil
+	return nil
+}
+
+// start runs the process again with its original flags, except for the flags named in
+// override which are replaced by the given extra flags. The previous data directory is
+// kept and the logs go to the given file.
+func (p *process) start(logFile *os.File, override []string, extraFlags ...string) error {
+	if p.cmd != nil {
+		return errors.New("process is already running")
+	}
+	override = append(override, "force-clear-db", "log-file")
+	args := make([]string, 0, len(p.args)+len(extraFlags)+1)
+	for _, arg := range p.args {
+		if !hasFlagName(arg, override) {
+			args = append(args, arg)
+		}
+	}
+	args = append(args, fmt.Sprintf("--log-file=%s", logFile.Name()))
+	args = append(args, extraFlags...)
+	cmd := exec.Command(p.binaryPath, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	p.cmd = cmd
+	p.restarts++
+	return nil
+}
+
+// hasFlagName returns true if arg sets one of the given flags.
+func hasFlagName(arg string, names []string) bool {
+	name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Controller stops, restarts and isolates the beacon nodes and validator clients started by
+// this package, as required by the faults of an end-to-end test.
+type Controller struct {
+	t *testing.T
+}
+
+var _ = types.ComponentController(&Controller{})
+
+// NewController returns a controller for the components of the running test.
+func NewController(t *testing.T) *Controller {
+	return &Controller{t: t}
+}
+
+// StopBeaconNode shuts down the beacon node with the given index.
+func (c *Controller) StopBeaconNode(index int) error {
+	p, err := processes.get(processes.beaconNodes, index)
+	if err != nil {
+		return err
+	}
+	c.t.Logf("Stopping beacon node %d", index)
+	return p.stop()
+}
+
+// StartBeaconNode starts the stopped beacon node with the given index. If clearDB is set,
+// its beacon chain database is deleted first and the node resyncs from its peers.
+func (c *Controller) StartBeaconNode(index int, clearDB bool) error {
+	if clearDB {
+		dbPath := path.Join(e2e.TestParams.TestPath, fmt.Sprintf("eth2-beacon-node-%d", index), kv.BeaconNodeDbDirName)
+		if err := os.RemoveAll(dbPath); err != nil {
+			return errors.Wrapf(err, "could not delete database of beacon node %d", index)
+		}
+	}
+	return c.restartBeaconNode(index, nil)
+}
+
+func (c *Controller) restartBeaconNode(index int, override []string, extraFlags ...string) error {
+	p, err := processes.get(processes.beaconNodes, index)
+	if err != nil {
+		return err
+	}
+	logFile, err := helpers.DeleteAndCreateFile(
+		e2e.TestParams.LogPath, fmt.Sprintf(e2e.BeaconNodeRestartLogFileName, index, p.restarts+1),
+	)
+	if err != nil {
+		return err
+	}
+	c.t.Logf("Starting beacon node %d with extra flags: %s", index, strings.Join(extraFlags, " "))
+	if err := p.start(logFile, override, extraFlags...); err != nil {
+		return errors.Wrapf(err, "could not start beacon node %d", index)
+	}
+	if err := helpers.WaitForTextInFile(logFile, beaconNodeReadyText); err != nil {
+		return errors.Wrapf(err, "beacon node %d had issues restarting", index)
+	}
+	return nil
+}
+
+// StopValidatorClient shuts down the validator client with the given index.
+func (c *Controller) StopValidatorClient(index int) error {
+	p, err := processes.get(processes.validators, index)
+	if err != nil {
+		return err
+	}
+	c.t.Logf("Stopping validator client %d", index)
+	return p.stop()
+}
+
+// StartValidatorClient starts the stopped validator client with the given index and extra
+// flags. Its slashing protection database is kept.
+func (c *Controller) StartValidatorClient(index int, extraFlags ...string) error {
+	p, err := processes.get(processes.validators, index)
+	if err != nil {
+		return err
+	}
+	logFile, err := helpers.DeleteAndCreateFile(
+		e2e.TestParams.LogPath, fmt.Sprintf(e2e.ValidatorRestartLogFileName, index, p.restarts+1),
+	)
+	if err != nil {
+		return err
+	}
+	c.t.Logf("Starting validator client %d with extra flags: %s", index, strings.Join(extraFlags, " "))
+	return errors.Wrapf(p.start(logFile, nil, extraFlags...), "could not start validator client %d", index)
+}
+
+// Partition restarts every beacon node on its own loopback address, denying p2p connections
+// from the addresses of the nodes outside of its group. Nodes not listed in any group are
+// isolated on their own. This relies on the whole 127.0.0.0/8 range being routed to the
+// loopback interface, as it is on Linux.
+func (c *Controller) Partition(groups ...[]int) error {
+	groupOf := make(map[int]int)
+	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
+		groupOf[i] = len(groups) + i
+	}
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			groupOf[i] = g
+		}
+	}
+	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
+		flags := []string{fmt.Sprintf("--p2p-local-ip=%s", beaconNodeIP(i))}
+		peers := 0
+		for j := 0; j < e2e.TestParams.BeaconNodeCount; j++ {
+			if j == i {
+				continue
+			}
+			if groupOf[j] != groupOf[i] {
+				flags = append(flags, fmt.Sprintf("--p2p-denylist=%s/32", beaconNodeIP(j)))
+				continue
+			}
+			peers++
+		}
+		flags = append(flags, fmt.Sprintf("--min-sync-peers=%d", peers))
+		if err := c.reconnectBeaconNode(i, flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Heal restarts every beacon node without any p2p restriction.
+func (c *Controller) Heal() error {
+	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
+		flags := []string{
+			fmt.Sprintf("--p2p-local-ip=%s", beaconNodeIP(i)),
+			fmt.Sprintf("--min-sync-peers=%d", e2e.TestParams.BeaconNodeCount-1),
+		}
+		if err := c.reconnectBeaconNode(i, flags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Controller) reconnectBeaconNode(index int, flags []string) error {
+	if err := c.StopBeaconNode(index); err != nil {
+		return err
+	}
+	return c.restartBeaconNode(index, []string{"p2p-local-ip", "p2p-denylist", "min-sync-peers"}, flags...)
+}
+
+// beaconNodeIP is the loopback address a beacon node listens on once it has been partitioned.
+func beaconNodeIP(index int) string {
+	return fmt.Sprintf("127.0.0.%d", 10+index)
+}
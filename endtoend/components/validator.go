@@ -87,6 +87,7 @@ func StartNewValidatorClient(t *testing.T, config *types.E2EConfig, validatorNum
 	if err = cmd.Start(); err != nil {
 		t.Fatal(err)
 	}
+	processes.register(processes.validators, index, &process{binaryPath: binaryPath, args: args, cmd: cmd})
 }
 
 // SendAndMineDeposits sends the requested amount of deposits and mines the chain after to ensure the deposits are seen.
@@ -97,8 +97,17 @@ func runEndToEndTest(t *testing.T, config *e2etypes.E2EConfig) {
 	// Offsetting the ticker from genesis so it ticks in the middle of an epoch, in order to keep results consistent.
 	tickingStartTime := genesisTime.Add(middleOfEpoch)
 
+	controller := components.NewController(t)
 	ticker := helpers.NewEpochTicker(tickingStartTime, epochSeconds)
 	for currentEpoch := range ticker.C() {
+		for _, fault := range config.Faults {
+			if !fault.Policy(types.Epoch(currentEpoch)) {
+				continue
+			}
+			t.Run(fmt.Sprintf(fault.Name, currentEpoch), func(t *testing.T) {
+				require.NoError(t, fault.Inject(controller), "Could not inject fault in epoch %d", currentEpoch)
+			})
+		}
 		for _, evaluator := range config.Evaluators {
 			// Only run if the policy says so.
 			if !evaluator.Policy(types.Epoch(currentEpoch)) {
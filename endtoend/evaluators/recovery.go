@@ -0,0 +1,175 @@
+package evaluators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/endtoend/policies"
+	e2etypes "github.com/prysmaticlabs/prysm/endtoend/types"
+	"google.golang.org/grpc"
+)
+
+// NoSlashableMessages ensures no validator signed two conflicting blocks or attestations,
+// looking at every block known to any of the beacon nodes, including the ones of forks.
+var NoSlashableMessages = e2etypes.Evaluator{
+	Name:       "no_slashable_messages_epoch_%d",
+	Policy:     policies.AfterNthEpoch(0),
+	Evaluation: noSlashableMessages,
+}
+
+// FinalizationResumes ensures every beacon node finalizes as it should again after the
+// given epoch, once the network recovered from a fault.
+func FinalizationResumes(afterEpoch types.Epoch) e2etypes.Evaluator {
+	return e2etypes.Evaluator{
+		Name:       "finalization_resumes_epoch_%d",
+		Policy:     policies.AfterNthEpoch(afterEpoch),
+		Evaluation: finalizationOccursOnAllNodes,
+	}
+}
+
+// NodesConverge ensures all beacon nodes agree on the head, justified and finalized
+// checkpoints again after the given epoch, once the network recovered from a fault.
+func NodesConverge(afterEpoch types.Epoch) e2etypes.Evaluator {
+	return e2etypes.Evaluator{
+		Name:       "nodes_converge_epoch_%d",
+		Policy:     policies.AfterNthEpoch(afterEpoch),
+		Evaluation: allNodesHaveSameHead,
+	}
+}
+
+func finalizationOccursOnAllNodes(conns ...*grpc.ClientConn) error {
+	for i, conn := range conns {
+		if err := finalizationOccurs(conn); err != nil {
+			return errors.Wrapf(err, "beacon node %d", i)
+		}
+		time.Sleep(connTimeDelay)
+	}
+	return nil
+}
+
+// attesterVote is the source and target of an attestation signed by a validator.
+type attesterVote struct {
+	source   types.Epoch
+	target   types.Epoch
+	dataRoot [32]byte
+}
+
+// proposerSlot identifies the block proposal of a validator at a slot.
+type proposerSlot struct {
+	proposer types.ValidatorIndex
+	slot     types.Slot
+}
+
+// Votes and proposals seen by noSlashableMessages so far, and the next epoch to check.
+var (
+	attesterVotes      = make(map[uint64][]attesterVote)
+	proposals          = make(map[proposerSlot][32]byte)
+	nextSlashableCheck types.Epoch
+)
+
+func noSlashableMessages(conns ...*grpc.ClientConn) error {
+	ctx := context.Background()
+	chainHead, err := eth.NewBeaconChainClient(conns[0]).GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+	// Only look at completed epochs, whose blocks are all known by now.
+	for epoch := nextSlashableCheck; epoch < chainHead.HeadEpoch; epoch++ {
+		for i, conn := range conns {
+			client := eth.NewBeaconChainClient(conn)
+			if err := checkProposals(ctx, client, epoch); err != nil {
+				return errors.Wrapf(err, "beacon node %d", i)
+			}
+			if err := checkAttestations(ctx, client, epoch); err != nil {
+				return errors.Wrapf(err, "beacon node %d", i)
+			}
+			time.Sleep(connTimeDelay)
+		}
+		nextSlashableCheck = epoch + 1
+	}
+	return nil
+}
+
+func checkProposals(ctx context.Context, client eth.BeaconChainClient, epoch types.Epoch) error {
+	req := &eth.ListBlocksRequest{QueryFilter: &eth.ListBlocksRequest_Epoch{Epoch: epoch}}
+	for {
+		resp, err := client.ListBlocks(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to list blocks")
+		}
+		for _, ctr := range resp.BlockContainers {
+			blk := ctr.Block.Block
+			key := proposerSlot{proposer: blk.ProposerIndex, slot: blk.Slot}
+			root, err := blk.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			if seen, ok := proposals[key]; ok && seen != root {
+				return fmt.Errorf(
+					"validator %d proposed conflicting blocks %#x and %#x at slot %d",
+					blk.ProposerIndex, seen, root, blk.Slot,
+				)
+			}
+			proposals[key] = root
+		}
+		if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+			return nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+func checkAttestations(ctx context.Context, client eth.BeaconChainClient, epoch types.Epoch) error {
+	req := &eth.ListIndexedAttestationsRequest{
+		QueryFilter: &eth.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+	}
+	for {
+		resp, err := client.ListIndexedAttestations(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to list indexed attestations")
+		}
+		for _, att := range resp.IndexedAttestations {
+			root, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			vote := attesterVote{source: att.Data.Source.Epoch, target: att.Data.Target.Epoch, dataRoot: root}
+			for _, idx := range att.AttestingIndices {
+				if err := recordVote(idx, vote); err != nil {
+					return err
+				}
+			}
+		}
+		if resp.NextPageToken == "" || len(resp.IndexedAttestations) == 0 {
+			return nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// recordVote stores the vote of a validator, failing if it is a double vote or a
+// surrounding or surrounded vote of one of its previous votes.
+func recordVote(idx uint64, vote attesterVote) error {
+	for _, prev := range attesterVotes[idx] {
+		if prev.dataRoot == vote.dataRoot {
+			return nil
+		}
+		if prev.target == vote.target {
+			return fmt.Errorf("validator %d double voted for target epoch %d", idx, vote.target)
+		}
+		if (prev.source < vote.source && vote.target < prev.target) ||
+			(vote.source < prev.source && prev.target < vote.target) {
+			return fmt.Errorf(
+				"validator %d signed surrounding votes %d->%d and %d->%d",
+				idx, prev.source, prev.target, vote.source, vote.target,
+			)
+		}
+	}
+	attesterVotes[idx] = append(attesterVotes[idx], vote)
+	return nil
+}
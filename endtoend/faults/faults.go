@@ -0,0 +1,123 @@
+// Package faults defines disruptions which can be injected into a running end to end
+// test, such as restarting beacon nodes, partitioning the network or taking validators
+// offline, to check that the chain recovers from them.
+package faults
+
+import (
+	"fmt"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/endtoend/policies"
+	e2etypes "github.com/prysmaticlabs/prysm/endtoend/types"
+)
+
+// StopBeaconNode shuts down a beacon node in the middle of the given epoch.
+func StopBeaconNode(index int, epoch types.Epoch) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("stop_beacon_node_%d_epoch_%%d", index),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			return c.StopBeaconNode(index)
+		},
+	}
+}
+
+// StartBeaconNode starts a stopped beacon node again with its database in the middle of
+// the given epoch.
+func StartBeaconNode(index int, epoch types.Epoch) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("start_beacon_node_%d_epoch_%%d", index),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			return c.StartBeaconNode(index, false /* clearDB */)
+		},
+	}
+}
+
+// ResyncBeaconNode restarts a beacon node after deleting its database in the middle of the
+// given epoch, so it has to sync the chain from its peers.
+func ResyncBeaconNode(index int, epoch types.Epoch) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("resync_beacon_node_%d_epoch_%%d", index),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			if err := c.StopBeaconNode(index); err != nil {
+				return err
+			}
+			return c.StartBeaconNode(index, true /* clearDB */)
+		},
+	}
+}
+
+// PartitionBeaconNodes splits the beacon nodes into isolated groups in the middle of the
+// given epoch.
+func PartitionBeaconNodes(epoch types.Epoch, groups ...[]int) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   "partition_beacon_nodes_epoch_%d",
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			return c.Partition(groups...)
+		},
+	}
+}
+
+// HealPartition reconnects all the beacon nodes in the middle of the given epoch.
+func HealPartition(epoch types.Epoch) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   "heal_partition_epoch_%d",
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			return c.Heal()
+		},
+	}
+}
+
+// StopValidatorClients takes the given validator clients offline in the middle of the
+// given epoch.
+func StopValidatorClients(epoch types.Epoch, indices ...int) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("stop_validator_clients_%v_epoch_%%d", indices),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			for _, i := range indices {
+				if err := c.StopValidatorClient(i); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// StartValidatorClients brings the given stopped validator clients back online in the
+// middle of the given epoch.
+func StartValidatorClients(epoch types.Epoch, indices ...int) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("start_validator_clients_%v_epoch_%%d", indices),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			for _, i := range indices {
+				if err := c.StartValidatorClient(i); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// SkewValidatorClock restarts a validator client with its clock shifted by the given
+// duration in the middle of the given epoch. A zero skew restores its clock.
+func SkewValidatorClock(index int, epoch types.Epoch, skew time.Duration) e2etypes.Fault {
+	return e2etypes.Fault{
+		Name:   fmt.Sprintf("skew_validator_client_%d_clock_epoch_%%d", index),
+		Policy: policies.OnEpoch(epoch),
+		Inject: func(c e2etypes.ComponentController) error {
+			if err := c.StopValidatorClient(index); err != nil {
+				return err
+			}
+			return c.StartValidatorClient(index, fmt.Sprintf("--e2e-clock-skew=%s", skew))
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package endtoend
+
+import (
+	"testing"
+	"time"
+
+	ev "github.com/prysmaticlabs/prysm/endtoend/evaluators"
+	"github.com/prysmaticlabs/prysm/endtoend/faults"
+	e2eParams "github.com/prysmaticlabs/prysm/endtoend/params"
+	"github.com/prysmaticlabs/prysm/endtoend/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEndToEnd_Faults_MinimalConfig(t *testing.T) {
+	testutil.ResetCache()
+	params.UseE2EConfig()
+	// Four beacon nodes with a validator client each, so most validators can be taken offline
+	// while a node keeps running.
+	require.NoError(t, e2eParams.Init(4))
+
+	minimalConfig := &types.E2EConfig{
+		BeaconFlags:    []string{},
+		ValidatorFlags: []string{},
+		EpochsToRun:    20,
+		TestSync:       false,
+		TestSlasher:    false,
+		TestDeposits:   false,
+		Faults: []types.Fault{
+			faults.StopBeaconNode(3, 3),
+			faults.StartBeaconNode(3, 4),
+			faults.PartitionBeaconNodes(6, []int{0, 1}, []int{2, 3}),
+			faults.HealPartition(8),
+			faults.ResyncBeaconNode(1, 10),
+			faults.SkewValidatorClock(0, 11, 2*time.Second),
+			// Three quarters of the validators go offline, which stops finality.
+			faults.StopValidatorClients(12, 1, 2, 3),
+			faults.StartValidatorClients(14, 1, 2, 3),
+		},
+		Evaluators: []types.Evaluator{
+			ev.PeersConnect,
+			ev.NoSlashableMessages,
+			ev.NodesConverge(16),
+			ev.FinalizationResumes(17),
+		},
+	}
+
+	runEndToEndTest(t, minimalConfig)
+}
@@ -39,12 +39,18 @@ var BootNodeLogFileName = "bootnode.log"
 // BeaconNodeLogFileName is the file name used for the beacon chain node logs.
 var BeaconNodeLogFileName = "beacon-%d.log"
 
+// BeaconNodeRestartLogFileName is the file name used for the logs of a restarted beacon chain node.
+var BeaconNodeRestartLogFileName = "beacon-%d-restart-%d.log"
+
 // SlasherLogFileName is the file name used for the slasher client logs.
 var SlasherLogFileName = "slasher-%d.log"
 
 // ValidatorLogFileName is the file name used for the validator client logs.
 var ValidatorLogFileName = "vals-%d.log"
 
+// ValidatorRestartLogFileName is the file name used for the logs of a restarted validator client.
+var ValidatorRestartLogFileName = "vals-%d-restart-%d.log"
+
 // StandardBeaconCount is a global constant for the count of beacon nodes of standard E2E tests.
 var StandardBeaconCount = 2
 
@@ -0,0 +1,171 @@
+package simulator
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// Protocols of the streams carrying the messages between the p2p hosts of the nodes.
+const (
+	blockProtocol        = "/prysm/simulator/block"
+	attestationProtocol  = "/prysm/simulator/attestation"
+	aggregateProtocol    = "/prysm/simulator/aggregate"
+	blocksByRootProtocol = "/prysm/simulator/blocks_by_root"
+)
+
+// receiveTimeout bounds the wait for a message sent over the p2p transport to be read
+// by the receiving node.
+const receiveTimeout = 10 * time.Second
+
+// message is a gossip message in flight between two simulated beacon nodes.
+type message struct {
+	seq       uint64
+	from      int
+	to        int
+	deliverAt time.Time
+	protocol  string
+	msg       proto.Message
+}
+
+// network schedules the gossip between simulated beacon nodes. Messages are sent over
+// the p2p hosts of the nodes in order of delivery time, then send order, when the
+// simulated clock reaches their delivery time, and each message is processed by the
+// receiving node before the next one is sent. It supports partitions and per-link
+// latencies.
+type network struct {
+	sim     *Simulator
+	queue   []*message
+	seq     uint64
+	groups  map[int]int
+	latency map[[2]int]time.Duration
+}
+
+func newNetwork(sim *Simulator) *network {
+	return &network{
+		sim:     sim,
+		latency: make(map[[2]int]time.Duration),
+	}
+}
+
+// connected reports whether messages can flow between two nodes.
+func (n *network) connected(a, b int) bool {
+	if n.groups == nil {
+		return true
+	}
+	return n.groups[a] == n.groups[b]
+}
+
+// partition splits the nodes into isolated groups. Nodes not listed in any group are
+// isolated on their own.
+func (n *network) partition(groups [][]int) {
+	n.groups = make(map[int]int)
+	for i := range n.sim.nodes {
+		n.groups[i] = len(groups) + i
+	}
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			n.groups[i] = g
+		}
+	}
+}
+
+// heal removes all partitions.
+func (n *network) heal() {
+	n.groups = nil
+}
+
+// gossip sends a message from a node to all the nodes it is connected to, after the
+// link latency and the given extra delay.
+func (n *network) gossip(from int, protocol string, msg proto.Message, delay time.Duration) {
+	now := n.sim.Now()
+	for to := range n.sim.nodes {
+		if to == from || !n.connected(from, to) {
+			continue
+		}
+		n.seq++
+		n.queue = append(n.queue, &message{
+			seq:       n.seq,
+			from:      from,
+			to:        to,
+			deliverAt: now.Add(n.latency[[2]int{from, to}] + delay),
+			protocol:  protocol,
+			msg:       msg,
+		})
+	}
+}
+
+// deliverDue delivers every queued message whose delivery time has been reached.
+// Messages between nodes which got partitioned while in flight are dropped.
+func (n *network) deliverDue(ctx context.Context) {
+	now := n.sim.Now()
+	sort.SliceStable(n.queue, func(i, j int) bool {
+		if !n.queue[i].deliverAt.Equal(n.queue[j].deliverAt) {
+			return n.queue[i].deliverAt.Before(n.queue[j].deliverAt)
+		}
+		return n.queue[i].seq < n.queue[j].seq
+	})
+	var due []*message
+	remaining := n.queue[:0]
+	for _, m := range n.queue {
+		if m.deliverAt.After(now) {
+			remaining = append(remaining, m)
+			continue
+		}
+		due = append(due, m)
+	}
+	n.queue = remaining
+	for _, m := range due {
+		if !n.connected(m.from, m.to) {
+			continue
+		}
+		to, from := n.sim.nodes[m.to], n.sim.nodes[m.from]
+		msg, err := n.send(ctx, from, to, m.protocol, m.msg)
+		if err != nil {
+			to.rejected = append(to.rejected, err)
+			continue
+		}
+		to.receive(ctx, m.protocol, msg, from)
+	}
+}
+
+// send writes a message to a stream opened from one node's p2p host to another's, and
+// returns the message as read by the receiving node.
+func (n *network) send(ctx context.Context, from, to *Node, protocol string, msg proto.Message) (proto.Message, error) {
+	stream, err := from.p2p.Send(ctx, msg, protocol, to.p2p.PeerID())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not send %s from node %d to node %d", protocol, from.index, to.index)
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	select {
+	case r := <-to.inbox:
+		if r.err != nil {
+			return nil, errors.Wrapf(r.err, "node %d could not read %s from node %d", to.index, protocol, from.index)
+		}
+		return r.msg, nil
+	case <-time.After(receiveTimeout):
+		return nil, errors.Errorf("node %d did not receive %s from node %d", to.index, protocol, from.index)
+	}
+}
+
+// newMessage returns an empty message of the type carried by the protocol.
+func newMessage(protocol string) (proto.Message, error) {
+	switch protocol {
+	case blockProtocol:
+		return &ethpb.SignedBeaconBlock{}, nil
+	case attestationProtocol:
+		return &ethpb.Attestation{}, nil
+	case aggregateProtocol:
+		return &ethpb.SignedAggregateAttestationAndProof{}, nil
+	default:
+		return nil, errors.Errorf("unknown protocol %s", protocol)
+	}
+}
@@ -0,0 +1,461 @@
+package simulator
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	libp2pcore "github.com/libp2p/go-libp2p-core/network"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	powtest "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Node is a simulated beacon node. It runs the real blockchain service, fork choice,
+// state generator and operation pools on top of its own database, exchanges messages
+// with the other nodes over its p2p host, and serves the validator RPC to the validator
+// client attached to it.
+type Node struct {
+	index        int
+	sim          *Simulator
+	db           db.Database
+	chain        *blockchain.Service
+	stateGen     *stategen.State
+	attPool      attestations.Pool
+	slashingPool *slashings.Pool
+	exitPool     *voluntaryexits.Pool
+	server       *validator.Server
+	p2p          *p2ptest.TestP2P
+	inbox        chan received
+	validator    *client.InProcessValidator
+	// Extra delay of the gossip of the blocks proposed through the node.
+	blockDelay time.Duration
+	// Attestations waiting to be applied to fork choice, which only accepts them from
+	// the slot after the one they attest to.
+	pendingAtts []*ethpb.Attestation
+	rejected    []error
+}
+
+// received is a message read from a stream by the p2p host of a node.
+type received struct {
+	msg proto.Message
+	err error
+}
+
+func newNode(t *testing.T, sim *Simulator, index int, genesisState *stateTrie.BeaconState) *Node {
+	ctx := sim.ctx
+	// Every store registers the same bolt metrics collectors, so each node has its own
+	// registry.
+	beaconDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{MetricsRegisterer: prometheus.NewRegistry()})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := beaconDB.Close(); err != nil {
+			t.Errorf("Could not close database of node %d: %v", index, err)
+		}
+	})
+
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesisBlk))
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: genesisRoot[:]}))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, genesisRoot))
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	attPool := attestations.NewPool()
+	opsService, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
+	require.NoError(t, err)
+	slashingPool := slashings.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	stateGen := stategen.New(beaconDB)
+	eth1 := powtest.NewPOWChain()
+	stateNotifier := &mock.MockStateNotifier{}
+
+	chain, err := blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:          beaconDB,
+		DepositCache:      depositCache,
+		ChainStartFetcher: eth1,
+		AttPool:           attPool,
+		ExitPool:          exitPool,
+		SlashingPool:      slashingPool,
+		P2p:               &p2ptest.MockBroadcaster{},
+		MaxRoutines:       1000,
+		StateNotifier:     stateNotifier,
+		ForkChoiceStore:   protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+		OpsService:        opsService,
+		StateGen:          stateGen,
+		Clock:             sim.Now,
+	})
+	require.NoError(t, err)
+	chain.Start()
+	t.Cleanup(func() {
+		if err := chain.Stop(); err != nil {
+			t.Errorf("Could not stop blockchain service of node %d: %v", index, err)
+		}
+	})
+
+	n := &Node{
+		index:        index,
+		sim:          sim,
+		db:           beaconDB,
+		chain:        chain,
+		stateGen:     stateGen,
+		attPool:      attPool,
+		slashingPool: slashingPool,
+		exitPool:     exitPool,
+		p2p:          p2ptest.NewTestP2P(t),
+		inbox:        make(chan received, 1),
+	}
+	n.server = &validator.Server{
+		Ctx:                    ctx,
+		BeaconDB:               beaconDB,
+		AttestationCache:       cache.NewAttestationCache(),
+		HeadFetcher:            chain,
+		ForkFetcher:            chain,
+		FinalizationFetcher:    chain,
+		TimeFetcher:            chain,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+		ChainStartFetcher:      eth1,
+		Eth1InfoFetcher:        eth1,
+		Eth1BlockFetcher:       eth1,
+		MockEth1Votes:          true,
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+		StateNotifier:          stateNotifier,
+		BlockNotifier:          &mock.MockBlockNotifier{},
+		OperationNotifier:      &mock.MockOperationNotifier{},
+		P2P:                    &broadcaster{node: n},
+		AttPool:                attPool,
+		SlashingsPool:          slashingPool,
+		ExitPool:               exitPool,
+		BlockReceiver:          chain,
+		StateGen:               stateGen,
+		Clock:                  sim.Now,
+	}
+	for _, protocol := range []string{blockProtocol, attestationProtocol, aggregateProtocol} {
+		n.p2p.SetStreamHandler(protocol+n.p2p.Encoding().ProtocolSuffix(), n.gossipHandler(protocol))
+	}
+	n.p2p.SetStreamHandler(blocksByRootProtocol+n.p2p.Encoding().ProtocolSuffix(), n.blocksByRootHandler)
+	return n
+}
+
+// dialValidatorRPC serves the validator RPC of the node over an in-memory listener and
+// returns a client connection to it.
+func (n *Node) dialValidatorRPC(t *testing.T) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	ethpb.RegisterBeaconNodeValidatorServer(srv, n.server)
+	pbrpc.RegisterDutiesServer(srv, n.server)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			log.WithError(err).Error("Validator RPC server of simulated node stopped")
+		}
+	}()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.DialContext(n.sim.ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("Could not close validator RPC connection of node %d: %v", n.index, err)
+		}
+	})
+	return conn
+}
+
+// Index of the node in the simulator.
+func (n *Node) Index() int {
+	return n.index
+}
+
+// DB returns the database of the node.
+func (n *Node) DB() db.ReadOnlyDatabase {
+	return n.db
+}
+
+// Chain returns the blockchain service of the node.
+func (n *Node) Chain() *blockchain.Service {
+	return n.chain
+}
+
+// ValidatorServer returns the validator RPC server of the node.
+func (n *Node) ValidatorServer() *validator.Server {
+	return n.server
+}
+
+// SlashingPool returns the slashing operation pool of the node.
+func (n *Node) SlashingPool() slashings.PoolManager {
+	return n.slashingPool
+}
+
+// HeadSlot of the node's canonical chain.
+func (n *Node) HeadSlot() types.Slot {
+	return n.chain.HeadSlot()
+}
+
+// HeadRoot of the node's canonical chain.
+func (n *Node) HeadRoot() [32]byte {
+	r, err := n.chain.HeadRoot(n.sim.ctx)
+	require.NoError(n.sim.t, err)
+	return bytesutil.ToBytes32(r)
+}
+
+// HeadState of the node's canonical chain.
+func (n *Node) HeadState() *stateTrie.BeaconState {
+	st, err := n.chain.HeadState(n.sim.ctx)
+	require.NoError(n.sim.t, err)
+	return st
+}
+
+// FinalizedCheckpoint of the node.
+func (n *Node) FinalizedCheckpoint() *ethpb.Checkpoint {
+	return n.chain.FinalizedCheckpt()
+}
+
+// HasBlock returns true if the node has processed the block with the given root.
+func (n *Node) HasBlock(root [32]byte) bool {
+	return n.db.HasBlock(n.sim.ctx, root)
+}
+
+// Rejected returns the processing errors of the blocks and attestations the node
+// received from the network and rejected.
+func (n *Node) Rejected() []error {
+	return n.rejected
+}
+
+// gossipHandler reads a gossip message sent by another node over the p2p host and hands
+// it to the simulator, which processes it.
+func (n *Node) gossipHandler(protocol string) libp2pcore.StreamHandler {
+	return func(stream libp2pcore.Stream) {
+		defer func() {
+			if err := stream.Close(); err != nil {
+				log.WithError(err).Debug("Could not close stream")
+			}
+		}()
+		msg, err := newMessage(protocol)
+		if err == nil {
+			err = n.p2p.Encoding().DecodeWithMaxLength(stream, msg)
+		}
+		n.inbox <- received{msg: msg, err: err}
+	}
+}
+
+// blocksByRootHandler answers the blocks by root requests of other nodes with the
+// requested blocks the node has.
+func (n *Node) blocksByRootHandler(stream libp2pcore.Stream) {
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	req := new(p2ptypes.BeaconBlockByRootsReq)
+	if err := n.p2p.Encoding().DecodeWithMaxLength(stream, req); err != nil {
+		log.WithError(err).Error("Could not decode blocks by root request")
+		return
+	}
+	for _, root := range *req {
+		blk, err := n.db.Block(n.sim.ctx, root)
+		if err != nil || blk == nil {
+			continue
+		}
+		if _, err := n.p2p.Encoding().EncodeWithMaxLength(stream, blk); err != nil {
+			log.WithError(err).Error("Could not write block to stream")
+			return
+		}
+	}
+}
+
+// requestBlock requests the block with the given root from another node over the p2p
+// host, as the sync service does with blocks by root.
+func (n *Node) requestBlock(ctx context.Context, from *Node, root [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	req := p2ptypes.BeaconBlockByRootsReq{root}
+	stream, err := n.p2p.Send(ctx, &req, blocksByRootProtocol, from.p2p.PeerID())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	if err := stream.SetReadDeadline(time.Now().Add(receiveTimeout)); err != nil {
+		return nil, err
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := n.p2p.Encoding().DecodeWithMaxLength(stream, blk); err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x from node %d", root, from.index)
+	}
+	return blk, nil
+}
+
+// receive processes a message sent by another node.
+func (n *Node) receive(ctx context.Context, protocol string, msg proto.Message, from *Node) {
+	switch m := msg.(type) {
+	case *ethpb.SignedBeaconBlock:
+		n.receiveBlock(ctx, m, from)
+	case *ethpb.Attestation:
+		n.receiveAttestation(ctx, m)
+	case *ethpb.SignedAggregateAttestationAndProof:
+		n.receiveAggregate(ctx, m)
+	default:
+		n.rejected = append(n.rejected, errors.Errorf("unexpected message %T on %s", msg, protocol))
+	}
+}
+
+// receiveBlock processes a block gossiped by another node. Missing ancestors are
+// requested from the sender first.
+func (n *Node) receiveBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock, from *Node) {
+	if err := n.importBlock(ctx, blk, from); err != nil {
+		n.rejected = append(n.rejected, err)
+	}
+}
+
+func (n *Node) importBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock, from *Node) error {
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if n.db.HasBlock(ctx, root) {
+		return nil
+	}
+	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+	if !n.db.HasBlock(ctx, parentRoot) && from != nil {
+		parent, err := n.requestBlock(ctx, from, parentRoot)
+		if err != nil {
+			return errors.Wrapf(err, "parent %#x of block at slot %d is unknown", parentRoot, blk.Block.Slot)
+		}
+		if err := n.importBlock(ctx, parent, from); err != nil {
+			return err
+		}
+	}
+	return n.chain.ReceiveBlock(ctx, blk, root)
+}
+
+// syncFrom imports the canonical chain of another node, as done when peers exchange
+// their status after a partition heals.
+func (n *Node) syncFrom(ctx context.Context, from *Node) {
+	headRoot := from.HeadRoot()
+	if n.db.HasBlock(ctx, headRoot) {
+		return
+	}
+	head, err := n.requestBlock(ctx, from, headRoot)
+	if err != nil {
+		n.rejected = append(n.rejected, err)
+		return
+	}
+	n.receiveBlock(ctx, head, from)
+}
+
+// receiveAttestation adds an attestation to the pool used for block proposals and
+// queues it for fork choice.
+func (n *Node) receiveAttestation(_ context.Context, att *ethpb.Attestation) {
+	if err := n.attPool.SaveUnaggregatedAttestation(stateTrie.CopyAttestation(att)); err != nil {
+		n.rejected = append(n.rejected, err)
+		return
+	}
+	n.pendingAtts = append(n.pendingAtts, stateTrie.CopyAttestation(att))
+}
+
+// receiveAggregate adds an aggregated attestation to the pool used for block proposals
+// and queues it for fork choice.
+func (n *Node) receiveAggregate(_ context.Context, agg *ethpb.SignedAggregateAttestationAndProof) {
+	att := agg.Message.Aggregate
+	if err := n.attPool.SaveAggregatedAttestation(stateTrie.CopyAttestation(att)); err != nil {
+		n.rejected = append(n.rejected, err)
+		return
+	}
+	n.pendingAtts = append(n.pendingAtts, stateTrie.CopyAttestation(att))
+}
+
+// processPendingAttestations applies the queued attestations from past slots to fork
+// choice. Attestations for blocks the node does not know yet are kept for up to an
+// epoch, in case the block arrives late.
+func (n *Node) processPendingAttestations(ctx context.Context, currentSlot types.Slot) {
+	remaining := n.pendingAtts[:0]
+	for _, att := range n.pendingAtts {
+		if att.Data.Slot >= currentSlot {
+			remaining = append(remaining, att)
+			continue
+		}
+		if !n.db.HasBlock(ctx, bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
+			if att.Data.Slot+params.BeaconConfig().SlotsPerEpoch > currentSlot {
+				remaining = append(remaining, att)
+			}
+			continue
+		}
+		// Attestations are allowed to fail fork choice checks, e.g. when they
+		// conflict with the finalized checkpoint, as they would on a live node.
+		if err := n.chain.ReceiveAttestationNoPubsub(ctx, att); err != nil {
+			continue
+		}
+	}
+	n.pendingAtts = remaining
+}
+
+// broadcaster is the p2p broadcaster of the validator RPC server of a node. It gossips
+// the blocks, attestations and aggregates submitted by the validator client attached to
+// the node. The node's own attestations are also added to its pool and fork choice
+// right away, as they would be once received back from the network.
+type broadcaster struct {
+	node *Node
+}
+
+// Broadcast gossips blocks and aggregates to the other nodes.
+func (b *broadcaster) Broadcast(_ context.Context, msg proto.Message) error {
+	n := b.node
+	switch m := msg.(type) {
+	case *ethpb.SignedBeaconBlock:
+		n.sim.blocks = append(n.sim.blocks, m)
+		n.sim.net.gossip(n.index, blockProtocol, m, n.blockDelay)
+	case *ethpb.SignedAggregateAttestationAndProof:
+		n.sim.net.gossip(n.index, aggregateProtocol, m, 0)
+	}
+	return nil
+}
+
+// BroadcastAttestation gossips an attestation to the other nodes.
+func (b *broadcaster) BroadcastAttestation(ctx context.Context, _ uint64, att *ethpb.Attestation) error {
+	n := b.node
+	n.sim.atts = append(n.sim.atts, att)
+	n.receiveAttestation(ctx, att)
+	n.sim.net.gossip(n.index, attestationProtocol, att, 0)
+	return nil
+}
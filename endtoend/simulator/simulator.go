@@ -0,0 +1,351 @@
+// Package simulator runs several beacon nodes and the validators attached to them in a
+// single process, over an in-memory network and against a simulated clock, so chain
+// scenarios such as forks, late blocks, partitions and slashings can be scripted and
+// asserted deterministically in go test.
+//
+// Each simulated beacon node runs the real blockchain service, fork choice, state
+// generator, operation pools and validator RPC server, backed by its own database and
+// a mocked Eth1 chain, and sends its gossip and block requests to the other nodes over
+// the p2p host of the p2p testing package. Validator i is attached to node i modulo the
+// number of nodes: every node has a real validator client holding the keys of its
+// validators, connected to its validator RPC server, which performs their duties. The
+// services of every node and the validator clients read the time from the simulated
+// clock.
+package simulator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// genesisTime of every simulated chain, fixed so runs are reproducible.
+var genesisTime = time.Unix(1606824023, 0)
+
+// Config of a simulation.
+type Config struct {
+	NumNodes      int
+	NumValidators uint64
+}
+
+// Simulator drives a set of simulated beacon nodes and their validators.
+type Simulator struct {
+	t        *testing.T
+	ctx      context.Context
+	lock     sync.RWMutex
+	now      time.Time
+	privKeys []bls.SecretKey
+	nodes    []*Node
+	net      *network
+	// Blocks and attestations published by the validator clients.
+	blocks []*ethpb.SignedBeaconBlock
+	atts   []*ethpb.Attestation
+}
+
+// New creates a simulation starting at genesis. All the nodes share the same
+// deterministic genesis state.
+func New(t *testing.T, cfg *Config) *Simulator {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, cfg.NumValidators)
+	require.NoError(t, genesisState.SetGenesisTime(uint64(genesisTime.Unix())))
+
+	s := &Simulator{
+		t:        t,
+		ctx:      ctx,
+		now:      genesisTime,
+		privKeys: privKeys,
+	}
+	s.net = newNetwork(s)
+	for i := 0; i < cfg.NumNodes; i++ {
+		s.nodes = append(s.nodes, newNode(t, s, i, genesisState.Copy()))
+	}
+	for i, n := range s.nodes {
+		for _, peer := range s.nodes[i+1:] {
+			n.p2p.Connect(peer.p2p)
+		}
+		n.attachValidatorClient(t)
+	}
+	return s
+}
+
+// Now is the current time of the simulated clock.
+func (s *Simulator) Now() time.Time {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.now
+}
+
+// Slot is the current slot of the simulated clock.
+func (s *Simulator) Slot() types.Slot {
+	return types.Slot(uint64(s.Now().Sub(genesisTime).Seconds()) / params.BeaconConfig().SecondsPerSlot)
+}
+
+// Node returns the simulated beacon node with the given index.
+func (s *Simulator) Node(i int) *Node {
+	return s.nodes[i]
+}
+
+// Nodes returns all the simulated beacon nodes.
+func (s *Simulator) Nodes() []*Node {
+	return s.nodes
+}
+
+// PrivateKey of the validator with the given index.
+func (s *Simulator) PrivateKey(idx types.ValidatorIndex) bls.SecretKey {
+	return s.privKeys[idx]
+}
+
+// NodeOf returns the index of the node the given validator is attached to.
+func (s *Simulator) NodeOf(idx types.ValidatorIndex) int {
+	return int(uint64(idx) % uint64(len(s.nodes)))
+}
+
+// AdvanceTime moves the simulated clock forward and delivers the network messages
+// which became due.
+func (s *Simulator) AdvanceTime(d time.Duration) {
+	s.lock.Lock()
+	s.now = s.now.Add(d)
+	s.lock.Unlock()
+	s.net.deliverDue(s.ctx)
+}
+
+// AdvanceSlot moves the simulated clock to the start of the next slot, delivers the
+// network messages which became due, applies the attestations of past slots to fork
+// choice and makes the validator clients update their duties.
+func (s *Simulator) AdvanceSlot() {
+	next := genesisTime.Add(time.Duration(uint64(s.Slot()+1)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s.AdvanceTime(next.Sub(s.Now()))
+	for _, n := range s.nodes {
+		n.processPendingAttestations(s.ctx, s.Slot())
+		require.NoError(s.t, n.validator.UpdateDuties(s.ctx, s.Slot()), "validator client of node %d could not update duties", n.index)
+	}
+}
+
+// RunSlots advances the simulation by the given number of slots with every validator
+// behaving honestly: blocks are proposed at the start of each slot, attestations are
+// made a third of the way through it and aggregated two thirds of the way through it.
+func (s *Simulator) RunSlots(slots uint64) error {
+	third := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3
+	for i := uint64(0); i < slots; i++ {
+		s.AdvanceSlot()
+		if _, err := s.ProposeBlocks(); err != nil {
+			return err
+		}
+		s.AdvanceTime(third)
+		if _, err := s.Attest(); err != nil {
+			return err
+		}
+		s.AdvanceTime(third)
+		if err := s.Aggregate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Partition splits the network into isolated groups of nodes. Nodes not listed are
+// isolated on their own.
+func (s *Simulator) Partition(groups ...[]int) {
+	s.net.partition(groups)
+}
+
+// Heal reconnects all the nodes. As peers do when they connect, every node syncs
+// the canonical chain of every other node.
+func (s *Simulator) Heal() {
+	s.net.heal()
+	for _, n := range s.nodes {
+		for _, peer := range s.nodes {
+			if peer != n {
+				n.syncFrom(s.ctx, peer)
+			}
+		}
+	}
+}
+
+// SetLatency sets the time messages sent from one node take to reach another.
+func (s *Simulator) SetLatency(from, to int, d time.Duration) {
+	s.net.latency[[2]int{from, to}] = d
+}
+
+// DelayBlocks delays the gossip of the blocks proposed through the given node by the
+// given duration, on top of the link latencies.
+func (s *Simulator) DelayBlocks(node int, d time.Duration) {
+	s.nodes[node].blockDelay = d
+}
+
+// ProposeOption customizes a block proposal.
+type ProposeOption func(*proposeConfig)
+
+type proposeConfig struct {
+	delay     time.Duration
+	modify    func(*ethpb.BeaconBlock)
+	unpublish bool
+}
+
+// WithBroadcastDelay delays the gossip of the block to the other nodes.
+func WithBroadcastDelay(d time.Duration) ProposeOption {
+	return func(c *proposeConfig) {
+		c.delay = d
+	}
+}
+
+// WithBlockModifier changes the block built by the beacon node before it is signed.
+// The state root is recomputed after the modification.
+func WithBlockModifier(modify func(*ethpb.BeaconBlock)) ProposeOption {
+	return func(c *proposeConfig) {
+		c.modify = modify
+	}
+}
+
+// WithoutPublishing only builds and signs the block, which is neither processed by
+// the proposing node nor gossiped.
+func WithoutPublishing() ProposeOption {
+	return func(c *proposeConfig) {
+		c.unpublish = true
+	}
+}
+
+// ProposeBlocks makes the validator clients propose the blocks of the current slot
+// assigned to their validators by the node they are attached to, and returns the
+// published blocks. The blocks are processed by the nodes and gossiped to their peers.
+func (s *Simulator) ProposeBlocks() ([]*ethpb.SignedBeaconBlock, error) {
+	published := len(s.blocks)
+	for _, n := range s.nodes {
+		if err := n.validator.ProposeBlocks(s.ctx, s.Slot()); err != nil {
+			return nil, errors.Wrapf(err, "validator client of node %d could not propose", n.index)
+		}
+	}
+	return append([]*ethpb.SignedBeaconBlock{}, s.blocks[published:]...), nil
+}
+
+// ProposeBlock makes the proposer of the current slot, as seen by the given node,
+// propose a block built by that node. The block is signed with the key of the proposer
+// directly, bypassing its validator client and its slashing protection, so misbehaving
+// proposers can be scripted. The block is processed by the node and gossiped to its
+// peers.
+func (s *Simulator) ProposeBlock(node int, opts ...ProposeOption) (*ethpb.SignedBeaconBlock, error) {
+	cfg := &proposeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	ctx := s.ctx
+	n := s.nodes[node]
+	slot := s.Slot()
+	epoch := helpers.SlotToEpoch(slot)
+
+	st, err := n.stateAt(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := helpers.BeaconProposerIndex(st)
+	if err != nil {
+		return nil, err
+	}
+	randaoReveal, err := testutil.RandaoReveal(st, epoch, s.privKeys)
+	if err != nil {
+		return nil, err
+	}
+	graffiti := bytesutil.ToBytes32([]byte("simulator"))
+	blk, err := n.server.GetBlock(ctx, &ethpb.BlockRequest{
+		Slot:         slot,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "node %d could not build block", node)
+	}
+	if cfg.modify != nil {
+		cfg.modify(blk)
+		parentState, err := n.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+		if err != nil {
+			return nil, err
+		}
+		root, err := state.CalculateStateRoot(ctx, parentState, &ethpb.SignedBeaconBlock{Block: blk})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute state root of modified block")
+		}
+		blk.StateRoot = root[:]
+	}
+	sig, err := helpers.ComputeDomainAndSign(st, epoch, blk, params.BeaconConfig().DomainBeaconProposer, s.privKeys[proposer])
+	if err != nil {
+		return nil, err
+	}
+	signed := &ethpb.SignedBeaconBlock{Block: blk, Signature: sig}
+	if cfg.unpublish {
+		return signed, nil
+	}
+
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if err := n.chain.ReceiveBlock(ctx, signed, root); err != nil {
+		return nil, errors.Wrapf(err, "node %d could not process its own block", node)
+	}
+	s.net.gossip(node, blockProtocol, signed, cfg.delay)
+	return signed, nil
+}
+
+// Attest makes the validator clients submit the attestations of the current slot
+// assigned to their validators by the node they are attached to, and returns the
+// published attestations. The attestations are processed by the nodes and gossiped to
+// their peers.
+func (s *Simulator) Attest() ([]*ethpb.Attestation, error) {
+	published := len(s.atts)
+	for _, n := range s.nodes {
+		if err := n.validator.SubmitAttestations(s.ctx, s.Slot()); err != nil {
+			return nil, errors.Wrapf(err, "validator client of node %d could not attest", n.index)
+		}
+	}
+	return append([]*ethpb.Attestation{}, s.atts[published:]...), nil
+}
+
+// Aggregate makes the validator clients submit the aggregates of the current slot of
+// their validators selected as aggregators. The aggregates are gossiped to the peers of
+// the nodes.
+func (s *Simulator) Aggregate() error {
+	for _, n := range s.nodes {
+		if err := n.validator.SubmitAggregateAndProofs(s.ctx, s.Slot()); err != nil {
+			return errors.Wrapf(err, "validator client of node %d could not aggregate", n.index)
+		}
+	}
+	return nil
+}
+
+// ProposerSlashing builds the slashing of a proposer from two conflicting blocks.
+func (s *Simulator) ProposerSlashing(a, b *ethpb.SignedBeaconBlock) (*ethpb.ProposerSlashing, error) {
+	h1, err := blockutil.SignedBeaconBlockHeaderFromBlock(a)
+	if err != nil {
+		return nil, err
+	}
+	h2, err := blockutil.SignedBeaconBlockHeaderFromBlock(b)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.ProposerSlashing{Header_1: h1, Header_2: h2}, nil
+}
+
+// stateAt returns a copy of the node's head state advanced to the given slot.
+func (n *Node) stateAt(ctx context.Context, slot types.Slot) (*stateTrie.BeaconState, error) {
+	st := n.HeadState().Copy()
+	if st.Slot() >= slot {
+		return st, nil
+	}
+	return state.ProcessSlots(ctx, st, slot)
+}
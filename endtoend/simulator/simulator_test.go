@@ -0,0 +1,133 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupSimulator(t *testing.T, numNodes int) *Simulator {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	return New(t, &Config{NumNodes: numNodes, NumValidators: 64})
+}
+
+func requireNoRejections(t *testing.T, s *Simulator) {
+	for _, n := range s.Nodes() {
+		require.Equal(t, 0, len(n.Rejected()), "node %d rejected messages: %v", n.Index(), n.Rejected())
+	}
+}
+
+func TestSimulator_Finality(t *testing.T) {
+	s := setupSimulator(t, 2)
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, s.RunSlots(5*slotsPerEpoch))
+
+	requireNoRejections(t, s)
+	a, b := s.Node(0), s.Node(1)
+	assert.Equal(t, s.Slot(), a.HeadSlot())
+	assert.Equal(t, a.HeadRoot(), b.HeadRoot())
+	assert.Equal(t, true, a.FinalizedCheckpoint().Epoch >= 2, "finalized epoch %d", a.FinalizedCheckpoint().Epoch)
+	assert.DeepEqual(t, a.FinalizedCheckpoint(), b.FinalizedCheckpoint())
+}
+
+func TestSimulator_PartitionForksAndHeals(t *testing.T) {
+	s := setupSimulator(t, 2)
+	require.NoError(t, s.RunSlots(uint64(params.BeaconConfig().SlotsPerEpoch)))
+	a, b := s.Node(0), s.Node(1)
+	require.Equal(t, a.HeadRoot(), b.HeadRoot())
+
+	s.Partition([]int{0}, []int{1})
+	require.NoError(t, s.RunSlots(4))
+	assert.NotEqual(t, a.HeadRoot(), b.HeadRoot(), "partitioned nodes should be on different forks")
+
+	s.Heal()
+	require.NoError(t, s.RunSlots(2))
+	requireNoRejections(t, s)
+	assert.Equal(t, a.HeadRoot(), b.HeadRoot())
+}
+
+func TestSimulator_LateBlockIsNotAttestedTo(t *testing.T) {
+	s := setupSimulator(t, 2)
+	require.NoError(t, s.RunSlots(uint64(params.BeaconConfig().SlotsPerEpoch)))
+	parent := s.Node(0).HeadRoot()
+
+	// Find the proposer of the next slot and delay its block past the attestation
+	// deadline.
+	s.AdvanceSlot()
+	proposer := proposerIndex(t, s, s.Slot())
+	proposerNode := s.NodeOf(proposer)
+	otherNode := 1 - proposerNode
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.DelayBlocks(proposerNode, secondsPerSlot/2)
+	blks, err := s.ProposeBlocks()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	root, err := blks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	s.AdvanceTime(secondsPerSlot / 3)
+	_, err = s.Attest()
+	require.NoError(t, err)
+	require.Equal(t, false, s.Node(otherNode).HasBlock(root))
+	req := &ethpb.AttestationDataRequest{Slot: s.Slot()}
+	data, err := s.Node(proposerNode).ValidatorServer().GetAttestationData(s.ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], data.BeaconBlockRoot, "proposer node should attest to its own block")
+	data, err = s.Node(otherNode).ValidatorServer().GetAttestationData(s.ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, parent[:], data.BeaconBlockRoot, "other node should attest to the parent")
+
+	s.AdvanceTime(secondsPerSlot / 2)
+	assert.Equal(t, true, s.Node(otherNode).HasBlock(root), "late block should eventually arrive")
+	requireNoRejections(t, s)
+}
+
+func TestSimulator_EquivocationIsSlashed(t *testing.T) {
+	s := setupSimulator(t, 2)
+	require.NoError(t, s.RunSlots(uint64(params.BeaconConfig().SlotsPerEpoch)))
+
+	s.AdvanceSlot()
+	proposer := proposerIndex(t, s, s.Slot())
+	node := s.NodeOf(proposer)
+	blk2, err := s.ProposeBlock(node, WithoutPublishing(), WithBlockModifier(func(b *ethpb.BeaconBlock) {
+		b.Body.Graffiti = bytesutil.PadTo([]byte("equivocation"), 32)
+	}))
+	require.NoError(t, err)
+	blks, err := s.ProposeBlocks()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	slashing, err := s.ProposerSlashing(blks[0], blk2)
+	require.NoError(t, err)
+
+	// Include the slashing in the next block.
+	s.AdvanceSlot()
+	next := s.NodeOf(proposerIndex(t, s, s.Slot()))
+	_, err = s.ProposeBlock(next, WithBlockModifier(func(b *ethpb.BeaconBlock) {
+		b.Body.ProposerSlashings = []*ethpb.ProposerSlashing{slashing}
+	}))
+	require.NoError(t, err)
+	s.AdvanceTime(time.Second)
+	requireNoRejections(t, s)
+
+	for _, n := range s.Nodes() {
+		v, err := n.HeadState().ValidatorAtIndexReadOnly(proposer)
+		require.NoError(t, err)
+		assert.Equal(t, true, v.Slashed(), "node %d should see the proposer slashed", n.Index())
+	}
+}
+
+func proposerIndex(t *testing.T, s *Simulator, slot types.Slot) types.ValidatorIndex {
+	st, err := s.Node(0).stateAt(s.ctx, slot)
+	require.NoError(t, err)
+	idx, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	return idx
+}
@@ -0,0 +1,84 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// keyManager holds the keys of the validators attached to a simulated node in memory.
+type keyManager struct {
+	pubKeys [][48]byte
+	keys    map[[48]byte]bls.SecretKey
+	feed    *event.Feed
+}
+
+// FetchValidatingPublicKeys returns the public keys of the validators.
+func (km *keyManager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	return km.pubKeys, nil
+}
+
+// FetchAllValidatingPublicKeys returns the public keys of the validators.
+func (km *keyManager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return km.FetchValidatingPublicKeys(ctx)
+}
+
+// Sign signs the signing root of the request with the key of the validator.
+func (km *keyManager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	key, ok := km.keys[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, errors.New("no signing key found")
+	}
+	return key.Sign(req.SigningRoot), nil
+}
+
+// SubscribeAccountChanges subscribes to changes of the keys, which never happen.
+func (km *keyManager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.feed.Subscribe(pubKeysChan)
+}
+
+// attachValidatorClient runs a validator client for the validators attached to the node,
+// connected to the validator RPC of the node and reading the time from the simulated
+// clock.
+func (n *Node) attachValidatorClient(t *testing.T) {
+	km := &keyManager{
+		keys: make(map[[48]byte]bls.SecretKey),
+		feed: new(event.Feed),
+	}
+	for i, key := range n.sim.privKeys {
+		if n.sim.NodeOf(types.ValidatorIndex(i)) != n.index {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+		km.pubKeys = append(km.pubKeys, pubKey)
+		km.keys[pubKey] = key
+	}
+	validatorDB, err := kv.NewKVStore(n.sim.ctx, t.TempDir(), &kv.Config{
+		PubKeys:           km.pubKeys,
+		MetricsRegisterer: prometheus.NewRegistry(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, validatorDB.Close())
+	})
+	v, err := client.NewInProcessValidator(n.sim.ctx, &client.InProcessConfig{
+		Conn:       n.dialValidatorRPC(t),
+		KeyManager: km,
+		DB:         validatorDB,
+		Graffiti:   []byte("simulator"),
+		Clock:      n.sim.Now,
+	})
+	require.NoError(t, err)
+	require.NoError(t, v.WaitForChainStart(n.sim.ctx))
+	n.validator = v
+}
@@ -17,6 +17,7 @@ type E2EConfig struct {
 	TestDeposits   bool
 	UsePprof       bool
 	Evaluators     []Evaluator
+	Faults         []Fault
 }
 
 // Evaluator defines the structure of the evaluators used to
@@ -26,3 +27,30 @@ type Evaluator struct {
 	Policy     func(currentEpoch types.Epoch) bool
 	Evaluation func(conn ...*grpc.ClientConn) error // A variable amount of conns is allowed to be passed in for evaluations to check all nodes if needed.
 }
+
+// Fault defines a disruption injected into the running network at the start of the epochs
+// selected by its policy, before the evaluators of that epoch are run.
+type Fault struct {
+	Name   string
+	Policy func(currentEpoch types.Epoch) bool
+	Inject func(c ComponentController) error
+}
+
+// ComponentController allows faults to stop, restart and isolate the beacon nodes and
+// validator clients of a running end-to-end test.
+type ComponentController interface {
+	// StopBeaconNode shuts down the beacon node with the given index.
+	StopBeaconNode(index int) error
+	// StartBeaconNode starts the stopped beacon node with the given index, keeping its
+	// database unless clearDB is set, in which case it has to resync from its peers.
+	StartBeaconNode(index int, clearDB bool) error
+	// StopValidatorClient shuts down the validator client with the given index.
+	StopValidatorClient(index int) error
+	// StartValidatorClient starts the stopped validator client with the given index,
+	// keeping its slashing protection database, with the given extra flags.
+	StartValidatorClient(index int, extraFlags ...string) error
+	// Partition isolates the given groups of beacon nodes from each other at the p2p level.
+	Partition(groups ...[]int) error
+	// Heal reconnects all the beacon nodes.
+	Heal() error
+}
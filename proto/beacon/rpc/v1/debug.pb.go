@@ -33,6 +33,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// The logging levels available in Prysm as an enum.
 type LoggingLevelRequest_Level int32
 
 const (
@@ -58,7 +59,7 @@ func (x LoggingLevelRequest_Level) String() string {
 }
 
 func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{7, 0}
 }
 
 type InclusionSlotRequest struct {
@@ -163,6 +164,183 @@ func (m *InclusionSlotResponse) GetSlot() github_com_prysmaticlabs_eth2_types.Sl
 	return 0
 }
 
+type AttestationPackingReports struct {
+	// Reports for the most recent block proposals, ordered by descending slot.
+	Reports              []*AttestationPackingReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AttestationPackingReports) Reset()         { *m = AttestationPackingReports{} }
+func (m *AttestationPackingReports) String() string { return proto.CompactTextString(m) }
+func (*AttestationPackingReports) ProtoMessage()    {}
+func (*AttestationPackingReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *AttestationPackingReports) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPackingReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPackingReports.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPackingReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPackingReports.Merge(m, src)
+}
+func (m *AttestationPackingReports) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPackingReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPackingReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPackingReports proto.InternalMessageInfo
+
+func (m *AttestationPackingReports) GetReports() []*AttestationPackingReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type AttestationPackingReport struct {
+	// Slot of the proposed block.
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	// Number of attestations in the pool considered for packing.
+	CandidatePoolSize uint64 `protobuf:"varint,2,opt,name=candidate_pool_size,json=candidatePoolSize,proto3" json:"candidate_pool_size,omitempty"`
+	// Number of candidate attestations which were valid for inclusion.
+	ValidCandidates uint64 `protobuf:"varint,3,opt,name=valid_candidates,json=validCandidates,proto3" json:"valid_candidates,omitempty"`
+	// Number of attestations packed into the block.
+	PackedAttestations uint64 `protobuf:"varint,4,opt,name=packed_attestations,json=packedAttestations,proto3" json:"packed_attestations,omitempty"`
+	// Number of validator votes included in the block which were not yet counted on chain.
+	NewVotesIncluded uint64 `protobuf:"varint,5,opt,name=new_votes_included,json=newVotesIncluded,proto3" json:"new_votes_included,omitempty"`
+	// Number of uncounted validator votes available across all valid candidates.
+	MaxNewVotes uint64 `protobuf:"varint,6,opt,name=max_new_votes,json=maxNewVotes,proto3" json:"max_new_votes,omitempty"`
+	// Expected proposer reward in Gwei for the votes included in the block.
+	ExpectedReward uint64 `protobuf:"varint,7,opt,name=expected_reward,json=expectedReward,proto3" json:"expected_reward,omitempty"`
+	// Proposer reward in Gwei if every available uncounted vote had been included.
+	MaxExpectedReward uint64 `protobuf:"varint,8,opt,name=max_expected_reward,json=maxExpectedReward,proto3" json:"max_expected_reward,omitempty"`
+	// Time spent selecting attestations in milliseconds.
+	PackingTimeMs uint64 `protobuf:"varint,9,opt,name=packing_time_ms,json=packingTimeMs,proto3" json:"packing_time_ms,omitempty"`
+	// Aggregation strategy used to pack the attestations.
+	Strategy             string   `protobuf:"bytes,10,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationPackingReport) Reset()         { *m = AttestationPackingReport{} }
+func (m *AttestationPackingReport) String() string { return proto.CompactTextString(m) }
+func (*AttestationPackingReport) ProtoMessage()    {}
+func (*AttestationPackingReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+}
+func (m *AttestationPackingReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPackingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPackingReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPackingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPackingReport.Merge(m, src)
+}
+func (m *AttestationPackingReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPackingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPackingReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPackingReport proto.InternalMessageInfo
+
+func (m *AttestationPackingReport) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetCandidatePoolSize() uint64 {
+	if m != nil {
+		return m.CandidatePoolSize
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetValidCandidates() uint64 {
+	if m != nil {
+		return m.ValidCandidates
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetPackedAttestations() uint64 {
+	if m != nil {
+		return m.PackedAttestations
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetNewVotesIncluded() uint64 {
+	if m != nil {
+		return m.NewVotesIncluded
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetMaxNewVotes() uint64 {
+	if m != nil {
+		return m.MaxNewVotes
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetExpectedReward() uint64 {
+	if m != nil {
+		return m.ExpectedReward
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetMaxExpectedReward() uint64 {
+	if m != nil {
+		return m.MaxExpectedReward
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetPackingTimeMs() uint64 {
+	if m != nil {
+		return m.PackingTimeMs
+	}
+	return 0
+}
+
+func (m *AttestationPackingReport) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
 type BeaconStateRequest struct {
 	// Types that are valid to be assigned to QueryFilter:
 	//	*BeaconStateRequest_Slot
@@ -177,7 +355,7 @@ func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
 func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateRequest) ProtoMessage()    {}
 func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
 }
 func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +440,7 @@ func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
 func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockRequest) ProtoMessage()    {}
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
 }
 func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,6 +477,7 @@ func (m *BlockRequest) GetBlockRoot() []byte {
 }
 
 type SSZResponse struct {
+	// Returns an ssz-encoded byte slice as a response.
 	Encoded              []byte   `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -309,7 +488,7 @@ func (m *SSZResponse) Reset()         { *m = SSZResponse{} }
 func (m *SSZResponse) String() string { return proto.CompactTextString(m) }
 func (*SSZResponse) ProtoMessage()    {}
 func (*SSZResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
 }
 func (m *SSZResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +535,7 @@ func (m *LoggingLevelRequest) Reset()         { *m = LoggingLevelRequest{} }
 func (m *LoggingLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*LoggingLevelRequest) ProtoMessage()    {}
 func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
 }
 func (m *LoggingLevelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,21 +572,26 @@ func (m *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
 }
 
 type ProtoArrayForkChoiceResponse struct {
-	PruneThreshold       uint64                                    `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
-	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
-	ProtoArrayNodes      []*ProtoArrayNode                         `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
-	Indices              map[string]uint64                         `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+	// The prune threshold of how many nodes allowed in proto array store.
+	PruneThreshold uint64 `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
+	// Latest justified epoch in proto array store.
+	JustifiedEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	// Latest finalized epoch in proto array store.
+	FinalizedEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	// The list of the proto array nodes in store.
+	ProtoArrayNodes []*ProtoArrayNode `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
+	// Root to indices mapping of the proto array nodes in store.
+	Indices              map[string]uint64 `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoiceResponse{} }
 func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
 func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
 }
 func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,24 +656,32 @@ func (m *ProtoArrayForkChoiceResponse) GetIndices() map[string]uint64 {
 }
 
 type ProtoArrayNode struct {
-	Slot                 github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
-	Root                 []byte                                    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
-	Parent               uint64                                    `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
-	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
-	Weight               uint64                                    `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	BestChild            uint64                                    `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
-	BestDescendant       uint64                                    `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+	// Slot of the proto array node.
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	// Root of the proto array node.
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// Parent of the proto array node.
+	Parent uint64 `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Justified epoch of the current proto array node.
+	JustifiedEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	// finalized epoch of the current proto array node.
+	FinalizedEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	// Current weight of the current proto array node.
+	Weight uint64 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Best child of the current proto array node.
+	BestChild uint64 `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	// Best descendant of the proto array node.
+	BestDescendant       uint64   `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
 func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
 func (*ProtoArrayNode) ProtoMessage()    {}
 func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
 }
 func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +777,7 @@ func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
 func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponses) ProtoMessage()    {}
 func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
 }
 func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,25 +814,34 @@ func (m *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
 }
 
 type DebugPeerResponse struct {
-	ListeningAddresses   []string                    `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
-	Direction            v1alpha1.PeerDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
-	ConnectionState      v1alpha1.ConnectionState    `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
-	PeerId               string                      `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
-	Enr                  string                      `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
-	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
-	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
-	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	ScoreInfo            *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	// Listening addresses know of the peer.
+	ListeningAddresses []string `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
+	// Direction of current connection.
+	Direction v1alpha1.PeerDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
+	// Current connection between host and peer.
+	ConnectionState v1alpha1.ConnectionState `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
+	// Peer ID of peer.
+	PeerId string `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// ENR of peer at the current moment.
+	Enr string `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
+	// Peer Info of the peer containing all relevant metadata.
+	PeerInfo *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
+	// Peer Status of the peer.
+	PeerStatus *v1.Status `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
+	// Last know update time for peer status.
+	LastUpdated uint64 `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// Score Info of the peer.
+	ScoreInfo            *ScoreInfo `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
 func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse) ProtoMessage()    {}
 func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
 func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,23 +933,31 @@ func (m *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
 	return nil
 }
 
+// Peer related metadata that is useful for debugging.
 type DebugPeerResponse_PeerInfo struct {
-	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
-	FaultCount           uint64       `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
-	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
-	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	// Metadata of the peer, containing their bitfield
+	// and sequence number.
+	Metadata *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// List of protocols the peer supports.
+	Protocols []string `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	// Number of times peer has been penalised.
+	FaultCount uint64 `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
+	// Protocol Version peer is running.
+	ProtocolVersion string `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// Agent Version peer is running.
+	AgentVersion string `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	// Latency of responses from peer(in ms).
+	PeerLatency          uint64   `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_PeerInfo{} }
 func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
 func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{11, 0}
 }
 func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,24 +1028,32 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 	return 0
 }
 
+// The Scoring related information of the particular peer.
 type ScoreInfo struct {
-	OverallScore         float32                        `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
-	ProcessedBlocks      uint64                         `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
-	BlockProviderScore   float32                        `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
-	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	GossipScore          float32                        `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
-	BehaviourPenalty     float32                        `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
-	ValidationError      string                         `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	OverallScore float32 `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	// Amount of processed blocks provided by
+	// the peer.
+	ProcessedBlocks uint64 `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	// Related block provider score.
+	BlockProviderScore float32 `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
+	// Relevant scores by particular topic.
+	TopicScores map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Gossip Score for peer.
+	GossipScore float32 `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
+	// Behaviour penalty of peer.
+	BehaviourPenalty float32 `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	// Returns the current validation error(if it exists).
+	ValidationError      string   `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ScoreInfo) Reset()         { *m = ScoreInfo{} }
 func (m *ScoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ScoreInfo) ProtoMessage()    {}
 func (*ScoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
 }
 func (m *ScoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,9 +1132,15 @@ func (m *ScoreInfo) GetValidationError() string {
 }
 
 type TopicScoreSnapshot struct {
-	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
-	FirstMessageDeliveries   float32  `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
-	MeshMessageDeliveries    float32  `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
+	// Time a peer has spent in the gossip mesh.
+	TimeInMesh uint64 `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
+	// This is the number of first message deliveries in the topic.
+	FirstMessageDeliveries float32 `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
+	// This is the number of message deliveries in the mesh, within the MeshMessageDeliveriesWindow of
+	// message validation.It effectively tracks first and near-first
+	// deliveries, ie a message seen from a mesh peer before we have forwarded it to them.
+	MeshMessageDeliveries float32 `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
+	// This is the number of invalid messages in the topic from the peer.
 	InvalidMessageDeliveries float32  `protobuf:"fixed32,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
 	XXX_unrecognized         []byte   `json:"-"`
@@ -928,7 +1151,7 @@ func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
 func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
 func (*TopicScoreSnapshot) ProtoMessage()    {}
 func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
 }
 func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,6 +1212,8 @@ func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
 	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
 	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*AttestationPackingReports)(nil), "ethereum.beacon.rpc.v1.AttestationPackingReports")
+	proto.RegisterType((*AttestationPackingReport)(nil), "ethereum.beacon.rpc.v1.AttestationPackingReport")
 	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
 	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
 	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
@@ -1007,107 +1232,122 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
 
 var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x52, 0xa2, 0xa4, 0x7d, 0x64, 0x29, 0x7a, 0xe2, 0xc8, 0x2c, 0x6d, 0x4b, 0xf2, 0x3a,
-	0x8d, 0xed, 0x24, 0x5a, 0x56, 0x6c, 0x51, 0x04, 0x41, 0x80, 0xda, 0xfa, 0x88, 0x22, 0xc0, 0x4e,
-	0xdc, 0x95, 0x1d, 0xa0, 0x2d, 0x8a, 0xc5, 0x68, 0xf7, 0x91, 0x3b, 0xf1, 0x6a, 0x67, 0x33, 0x33,
-	0x64, 0xab, 0xf4, 0x56, 0x14, 0x28, 0x7a, 0x69, 0x0f, 0x05, 0x7a, 0xee, 0x9f, 0x53, 0xa0, 0x97,
-	0x02, 0xbd, 0x07, 0x85, 0x11, 0xf4, 0x8f, 0xd0, 0xa9, 0x98, 0x99, 0xdd, 0x25, 0x15, 0x91, 0x89,
-	0x6c, 0x38, 0xb7, 0x79, 0x5f, 0xbf, 0xf7, 0xf6, 0x7d, 0xec, 0xbc, 0x81, 0x8d, 0x5c, 0x70, 0xc5,
-	0x7b, 0xc7, 0x48, 0x23, 0x9e, 0xf5, 0x44, 0x1e, 0xf5, 0xc6, 0xdb, 0xbd, 0x18, 0x8f, 0x47, 0x43,
-	0xdf, 0x48, 0xc8, 0x1a, 0xaa, 0x04, 0x05, 0x8e, 0x4e, 0x7c, 0xab, 0xe3, 0x8b, 0x3c, 0xf2, 0xc7,
-	0xdb, 0xdd, 0xeb, 0xa8, 0x92, 0xde, 0x78, 0x9b, 0xa6, 0x79, 0x42, 0xb7, 0x7b, 0x19, 0x8f, 0xd1,
-	0x1a, 0x74, 0xbd, 0x73, 0x88, 0x79, 0x3f, 0xd7, 0x88, 0x27, 0x28, 0x25, 0x1d, 0xa2, 0x2c, 0x74,
-	0x6e, 0x0e, 0x39, 0x1f, 0xa6, 0xd8, 0xa3, 0x39, 0xeb, 0xd1, 0x2c, 0xe3, 0x8a, 0x2a, 0xc6, 0xb3,
-	0x52, 0x7a, 0xa3, 0x90, 0x1a, 0xea, 0x78, 0x34, 0xe8, 0xe1, 0x49, 0xae, 0x4e, 0x0b, 0xe1, 0xd6,
-	0x90, 0xa9, 0x64, 0x74, 0xec, 0x47, 0xfc, 0xa4, 0x37, 0xe4, 0x43, 0x3e, 0xd1, 0xd2, 0x94, 0xf5,
-	0xad, 0x4f, 0x56, 0xdd, 0x4b, 0xe0, 0xda, 0x61, 0x16, 0xa5, 0x23, 0xc9, 0x78, 0x76, 0x94, 0x72,
-	0x15, 0xe0, 0x17, 0x23, 0x94, 0x8a, 0xb4, 0xa0, 0xc6, 0xe2, 0x8e, 0xb3, 0xe9, 0xdc, 0x5b, 0x0c,
-	0x6a, 0x2c, 0x26, 0x0f, 0x60, 0x51, 0xa6, 0x5c, 0x75, 0x6a, 0x9a, 0xb3, 0xf3, 0xde, 0xd9, 0x57,
-	0x1b, 0xf7, 0xa6, 0x1c, 0xe5, 0xe2, 0x54, 0x9e, 0x50, 0xc5, 0xa2, 0x94, 0x1e, 0xcb, 0x1e, 0xaa,
-	0xa4, 0xbf, 0xa5, 0x4e, 0x73, 0x94, 0xbe, 0x81, 0x34, 0x96, 0xde, 0x2f, 0xe1, 0xcd, 0x6f, 0x78,
-	0x92, 0x39, 0xcf, 0x24, 0xbe, 0x06, 0xe8, 0x3f, 0x3b, 0x40, 0x76, 0x4c, 0x3e, 0x8f, 0x14, 0x55,
-	0x58, 0x7e, 0xc3, 0x4e, 0x01, 0xec, 0xbc, 0x3c, 0xf0, 0xc7, 0x57, 0x2c, 0x34, 0xd9, 0x00, 0x38,
-	0x4e, 0x79, 0xf4, 0x3c, 0x14, 0xbc, 0x08, 0xb1, 0xf9, 0xf1, 0x95, 0xc0, 0x35, 0xbc, 0x80, 0x73,
-	0xb5, 0xd3, 0x82, 0xe6, 0x17, 0x23, 0x14, 0xa7, 0xe1, 0x80, 0xa5, 0x0a, 0x85, 0xb7, 0x05, 0xcd,
-	0x1d, 0x23, 0x2c, 0x82, 0xb8, 0x75, 0x0e, 0x40, 0x87, 0xd2, 0x9c, 0x32, 0xf7, 0xee, 0x42, 0xe3,
-	0xe8, 0xe8, 0x57, 0x55, 0x2e, 0x3a, 0xb0, 0x8c, 0x59, 0xc4, 0x63, 0x8c, 0x0b, 0xd5, 0x92, 0xf4,
-	0xfe, 0xe4, 0xc0, 0x1b, 0x8f, 0xf8, 0x70, 0xc8, 0xb2, 0xe1, 0x23, 0x1c, 0x63, 0x5a, 0xe2, 0x1f,
-	0x40, 0x3d, 0xd5, 0xb4, 0xd1, 0x6f, 0xf5, 0xb7, 0xfd, 0xd9, 0xfd, 0xe8, 0xcf, 0xb0, 0xf5, 0x2d,
-	0x61, 0xed, 0xbd, 0xbb, 0x50, 0x37, 0x34, 0x59, 0x81, 0xc5, 0xc3, 0x4f, 0x3e, 0xfa, 0xb4, 0x7d,
-	0x85, 0xb8, 0x50, 0xdf, 0xdb, 0xdf, 0x79, 0x76, 0xd0, 0x76, 0xf4, 0xf1, 0x69, 0xf0, 0x70, 0x77,
-	0xbf, 0x5d, 0xf3, 0xbe, 0x5e, 0x80, 0x9b, 0x4f, 0x74, 0xf3, 0x3c, 0x14, 0x82, 0x9e, 0x7e, 0xc4,
-	0xc5, 0xf3, 0xdd, 0x84, 0xb3, 0x08, 0xab, 0x8f, 0xb8, 0x0b, 0xab, 0xb9, 0x18, 0x65, 0x18, 0xaa,
-	0x44, 0xa0, 0x4c, 0x78, 0x5a, 0x36, 0x52, 0xcb, 0xb0, 0x9f, 0x96, 0x5c, 0xf2, 0x19, 0xac, 0x7e,
-	0x3e, 0x92, 0x8a, 0x0d, 0x18, 0xc6, 0x21, 0xe6, 0x3c, 0x4a, 0x8a, 0x26, 0xd8, 0x3a, 0xfb, 0x6a,
-	0xe3, 0xfe, 0x65, 0x6a, 0xb5, 0xaf, 0x8d, 0x82, 0x56, 0x85, 0x62, 0x68, 0x8d, 0x3b, 0x60, 0x19,
-	0x4d, 0xd9, 0x97, 0x15, 0xee, 0xc2, 0x2b, 0xe1, 0x56, 0x28, 0x16, 0x37, 0x80, 0xab, 0x66, 0x6a,
-	0x42, 0xaa, 0xbf, 0x3c, 0xd4, 0x43, 0x2d, 0x3b, 0x8b, 0x9b, 0x0b, 0xf7, 0x1a, 0xfd, 0xb7, 0xe7,
-	0xe5, 0x7d, 0x92, 0xa9, 0x4f, 0x78, 0x8c, 0xc1, 0x6a, 0x7e, 0x8e, 0x96, 0xe4, 0xd7, 0xb0, 0xcc,
-	0xb2, 0x98, 0x45, 0x28, 0x3b, 0x75, 0x83, 0xf4, 0xf0, 0xbb, 0x91, 0x2e, 0xe6, 0xdc, 0x3f, 0xb4,
-	0x18, 0xfb, 0x99, 0x12, 0xa7, 0x41, 0x89, 0xd8, 0xfd, 0x00, 0x9a, 0xd3, 0x02, 0xd2, 0x86, 0x85,
-	0xe7, 0x78, 0x6a, 0xaa, 0xe1, 0x06, 0xfa, 0x48, 0xae, 0x41, 0x7d, 0x4c, 0xd3, 0x11, 0xda, 0xc4,
-	0x07, 0x96, 0xf8, 0xa0, 0xf6, 0xbe, 0xe3, 0xfd, 0x65, 0x01, 0x5a, 0xe7, 0x83, 0xaf, 0x26, 0xd5,
-	0x79, 0xd5, 0x49, 0x25, 0x04, 0x16, 0x27, 0x83, 0x14, 0x98, 0x33, 0x59, 0x83, 0xa5, 0x9c, 0x0a,
-	0xcc, 0x94, 0x2d, 0x52, 0x50, 0x50, 0xb3, 0xba, 0x63, 0xf1, 0x7b, 0xea, 0x8e, 0xfa, 0xeb, 0xe8,
-	0x8e, 0x35, 0x58, 0xfa, 0x2d, 0xb2, 0x61, 0xa2, 0x3a, 0x4b, 0xf6, 0x3b, 0x2c, 0x65, 0xfe, 0x00,
-	0x28, 0x55, 0x18, 0x25, 0x2c, 0x8d, 0x3b, 0xcb, 0x46, 0xe6, 0x6a, 0xce, 0xae, 0x66, 0xe8, 0x69,
-	0x31, 0xe2, 0x18, 0x65, 0x84, 0x59, 0x4c, 0x33, 0xd5, 0x59, 0xb1, 0xd3, 0xa2, 0xd9, 0x7b, 0x15,
-	0xd7, 0xfb, 0x0d, 0x90, 0x3d, 0x7d, 0xf1, 0x3c, 0x41, 0x14, 0x65, 0xdd, 0x25, 0x39, 0x00, 0x57,
-	0x94, 0x44, 0xc7, 0x31, 0x1d, 0x74, 0x7f, 0x5e, 0x07, 0x5d, 0x30, 0x0f, 0x26, 0xb6, 0xde, 0x59,
-	0x1d, 0xae, 0x5e, 0x50, 0x20, 0x3d, 0x78, 0x23, 0x65, 0x52, 0x61, 0xc6, 0xb2, 0x61, 0x48, 0xe3,
-	0x58, 0xa0, 0x2c, 0x1d, 0xb9, 0x01, 0xa9, 0x44, 0x0f, 0x4b, 0x09, 0xd9, 0x01, 0x37, 0x66, 0x02,
-	0x23, 0x7d, 0x61, 0x99, 0x32, 0xb7, 0xfa, 0x6f, 0x4d, 0xe2, 0x41, 0x95, 0xf8, 0xe5, 0xa5, 0xe8,
-	0x6b, 0x47, 0x7b, 0xa5, 0x6e, 0x30, 0x31, 0x23, 0xbf, 0x80, 0x76, 0xc4, 0xb3, 0xcc, 0x52, 0xa1,
-	0xd4, 0xff, 0x74, 0xd3, 0x1b, 0xad, 0xe9, 0x31, 0x3b, 0x07, 0xb5, 0x5b, 0xa9, 0xdb, 0x1b, 0x60,
-	0x35, 0x3a, 0xcf, 0x20, 0xd7, 0x61, 0x39, 0x47, 0x14, 0x21, 0x8b, 0x4d, 0x13, 0xb9, 0xc1, 0x92,
-	0x26, 0x0f, 0x63, 0x3d, 0x12, 0x98, 0x09, 0xd3, 0x01, 0x6e, 0xa0, 0x8f, 0xe4, 0x53, 0x70, 0xad,
-	0x6a, 0x36, 0xe0, 0xa6, 0x94, 0x8d, 0x7e, 0xff, 0xd2, 0x19, 0x35, 0x1f, 0x75, 0x98, 0x0d, 0x78,
-	0xb0, 0x92, 0x17, 0x27, 0xf2, 0x73, 0x68, 0x18, 0x40, 0xfd, 0x21, 0x23, 0x69, 0x3a, 0xa0, 0xd1,
-	0x5f, 0xbf, 0x00, 0x99, 0xf7, 0x73, 0x0d, 0x79, 0x64, 0xb4, 0x02, 0xd0, 0x26, 0xf6, 0x4c, 0x6e,
-	0x43, 0x33, 0xa5, 0x52, 0x85, 0xa3, 0x3c, 0xa6, 0x0a, 0xe3, 0xa2, 0x3f, 0x1a, 0x9a, 0xf7, 0xcc,
-	0xb2, 0xc8, 0x03, 0x00, 0x19, 0x71, 0x81, 0x36, 0x6a, 0xd7, 0xb8, 0xb8, 0x3d, 0x2f, 0xea, 0x23,
-	0xad, 0x69, 0x82, 0x74, 0x65, 0x79, 0xec, 0x9e, 0x39, 0xb0, 0x52, 0x06, 0x4f, 0x3e, 0x84, 0x95,
-	0x13, 0x54, 0x34, 0xa6, 0x8a, 0x9a, 0x69, 0x6f, 0xf4, 0x37, 0xe7, 0xc5, 0xfb, 0x18, 0x15, 0xdd,
-	0xa3, 0x8a, 0x06, 0x95, 0x05, 0xb9, 0x09, 0xae, 0xf9, 0xcd, 0x45, 0x3c, 0x95, 0x9d, 0x9a, 0x69,
-	0x95, 0x09, 0x83, 0x6c, 0x40, 0x63, 0x40, 0x47, 0xa9, 0x0a, 0x23, 0x3e, 0xaa, 0x86, 0x1e, 0x0c,
-	0x6b, 0x57, 0x73, 0xc8, 0x7d, 0x68, 0x97, 0xda, 0xe1, 0x18, 0x85, 0x5e, 0x18, 0x8a, 0xa2, 0xad,
-	0x96, 0xfc, 0xcf, 0x2c, 0x9b, 0xdc, 0x81, 0x1f, 0xd0, 0x21, 0x66, 0xaa, 0xd2, 0xb3, 0x75, 0x6c,
-	0x1a, 0x66, 0xa9, 0x74, 0x1b, 0x9a, 0x26, 0xff, 0x29, 0x55, 0x98, 0x45, 0xa7, 0xc5, 0x78, 0x9a,
-	0x9a, 0x3c, 0xb2, 0x2c, 0xef, 0x5f, 0x0b, 0xe0, 0x56, 0x59, 0xd1, 0xa8, 0x7c, 0x8c, 0x82, 0xa6,
-	0x69, 0x68, 0xf2, 0x63, 0x52, 0x50, 0x0b, 0x9a, 0x05, 0xd3, 0x28, 0x16, 0x51, 0x46, 0xba, 0xeb,
-	0xe3, 0xd0, 0x5c, 0xe8, 0xb2, 0xf8, 0x89, 0xae, 0x56, 0x7c, 0xb3, 0x09, 0x48, 0xf2, 0x63, 0xb8,
-	0x66, 0x77, 0x80, 0x5c, 0xf0, 0x31, 0x8b, 0x75, 0x2b, 0x18, 0xd8, 0x05, 0x03, 0x4b, 0x8c, 0xec,
-	0x49, 0x21, 0xb2, 0xe0, 0xcf, 0xa0, 0xa9, 0x78, 0xce, 0x22, 0xab, 0x58, 0x5e, 0x32, 0xfd, 0xef,
-	0x2c, 0xa8, 0xff, 0x54, 0x5b, 0x19, 0xb2, 0xb8, 0x0b, 0x1a, 0x6a, 0xc2, 0xd1, 0x99, 0x18, 0x72,
-	0x29, 0x59, 0x5e, 0x04, 0x50, 0x37, 0x01, 0x34, 0x2c, 0xcf, 0x7a, 0x7e, 0x17, 0xae, 0x1e, 0x63,
-	0x42, 0xc7, 0x8c, 0x8f, 0x44, 0x98, 0x63, 0x46, 0x53, 0x65, 0x33, 0x56, 0x0b, 0xda, 0x95, 0xe0,
-	0x89, 0xe5, 0xeb, 0x1c, 0x8c, 0x69, 0xca, 0x62, 0xb3, 0x9e, 0x86, 0x28, 0x04, 0x17, 0xa6, 0xbd,
-	0xdd, 0x60, 0x75, 0xc2, 0xdf, 0xd7, 0xec, 0xee, 0xe7, 0xd0, 0xfe, 0x66, 0x6c, 0x33, 0xae, 0xa3,
-	0x07, 0xd3, 0xd7, 0x51, 0xa3, 0xff, 0xce, 0xbc, 0x0f, 0x9e, 0x40, 0x1d, 0x65, 0x34, 0x97, 0x09,
-	0x57, 0xd3, 0x57, 0xd7, 0xff, 0x1c, 0x20, 0x17, 0x35, 0xc8, 0x26, 0x34, 0x15, 0x3b, 0xd1, 0x23,
-	0x12, 0x9e, 0xa0, 0x4c, 0x8a, 0xa5, 0x04, 0x34, 0xef, 0x30, 0x7b, 0x8c, 0x32, 0x21, 0xef, 0x43,
-	0x67, 0xc0, 0x84, 0x54, 0x61, 0xb1, 0x8f, 0x87, 0x31, 0xa6, 0x6c, 0x8c, 0x82, 0xa1, 0xad, 0x6d,
-	0x2d, 0x58, 0x33, 0xf2, 0xc7, 0x56, 0xbc, 0x57, 0x49, 0xc9, 0xcf, 0xe0, 0xba, 0xc6, 0x9c, 0x65,
-	0x68, 0xab, 0xfc, 0xa6, 0x16, 0x5f, 0xb4, 0xfb, 0x10, 0xba, 0x2c, 0x33, 0xb9, 0x9a, 0x65, 0xba,
-	0x68, 0x4c, 0x3b, 0x85, 0xc6, 0x05, 0xeb, 0xfe, 0x3f, 0x96, 0xa1, 0x6e, 0x7e, 0x41, 0xe4, 0x8f,
-	0x0e, 0xb4, 0x0e, 0x50, 0x4d, 0x6d, 0xc1, 0x64, 0x6e, 0xf2, 0x2e, 0xae, 0xca, 0xdd, 0x3b, 0x73,
-	0x3b, 0x6b, 0xb2, 0x9c, 0x7a, 0xb7, 0xff, 0xf0, 0x9f, 0xaf, 0xff, 0x56, 0xbb, 0x41, 0x7e, 0xd8,
-	0x3b, 0xf7, 0xb6, 0x31, 0xaf, 0xa1, 0x9e, 0xf9, 0x4b, 0x93, 0xdf, 0xc1, 0x8a, 0x8e, 0x42, 0x37,
-	0x34, 0x79, 0x6b, 0xae, 0xff, 0xa9, 0xfd, 0xf8, 0x35, 0x78, 0x36, 0xe3, 0x43, 0x7e, 0x0f, 0xab,
-	0x47, 0xa8, 0xa6, 0xb7, 0x5c, 0xf2, 0xee, 0x4b, 0xec, 0xc2, 0xdd, 0x35, 0xdf, 0xbe, 0xaa, 0xfc,
-	0xf2, 0xbd, 0xe4, 0xef, 0xeb, 0x57, 0x95, 0x77, 0xc7, 0xb8, 0xbe, 0xe5, 0xdd, 0x98, 0xe5, 0x3a,
-	0xb5, 0x40, 0xe4, 0xaf, 0x0e, 0x5c, 0x3f, 0x40, 0x35, 0x6b, 0x43, 0x23, 0x73, 0x80, 0xbb, 0x3f,
-	0x7d, 0x95, 0x3d, 0xcf, 0x7b, 0xdb, 0x84, 0xb3, 0x49, 0xd6, 0x67, 0x85, 0x33, 0xe0, 0xe2, 0x79,
-	0x64, 0xbd, 0x0a, 0x70, 0x1f, 0x31, 0xa9, 0xf4, 0x0f, 0x5d, 0xce, 0x0d, 0xe1, 0x9d, 0x4b, 0x5f,
-	0x6b, 0xf2, 0xdb, 0x4b, 0x90, 0x1b, 0x37, 0x5f, 0xc2, 0xb2, 0x4e, 0x02, 0xa2, 0x20, 0xde, 0xb7,
-	0x5c, 0xf9, 0x65, 0xc6, 0x2f, 0xbf, 0xa6, 0x78, 0x9b, 0xc6, 0x79, 0x97, 0x74, 0xe6, 0x39, 0x27,
-	0x7f, 0x77, 0xa0, 0x7d, 0x80, 0xea, 0xdc, 0x0b, 0x93, 0xbc, 0x37, 0xcf, 0xc3, 0xac, 0x27, 0x6f,
-	0x77, 0xeb, 0x92, 0xda, 0x45, 0x4c, 0x3f, 0x32, 0x31, 0x6d, 0x90, 0x5b, 0xb3, 0x62, 0x62, 0xa5,
-	0xc9, 0x4e, 0xf3, 0x9f, 0x2f, 0xd6, 0x9d, 0x7f, 0xbf, 0x58, 0x77, 0xfe, 0xfb, 0x62, 0xdd, 0x39,
-	0x5e, 0x32, 0x15, 0xf8, 0xc9, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x0a, 0x6e, 0xd4, 0x57,
-	0x10, 0x00, 0x00,
+	// 1840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x37, 0x29, 0x51, 0x12, 0x1f, 0x69, 0x92, 0x1e, 0x3b, 0x32, 0x43, 0xdb, 0x92, 0xbc, 0xce,
+	0xd7, 0xb2, 0x13, 0x8b, 0x8c, 0xf8, 0x2d, 0x8a, 0x20, 0x08, 0x50, 0xeb, 0x57, 0x14, 0x15, 0xb6,
+	0xa3, 0xae, 0x6c, 0x03, 0x6d, 0x51, 0x2c, 0x46, 0xbb, 0x4f, 0xe4, 0x44, 0xcb, 0x9d, 0xcd, 0xcc,
+	0x90, 0x96, 0xdc, 0x5b, 0x51, 0xa0, 0xe8, 0xa5, 0x3d, 0x14, 0xe8, 0xa5, 0xff, 0x4b, 0xcf, 0x05,
+	0x7a, 0x29, 0xd0, 0x43, 0x6f, 0x41, 0x61, 0x04, 0xfd, 0x23, 0x7c, 0x2a, 0x66, 0x66, 0x77, 0x49,
+	0x59, 0xdc, 0x44, 0x31, 0xdc, 0xdb, 0xcc, 0xe7, 0xfd, 0xdc, 0xf7, 0xde, 0xbc, 0x79, 0xb3, 0xb0,
+	0x1c, 0x0b, 0xae, 0x78, 0xe7, 0x10, 0xa9, 0xcf, 0xa3, 0x8e, 0x88, 0xfd, 0xce, 0x68, 0xbd, 0x13,
+	0xe0, 0xe1, 0xb0, 0xd7, 0x36, 0x14, 0xb2, 0x88, 0xaa, 0x8f, 0x02, 0x87, 0x83, 0xb6, 0xe5, 0x69,
+	0x8b, 0xd8, 0x6f, 0x8f, 0xd6, 0x5b, 0xd7, 0x51, 0xf5, 0x3b, 0xa3, 0x75, 0x1a, 0xc6, 0x7d, 0xba,
+	0xde, 0x89, 0x78, 0x80, 0x56, 0xa0, 0xe5, 0x9c, 0xd1, 0x18, 0x77, 0x63, 0xad, 0x71, 0x80, 0x52,
+	0xd2, 0x1e, 0xca, 0x84, 0xe7, 0x66, 0x8f, 0xf3, 0x5e, 0x88, 0x1d, 0x1a, 0xb3, 0x0e, 0x8d, 0x22,
+	0xae, 0xa8, 0x62, 0x3c, 0x4a, 0xa9, 0x37, 0x12, 0xaa, 0xd9, 0x1d, 0x0e, 0x8f, 0x3a, 0x38, 0x88,
+	0xd5, 0x69, 0x42, 0x5c, 0xeb, 0x31, 0xd5, 0x1f, 0x1e, 0xb6, 0x7d, 0x3e, 0xe8, 0xf4, 0x78, 0x8f,
+	0x8f, 0xb9, 0xf4, 0xce, 0xda, 0xd6, 0x2b, 0xcb, 0xee, 0xf4, 0xe1, 0xda, 0x5e, 0xe4, 0x87, 0x43,
+	0xc9, 0x78, 0x74, 0x10, 0x72, 0xe5, 0xe2, 0xd7, 0x43, 0x94, 0x8a, 0xd4, 0xa0, 0xc8, 0x82, 0x66,
+	0x61, 0xa5, 0x70, 0x6f, 0xd6, 0x2d, 0xb2, 0x80, 0x3c, 0x84, 0x59, 0x19, 0x72, 0xd5, 0x2c, 0x6a,
+	0x64, 0xf3, 0xc1, 0xeb, 0x6f, 0x96, 0xef, 0x4d, 0x18, 0x8a, 0xc5, 0xa9, 0x1c, 0x50, 0xc5, 0xfc,
+	0x90, 0x1e, 0xca, 0x0e, 0xaa, 0x7e, 0x77, 0x4d, 0x9d, 0xc6, 0x28, 0xdb, 0x46, 0xa5, 0x91, 0x74,
+	0x7e, 0x0e, 0xef, 0xbd, 0x61, 0x49, 0xc6, 0x3c, 0x92, 0xf8, 0x0e, 0x54, 0xf7, 0xe0, 0xfd, 0x0d,
+	0xa5, 0x50, 0xda, 0x30, 0xed, 0x53, 0xff, 0x98, 0x45, 0x3d, 0x17, 0x63, 0x2e, 0x94, 0x24, 0x3f,
+	0x85, 0x79, 0x61, 0x97, 0xcd, 0xc2, 0xca, 0xcc, 0xbd, 0x4a, 0xf7, 0xe3, 0xf6, 0xf4, 0x94, 0xb5,
+	0xf3, 0x74, 0xb8, 0xa9, 0x02, 0xe7, 0x5f, 0x33, 0xd0, 0xcc, 0xe3, 0xca, 0xbe, 0xa3, 0xf0, 0xb6,
+	0xdf, 0x41, 0xda, 0x70, 0xd5, 0xa7, 0x51, 0xc0, 0x02, 0xaa, 0xd0, 0x8b, 0x39, 0x0f, 0x3d, 0xc9,
+	0x5e, 0xa2, 0x0d, 0x8c, 0x7b, 0x25, 0x23, 0xed, 0x73, 0x1e, 0x1e, 0xb0, 0x97, 0x48, 0xee, 0x43,
+	0x63, 0x44, 0x43, 0x16, 0x78, 0x19, 0x49, 0x36, 0x67, 0x0c, 0x73, 0xdd, 0xe0, 0x5b, 0x19, 0x4c,
+	0x3a, 0x70, 0x35, 0xa6, 0xfe, 0x31, 0x06, 0x1e, 0x1d, 0xfb, 0x2f, 0x9b, 0xb3, 0x86, 0x9b, 0x58,
+	0xd2, 0xc4, 0x97, 0x49, 0xf2, 0x00, 0x48, 0x84, 0x2f, 0xbc, 0x11, 0x57, 0x28, 0x3d, 0xa6, 0x13,
+	0x17, 0x60, 0xd0, 0x2c, 0x19, 0xfe, 0x46, 0x84, 0x2f, 0x9e, 0x6b, 0xc2, 0x5e, 0x82, 0x13, 0x07,
+	0x2e, 0x0f, 0xe8, 0x89, 0x97, 0x49, 0x34, 0xe7, 0x0c, 0x63, 0x65, 0x40, 0x4f, 0x9e, 0x24, 0xbc,
+	0x64, 0x15, 0xea, 0x78, 0x12, 0xa3, 0xaf, 0x30, 0xf0, 0x04, 0xbe, 0xa0, 0x22, 0x68, 0xce, 0x1b,
+	0xae, 0x5a, 0x0a, 0xbb, 0x06, 0xd5, 0x61, 0xd0, 0xca, 0xde, 0x64, 0x5e, 0xb0, 0x61, 0x18, 0xd0,
+	0x93, 0x9d, 0xb3, 0xfc, 0x77, 0xa1, 0x1e, 0xdb, 0x4c, 0x78, 0x8a, 0x0d, 0xd0, 0x1b, 0xc8, 0x66,
+	0xd9, 0xf0, 0x5e, 0x4e, 0xe0, 0xa7, 0x6c, 0x80, 0x8f, 0x25, 0x69, 0xc1, 0x82, 0x54, 0x82, 0x2a,
+	0xec, 0x9d, 0x36, 0x61, 0xa5, 0x70, 0xaf, 0xec, 0x66, 0x7b, 0xe7, 0xf7, 0x05, 0x20, 0x9b, 0xa6,
+	0x1a, 0x0e, 0x14, 0x55, 0x98, 0x1e, 0x83, 0xcd, 0xb7, 0xcf, 0xe9, 0x17, 0x97, 0x92, 0xac, 0x2e,
+	0x03, 0x1c, 0x86, 0xdc, 0x3f, 0xf6, 0x04, 0x4f, 0xaa, 0xbc, 0xfa, 0xc5, 0x25, 0xb7, 0x6c, 0x30,
+	0x97, 0x73, 0xb5, 0x59, 0x83, 0xea, 0xd7, 0x43, 0x14, 0xa7, 0xde, 0x11, 0x0b, 0x15, 0x0a, 0x67,
+	0x0d, 0xaa, 0x9b, 0x86, 0x98, 0x38, 0x71, 0xeb, 0x8c, 0x02, 0xed, 0x4a, 0x75, 0x42, 0xdc, 0x59,
+	0x85, 0xca, 0xc1, 0xc1, 0x2f, 0xb2, 0xe3, 0xd4, 0x84, 0x79, 0x8c, 0x7c, 0xae, 0xb3, 0x65, 0x59,
+	0xd3, 0xad, 0xf3, 0xbb, 0x02, 0x5c, 0x7d, 0xc4, 0x7b, 0x3d, 0x16, 0xf5, 0x1e, 0xe1, 0x08, 0xc3,
+	0x54, 0xff, 0x2e, 0x94, 0x42, 0xbd, 0x37, 0xfc, 0xb5, 0xee, 0x7a, 0xde, 0xf9, 0x98, 0x22, 0xdb,
+	0xb6, 0x1b, 0x2b, 0xef, 0xac, 0x42, 0xc9, 0xec, 0xc9, 0x02, 0xcc, 0xee, 0x3d, 0xf9, 0xfc, 0xcb,
+	0xc6, 0x25, 0x52, 0x86, 0xd2, 0xf6, 0xce, 0xe6, 0xb3, 0xdd, 0x46, 0x41, 0x2f, 0x9f, 0xba, 0x1b,
+	0x5b, 0x3b, 0x8d, 0xa2, 0xf3, 0xed, 0x0c, 0xdc, 0xdc, 0x17, 0x5c, 0xf1, 0x0d, 0x21, 0xe8, 0xe9,
+	0xe7, 0x5c, 0x1c, 0x6f, 0xf5, 0x39, 0xf3, 0x31, 0xfb, 0x88, 0x55, 0xa8, 0xc7, 0x62, 0x18, 0xa1,
+	0xa7, 0xfa, 0x02, 0x65, 0x9f, 0x87, 0x69, 0x2f, 0xaa, 0x19, 0xf8, 0x69, 0x8a, 0x92, 0xe7, 0x50,
+	0xff, 0x6a, 0x28, 0x15, 0x3b, 0x62, 0x18, 0x78, 0x18, 0x73, 0xbf, 0x9f, 0xf4, 0x91, 0xb5, 0xd7,
+	0xdf, 0x2c, 0xdf, 0xbf, 0x48, 0xae, 0x76, 0xb4, 0x90, 0x5b, 0xcb, 0xb4, 0x98, 0xbd, 0xd6, 0x7b,
+	0xc4, 0x22, 0x1a, 0xb2, 0x97, 0x99, 0xde, 0x99, 0xb7, 0xd2, 0x9b, 0x69, 0xb1, 0x7a, 0x5d, 0xb8,
+	0x62, 0x1a, 0xaf, 0x47, 0xf5, 0x97, 0x7b, 0xfa, 0x5e, 0xd0, 0xa7, 0x50, 0xf7, 0xa5, 0xbb, 0x79,
+	0x71, 0x1f, 0x47, 0xea, 0x09, 0x0f, 0xd0, 0xad, 0xc7, 0x67, 0xf6, 0x92, 0xfc, 0x12, 0xe6, 0x59,
+	0x14, 0x30, 0x1f, 0x65, 0xb3, 0x64, 0x34, 0x6d, 0x7c, 0xbf, 0xa6, 0xf3, 0x31, 0x6f, 0xef, 0x59,
+	0x1d, 0x3b, 0x91, 0x12, 0xa7, 0x6e, 0xaa, 0xb1, 0xf5, 0x29, 0x54, 0x27, 0x09, 0xa4, 0x01, 0x33,
+	0xc7, 0x78, 0x6a, 0xb2, 0x51, 0x76, 0xf5, 0x92, 0x5c, 0x83, 0xd2, 0x88, 0x86, 0xc3, 0xb4, 0x4f,
+	0xd9, 0xcd, 0xa7, 0xc5, 0x4f, 0x0a, 0xce, 0x1f, 0x66, 0xa0, 0x76, 0xd6, 0xf9, 0x77, 0xd0, 0x24,
+	0x09, 0xcc, 0x8e, 0x0f, 0x92, 0x6b, 0xd6, 0x64, 0x11, 0xe6, 0x62, 0x2a, 0x30, 0x52, 0x49, 0xfb,
+	0x4b, 0x76, 0xd3, 0xaa, 0x63, 0xf6, 0x7f, 0x54, 0x1d, 0xa5, 0x77, 0x51, 0x1d, 0x8b, 0x30, 0xf7,
+	0x02, 0x59, 0xaf, 0xaf, 0x92, 0xfe, 0x99, 0xec, 0x4c, 0x07, 0x40, 0xa9, 0x3c, 0xbf, 0xcf, 0xc2,
+	0xb4, 0x6b, 0x96, 0x35, 0xb2, 0xa5, 0x01, 0x7d, 0x5a, 0x0c, 0x39, 0x40, 0xe9, 0x63, 0x14, 0xd0,
+	0x48, 0x25, 0xcd, 0xb2, 0xa6, 0xe1, 0xed, 0x0c, 0x75, 0x7e, 0x05, 0x64, 0x5b, 0xcf, 0x2e, 0xfb,
+	0x88, 0x22, 0xcd, 0xbb, 0x24, 0xbb, 0x50, 0x16, 0xe9, 0x26, 0xb9, 0x23, 0xef, 0xe7, 0x55, 0xd0,
+	0x39, 0x71, 0x77, 0x2c, 0xeb, 0xbc, 0x2e, 0xc1, 0x95, 0x73, 0x0c, 0xfa, 0xea, 0x09, 0x99, 0x54,
+	0x18, 0xe9, 0x06, 0x4d, 0x83, 0x40, 0xa0, 0x4c, 0x0d, 0x95, 0x5d, 0x92, 0x91, 0x36, 0x52, 0x0a,
+	0xd9, 0x84, 0x72, 0xc0, 0x04, 0xfa, 0xfa, 0x22, 0x32, 0x69, 0xae, 0x75, 0x3f, 0x18, 0xfb, 0x83,
+	0xaa, 0xdf, 0x4e, 0xe7, 0xaa, 0xb6, 0x36, 0xb4, 0x9d, 0xf2, 0xba, 0x63, 0x31, 0xf2, 0x33, 0x68,
+	0xf8, 0x3c, 0x8a, 0xec, 0xce, 0xd3, 0xb7, 0x1a, 0x9a, 0xda, 0xa8, 0x75, 0xef, 0xe6, 0xa8, 0xda,
+	0xca, 0xd8, 0xed, 0x0d, 0x50, 0xf7, 0xcf, 0x02, 0xe4, 0x3a, 0xcc, 0xc7, 0x88, 0xc2, 0x63, 0x81,
+	0x29, 0xa2, 0xb2, 0x3b, 0xa7, 0xb7, 0x7b, 0x81, 0x3e, 0x12, 0x18, 0x09, 0x53, 0x01, 0x65, 0x57,
+	0x2f, 0xc9, 0x97, 0x50, 0xb6, 0xac, 0xd1, 0x11, 0x37, 0xa9, 0xac, 0x74, 0xbb, 0x17, 0x8e, 0xa8,
+	0xf9, 0xa8, 0xbd, 0xe8, 0x88, 0xbb, 0x0b, 0x71, 0xb2, 0x22, 0x3f, 0x81, 0x8a, 0x51, 0xa8, 0x3f,
+	0x64, 0x28, 0x4d, 0x05, 0x54, 0xba, 0x4b, 0xe7, 0x54, 0xc6, 0xdd, 0x58, 0xab, 0x3c, 0x30, 0x5c,
+	0x2e, 0x68, 0x11, 0xbb, 0x26, 0xb7, 0xa1, 0x1a, 0x52, 0xa9, 0xbc, 0x61, 0xac, 0xe7, 0x81, 0xf4,
+	0x32, 0xad, 0x68, 0xec, 0x99, 0x85, 0xc8, 0x43, 0x00, 0xe9, 0x73, 0x81, 0xd6, 0xeb, 0xb2, 0x31,
+	0x71, 0x3b, 0xcf, 0xeb, 0x03, 0xcd, 0x69, 0x9c, 0x2c, 0xcb, 0x74, 0xd9, 0x7a, 0x5d, 0x80, 0x85,
+	0xd4, 0x79, 0xf2, 0x19, 0x2c, 0x0c, 0x50, 0xd1, 0x80, 0x2a, 0x6a, 0x4e, 0x7b, 0xa5, 0xbb, 0x92,
+	0xe7, 0xef, 0x63, 0x54, 0x74, 0x9b, 0x2a, 0xea, 0x66, 0x12, 0xe4, 0x26, 0x94, 0x4d, 0x9b, 0xf3,
+	0x79, 0x28, 0x9b, 0x45, 0x53, 0x2a, 0x63, 0x80, 0x2c, 0x43, 0xe5, 0x88, 0x0e, 0x43, 0xe5, 0xf9,
+	0x7c, 0x98, 0x1d, 0x7a, 0x30, 0xd0, 0x96, 0x46, 0xf4, 0x64, 0x94, 0x72, 0x7b, 0x23, 0x14, 0x7a,
+	0xe6, 0x4c, 0x92, 0x56, 0x4f, 0xf1, 0xe7, 0x16, 0x26, 0x77, 0xe0, 0x32, 0xed, 0x61, 0xa4, 0x32,
+	0x3e, 0x9b, 0xc7, 0xaa, 0x01, 0x53, 0xa6, 0xdb, 0x50, 0x35, 0xf1, 0x0f, 0xa9, 0xc2, 0xc8, 0x3f,
+	0x4d, 0xc7, 0x1b, 0x8d, 0x3d, 0xb2, 0x90, 0xf3, 0xf7, 0x19, 0x28, 0x67, 0x51, 0xd1, 0x5a, 0xf9,
+	0x08, 0x05, 0x0d, 0x43, 0xcf, 0xc4, 0xc7, 0x84, 0xa0, 0xe8, 0x56, 0x13, 0xd0, 0x30, 0x26, 0x5e,
+	0xfa, 0xba, 0xea, 0x03, 0xcf, 0x5c, 0xe8, 0x32, 0x69, 0xa2, 0xf5, 0x0c, 0x37, 0x93, 0x80, 0x24,
+	0x1f, 0xc3, 0x35, 0x3b, 0x03, 0xc4, 0x82, 0x8f, 0x58, 0xa0, 0x4b, 0xc1, 0xa8, 0x9d, 0x31, 0x6a,
+	0x89, 0xa1, 0xed, 0x27, 0x24, 0xab, 0xfc, 0x19, 0x54, 0x15, 0x8f, 0x99, 0x6f, 0x19, 0xd3, 0x4b,
+	0xa6, 0xfb, 0xbd, 0x09, 0x6d, 0x3f, 0xd5, 0x52, 0x66, 0x9b, 0xdc, 0x05, 0x15, 0x35, 0x46, 0x74,
+	0x24, 0x7a, 0x5c, 0x4a, 0x16, 0x27, 0x0e, 0x94, 0x8c, 0x03, 0x15, 0x8b, 0x59, 0xcb, 0x1f, 0xc1,
+	0x95, 0x43, 0xec, 0xd3, 0x11, 0xe3, 0x43, 0xe1, 0xc5, 0x18, 0xd1, 0x50, 0xd9, 0x88, 0x15, 0xdd,
+	0x46, 0x46, 0xd8, 0xb7, 0x78, 0x36, 0xc3, 0x9a, 0xb1, 0xd3, 0x43, 0x21, 0xb8, 0x30, 0xe5, 0x5d,
+	0x76, 0xeb, 0x63, 0x7c, 0x47, 0xc3, 0xad, 0xaf, 0xa0, 0xf1, 0xa6, 0x6f, 0x53, 0xae, 0xa3, 0x87,
+	0x93, 0xd7, 0x51, 0xa5, 0xfb, 0x61, 0xde, 0x07, 0x8f, 0x55, 0x1d, 0x44, 0x34, 0x96, 0x7d, 0xae,
+	0x26, 0xaf, 0xae, 0xff, 0x14, 0x80, 0x9c, 0xe7, 0x20, 0x2b, 0x50, 0x35, 0x23, 0x26, 0x8b, 0xbc,
+	0x01, 0xca, 0x7e, 0x32, 0x94, 0x80, 0xc6, 0xf6, 0xa2, 0xc7, 0x28, 0xfb, 0xe4, 0x13, 0x68, 0x1e,
+	0x31, 0x21, 0x95, 0x97, 0x3c, 0xe9, 0xbc, 0x00, 0x43, 0x36, 0x42, 0xc1, 0xd0, 0xe6, 0xb6, 0xe8,
+	0x2e, 0x1a, 0xfa, 0x63, 0x4b, 0xde, 0xce, 0xa8, 0xe4, 0xc7, 0x70, 0x5d, 0xeb, 0x9c, 0x26, 0x68,
+	0xb3, 0xfc, 0x9e, 0x26, 0x9f, 0x97, 0xfb, 0x0c, 0x5a, 0x2c, 0xb2, 0xef, 0x80, 0x29, 0xa2, 0xb3,
+	0x46, 0xb4, 0x99, 0x70, 0x9c, 0x93, 0xee, 0xfe, 0x75, 0x01, 0x4a, 0xa6, 0x05, 0x91, 0xdf, 0x16,
+	0xa0, 0xb6, 0x8b, 0x6a, 0x62, 0x0a, 0x26, 0xb9, 0xc1, 0x3b, 0x3f, 0x2a, 0xb7, 0xee, 0xe4, 0x56,
+	0xd6, 0x78, 0x38, 0x75, 0x6e, 0xff, 0xe6, 0x9f, 0xdf, 0xfe, 0xa9, 0x78, 0x83, 0xbc, 0xdf, 0x39,
+	0xf3, 0x3c, 0x36, 0x0f, 0xea, 0x8e, 0xe9, 0xd2, 0xe4, 0x04, 0x16, 0xb4, 0x17, 0xba, 0xa0, 0xc9,
+	0x07, 0xb9, 0xf6, 0x27, 0xe6, 0xe3, 0x77, 0x60, 0xd9, 0x1c, 0x1f, 0xf2, 0x6b, 0xa8, 0x1f, 0xa0,
+	0x9a, 0x9c, 0x72, 0xc9, 0x47, 0x3f, 0x60, 0x16, 0x6e, 0x2d, 0xb6, 0xed, 0xc3, 0xbc, 0x9d, 0x3e,
+	0xb9, 0xdb, 0x3b, 0xfa, 0x61, 0xee, 0xdc, 0x31, 0xa6, 0x6f, 0x39, 0x37, 0xa6, 0x99, 0x0e, 0xad,
+	0x22, 0xf2, 0xc7, 0x02, 0x5c, 0xdf, 0x45, 0x35, 0x6d, 0x42, 0x23, 0x39, 0x8a, 0x5b, 0x3f, 0x7a,
+	0x9b, 0x39, 0xcf, 0xb9, 0x6b, 0xdc, 0x59, 0x21, 0x4b, 0xd3, 0xdc, 0x39, 0xe2, 0xe2, 0xd8, 0xb7,
+	0x56, 0x05, 0x94, 0x1f, 0x31, 0xa9, 0x74, 0x43, 0x97, 0xb9, 0x2e, 0x7c, 0x78, 0xe1, 0x6b, 0x4d,
+	0x7e, 0x77, 0x0a, 0x62, 0x63, 0xe6, 0x25, 0xcc, 0xeb, 0x20, 0x20, 0x0a, 0xe2, 0x7c, 0xc7, 0x95,
+	0x9f, 0x46, 0xfc, 0xe2, 0x63, 0x8a, 0xb3, 0x62, 0x8c, 0xb7, 0x48, 0x33, 0xcf, 0x38, 0xf9, 0x73,
+	0x01, 0x1a, 0xbb, 0xa8, 0xce, 0xfc, 0xa4, 0x20, 0x0f, 0xf2, 0x2c, 0x4c, 0xfb, 0x6b, 0xd2, 0x5a,
+	0xbb, 0x20, 0x77, 0xe2, 0xd3, 0xff, 0x19, 0x9f, 0x96, 0xc9, 0xad, 0x69, 0x3e, 0xb1, 0x54, 0x84,
+	0xfc, 0xa5, 0x00, 0xb7, 0x74, 0x26, 0xf2, 0xff, 0x71, 0xe4, 0x65, 0x67, 0xfd, 0x87, 0xfe, 0xea,
+	0x90, 0x4e, 0xc7, 0xf8, 0x74, 0x9f, 0xac, 0x4e, 0xf3, 0x69, 0xe2, 0xdf, 0x81, 0x97, 0xbc, 0xad,
+	0x37, 0xab, 0x7f, 0x7b, 0xb5, 0x54, 0xf8, 0xc7, 0xab, 0xa5, 0xc2, 0xbf, 0x5f, 0x2d, 0x15, 0x0e,
+	0xe7, 0x8c, 0x07, 0xff, 0xff, 0xdf, 0x01, 0x00, 0x5e, 0xf8, 0x28, 0x7e, 0x38, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1122,13 +1362,22 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DebugClient interface {
+	// Returns a beacon state by filter criteria from the beacon node.
 	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	// Returns a beacon state by filter criteria from the beacon node.
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	// SetLoggingLevel sets the log-level of the beacon node programmatically.
 	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Returns a proto array fork choice object from the beacon node.
 	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	// Returns all the related data for every peer tracked by the host node.
 	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	// Returns requested peer with specified peer id if it exists.
 	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	// Returns the inclusion slot of a given attester id and slot.
 	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	// Returns quality reports for the attestations packed into the most recent block proposals.
+	ListAttestationPackingReports(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPackingReports, error)
 }
 
 type debugClient struct {
@@ -1202,15 +1451,33 @@ func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotReq
 	return out, nil
 }
 
+func (c *debugClient) ListAttestationPackingReports(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPackingReports, error) {
+	out := new(AttestationPackingReports)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListAttestationPackingReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
+	// Returns a beacon state by filter criteria from the beacon node.
 	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	// Returns a beacon state by filter criteria from the beacon node.
 	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	// SetLoggingLevel sets the log-level of the beacon node programmatically.
 	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
+	// Returns a proto array fork choice object from the beacon node.
 	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	// Returns all the related data for every peer tracked by the host node.
 	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
+	// Returns requested peer with specified peer id if it exists.
 	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	// Returns the inclusion slot of a given attester id and slot.
 	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	// Returns quality reports for the attestations packed into the most recent block proposals.
+	ListAttestationPackingReports(context.Context, *types.Empty) (*AttestationPackingReports, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -1238,6 +1505,9 @@ func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.Peer
 func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
 }
+func (*UnimplementedDebugServer) ListAttestationPackingReports(ctx context.Context, req *types.Empty) (*AttestationPackingReports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationPackingReports not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -1369,6 +1639,24 @@ func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_ListAttestationPackingReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListAttestationPackingReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListAttestationPackingReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListAttestationPackingReports(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -1401,6 +1689,10 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInclusionSlot",
 			Handler:    _Debug_GetInclusionSlot_Handler,
 		},
+		{
+			MethodName: "ListAttestationPackingReports",
+			Handler:    _Debug_ListAttestationPackingReports_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/debug.proto",
@@ -1475,6 +1767,126 @@ func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationPackingReports) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPackingReports) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPackingReports) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationPackingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPackingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPackingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PackingTimeMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PackingTimeMs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxExpectedReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MaxExpectedReward))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpectedReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ExpectedReward))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxNewVotes != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MaxNewVotes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NewVotesIncluded != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewVotesIncluded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PackedAttestations != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PackedAttestations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidCandidates != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidCandidates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CandidatePoolSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.CandidatePoolSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2178,14 +2590,17 @@ func (m *InclusionSlotResponse) Size() (n int) {
 	return n
 }
 
-func (m *BeaconStateRequest) Size() (n int) {
+func (m *AttestationPackingReports) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2193,13 +2608,71 @@ func (m *BeaconStateRequest) Size() (n int) {
 	return n
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
+func (m *AttestationPackingReport) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.CandidatePoolSize != 0 {
+		n += 1 + sovDebug(uint64(m.CandidatePoolSize))
+	}
+	if m.ValidCandidates != 0 {
+		n += 1 + sovDebug(uint64(m.ValidCandidates))
+	}
+	if m.PackedAttestations != 0 {
+		n += 1 + sovDebug(uint64(m.PackedAttestations))
+	}
+	if m.NewVotesIncluded != 0 {
+		n += 1 + sovDebug(uint64(m.NewVotesIncluded))
+	}
+	if m.MaxNewVotes != 0 {
+		n += 1 + sovDebug(uint64(m.MaxNewVotes))
+	}
+	if m.ExpectedReward != 0 {
+		n += 1 + sovDebug(uint64(m.ExpectedReward))
+	}
+	if m.MaxExpectedReward != 0 {
+		n += 1 + sovDebug(uint64(m.MaxExpectedReward))
+	}
+	if m.PackingTimeMs != 0 {
+		n += 1 + sovDebug(uint64(m.PackingTimeMs))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
 	return n
 }
 func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
@@ -2667,6 +3140,345 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationPackingReports) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPackingReports: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPackingReports: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &AttestationPackingReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationPackingReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPackingReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPackingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidatePoolSize", wireType)
+			}
+			m.CandidatePoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandidatePoolSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidCandidates", wireType)
+			}
+			m.ValidCandidates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidCandidates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedAttestations", wireType)
+			}
+			m.PackedAttestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackedAttestations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewVotesIncluded", wireType)
+			}
+			m.NewVotesIncluded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewVotesIncluded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNewVotes", wireType)
+			}
+			m.MaxNewVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNewVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedReward", wireType)
+			}
+			m.ExpectedReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpectedReward", wireType)
+			}
+			m.MaxExpectedReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpectedReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackingTimeMs", wireType)
+			}
+			m.PackingTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackingTimeMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
 	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
@@ -31,6 +32,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SlashingsRequest_Status int32
+
+const (
+	// Return slashings regardless of their inclusion status.
+	SlashingsRequest_ALL SlashingsRequest_Status = 0
+	// Return slashings which have not yet been included in a block.
+	SlashingsRequest_PENDING SlashingsRequest_Status = 1
+	// Return slashings which have been included in a block.
+	SlashingsRequest_INCLUDED SlashingsRequest_Status = 2
+)
+
+var SlashingsRequest_Status_name = map[int32]string{
+	0: "ALL",
+	1: "PENDING",
+	2: "INCLUDED",
+}
+
+var SlashingsRequest_Status_value = map[string]int32{
+	"ALL":      0,
+	"PENDING":  1,
+	"INCLUDED": 2,
+}
+
+func (x SlashingsRequest_Status) String() string {
+	return proto.EnumName(SlashingsRequest_Status_name, int32(x))
+}
+
+func (SlashingsRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{3, 0}
+}
+
 type HighestAttestationRequest struct {
 	ValidatorIds         []uint64 `protobuf:"varint,1,rep,packed,name=validator_ids,json=validatorIds,proto3" json:"validator_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -188,6 +220,80 @@ func (m *HighestAttestation) GetHighestTargetEpoch() github_com_prysmaticlabs_et
 	return 0
 }
 
+type SlashingsRequest struct {
+	// Only return slashings involving any of these validator indices. Empty means all validators.
+	ValidatorIndices []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_indices,omitempty"`
+	// Only return slashings for offenses at or after this epoch.
+	StartEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"start_epoch,omitempty"`
+	// Only return slashings for offenses at or before this epoch. Zero means no upper bound.
+	EndEpoch             github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"end_epoch,omitempty"`
+	Status               SlashingsRequest_Status                   `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.slashing.SlashingsRequest_Status" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *SlashingsRequest) Reset()         { *m = SlashingsRequest{} }
+func (m *SlashingsRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingsRequest) ProtoMessage()    {}
+func (*SlashingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{3}
+}
+func (m *SlashingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingsRequest.Merge(m, src)
+}
+func (m *SlashingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingsRequest proto.InternalMessageInfo
+
+func (m *SlashingsRequest) GetValidatorIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *SlashingsRequest) GetStartEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *SlashingsRequest) GetEndEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *SlashingsRequest) GetStatus() SlashingsRequest_Status {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingsRequest_ALL
+}
+
 type ProposerSlashingResponse struct {
 	ProposerSlashing     []*v1alpha1.ProposerSlashing `protobuf:"bytes,1,rep,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
@@ -199,7 +305,7 @@ func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingRespon
 func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingResponse) ProtoMessage()    {}
 func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{3}
+	return fileDescriptor_da7e95107d0081b4, []int{4}
 }
 func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +352,7 @@ func (m *Slashable) Reset()         { *m = Slashable{} }
 func (m *Slashable) String() string { return proto.CompactTextString(m) }
 func (*Slashable) ProtoMessage()    {}
 func (*Slashable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{4}
+	return fileDescriptor_da7e95107d0081b4, []int{5}
 }
 func (m *Slashable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +399,7 @@ func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingRespon
 func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingResponse) ProtoMessage()    {}
 func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{5}
+	return fileDescriptor_da7e95107d0081b4, []int{6}
 }
 func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,6 +435,10 @@ func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSla
 	return nil
 }
 
+// ProposalHistory defines the structure for recording a validator's historical proposals.
+// Using a bitlist to represent the epochs and an uint64 to mark the latest marked
+// epoch of the bitlist, we can easily store which epochs a validator has proposed
+// a block for while pruning the older data.
 type ProposalHistory struct {
 	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
 	LatestEpochWritten   github_com_prysmaticlabs_eth2_types.Epoch    `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"latest_epoch_written,omitempty"`
@@ -341,7 +451,7 @@ func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
 func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
 func (*ProposalHistory) ProtoMessage()    {}
 func (*ProposalHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6}
+	return fileDescriptor_da7e95107d0081b4, []int{7}
 }
 func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +494,11 @@ func (m *ProposalHistory) GetLatestEpochWritten() github_com_prysmaticlabs_eth2_
 	return 0
 }
 
+// AttestationHistory defines the structure for recording a validator's historical attestation.
+// Using a map[uint64]uint64 to map its target epoch to its source epoch, in order to detect if a
+// vote being created is not a double vote and surrounded by, or surrounding any other votes.
+// Using an uint64 to mark the latest written epoch, we can safely perform a rolling prune whenever
+// the history is updated.
 type AttestationHistory struct {
 	TargetToSource       map[uint64]uint64                         `protobuf:"bytes,1,rep,name=target_to_source,json=targetToSource,proto3" json:"target_to_source,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	LatestEpochWritten   github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"latest_epoch_written,omitempty"`
@@ -396,7 +511,7 @@ func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
 func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
 func (*AttestationHistory) ProtoMessage()    {}
 func (*AttestationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{7}
+	return fileDescriptor_da7e95107d0081b4, []int{8}
 }
 func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,9 +555,11 @@ func (m *AttestationHistory) GetLatestEpochWritten() github_com_prysmaticlabs_et
 }
 
 func init() {
+	proto.RegisterEnum("ethereum.slashing.SlashingsRequest_Status", SlashingsRequest_Status_name, SlashingsRequest_Status_value)
 	proto.RegisterType((*HighestAttestationRequest)(nil), "ethereum.slashing.HighestAttestationRequest")
 	proto.RegisterType((*HighestAttestationResponse)(nil), "ethereum.slashing.HighestAttestationResponse")
 	proto.RegisterType((*HighestAttestation)(nil), "ethereum.slashing.HighestAttestation")
+	proto.RegisterType((*SlashingsRequest)(nil), "ethereum.slashing.SlashingsRequest")
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*Slashable)(nil), "ethereum.slashing.Slashable")
 	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
@@ -454,50 +571,63 @@ func init() {
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0xdb, 0x7e, 0x3f, 0x9d, 0xe6, 0xfb, 0x48, 0xa7, 0x15, 0x0a, 0x51, 0x49, 0x4b, 0x10,
-	0xa2, 0x15, 0x8d, 0x43, 0xcb, 0x06, 0x58, 0x51, 0x4b, 0x48, 0xcd, 0x06, 0x90, 0x5b, 0xc4, 0xd2,
-	0x1a, 0xdb, 0xb7, 0xf6, 0xa8, 0xae, 0xc7, 0xcc, 0xdc, 0x14, 0xf2, 0x30, 0x3c, 0x03, 0x0f, 0xc1,
-	0x86, 0x25, 0x4f, 0x50, 0xa1, 0xbe, 0x05, 0x5d, 0x21, 0xcf, 0x38, 0x89, 0x5b, 0x3b, 0xa8, 0x80,
-	0xd8, 0xcd, 0x5c, 0xdf, 0x39, 0xe7, 0xde, 0x33, 0xe7, 0x8e, 0xc9, 0xed, 0x4c, 0x0a, 0x14, 0x7d,
-	0x95, 0x30, 0x15, 0xf3, 0x34, 0x9a, 0x2c, 0x6c, 0x1d, 0xa7, 0xcb, 0x80, 0x31, 0x48, 0x18, 0x9e,
-	0xd8, 0xe3, 0x0f, 0xed, 0x75, 0xc0, 0xb8, 0x7f, 0xba, 0xc3, 0x92, 0x2c, 0x66, 0x3b, 0x7d, 0x1f,
-	0x58, 0x20, 0x52, 0xcf, 0x4f, 0x44, 0x70, 0x6c, 0xce, 0xb4, 0x7b, 0x11, 0xc7, 0x78, 0xe8, 0xdb,
-	0x81, 0x38, 0xe9, 0x47, 0x22, 0x12, 0x7d, 0x1d, 0xf6, 0x87, 0x47, 0x7a, 0x67, 0xf8, 0xf2, 0x95,
-	0x49, 0xef, 0x3e, 0x23, 0xb7, 0xf6, 0x79, 0x14, 0x83, 0xc2, 0x3d, 0x44, 0x50, 0xc8, 0x90, 0x8b,
-	0xd4, 0x85, 0xb7, 0x43, 0x50, 0x48, 0xef, 0x92, 0xff, 0x4e, 0x59, 0xc2, 0x43, 0x86, 0x42, 0x7a,
-	0x3c, 0x54, 0x2d, 0x6b, 0x63, 0x7e, 0x73, 0xc1, 0x6d, 0x4c, 0x82, 0x83, 0x50, 0x75, 0x23, 0xd2,
-	0xae, 0x43, 0x50, 0x99, 0x48, 0x15, 0xd0, 0x01, 0x69, 0xb0, 0x69, 0xd8, 0x20, 0x2c, 0xed, 0xde,
-	0xb3, 0x2b, 0x9d, 0xd9, 0x35, 0x20, 0x97, 0x8e, 0x76, 0xbf, 0x59, 0x84, 0x56, 0x93, 0xe8, 0x1d,
-	0xd2, 0x28, 0x17, 0xd9, 0xb2, 0x36, 0xac, 0xcd, 0x05, 0x77, 0xa9, 0x54, 0x23, 0xf5, 0xc8, 0x6a,
-	0x6c, 0x0e, 0x7a, 0x4a, 0x0c, 0x65, 0x00, 0x1e, 0x64, 0x22, 0x88, 0x5b, 0x73, 0x79, 0xaa, 0xd3,
-	0xbb, 0x38, 0x5b, 0xdf, 0x2a, 0xa9, 0x96, 0xc9, 0x91, 0x3a, 0x61, 0xc8, 0x83, 0x84, 0xf9, 0xaa,
-	0x0f, 0x18, 0xef, 0xf6, 0x70, 0x94, 0x81, 0xb2, 0x9f, 0xe7, 0x87, 0x5c, 0x5a, 0x40, 0x1d, 0x68,
-	0x24, 0x1d, 0x2b, 0x13, 0x20, 0x93, 0x11, 0x60, 0x41, 0x30, 0xff, 0x3b, 0x04, 0x87, 0x1a, 0x49,
-	0xc7, 0xba, 0x19, 0x69, 0xbd, 0x92, 0x22, 0x13, 0x0a, 0xe4, 0x41, 0x21, 0xd8, 0x44, 0xe2, 0x43,
-	0xb2, 0x9c, 0x15, 0xdf, 0xbc, 0xb1, 0x9a, 0x85, 0xce, 0xf7, 0xa7, 0x3a, 0x03, 0xc6, 0xf6, 0xd8,
-	0x37, 0x76, 0x05, 0xab, 0x99, 0x5d, 0x89, 0x74, 0xb7, 0xc8, 0xa2, 0x5e, 0x33, 0x3f, 0x01, 0xba,
-	0x46, 0x16, 0xd5, 0x78, 0xa3, 0x05, 0xfe, 0xd7, 0x9d, 0x06, 0xf2, 0xe2, 0xcc, 0x85, 0xd4, 0x17,
-	0xc7, 0x8a, 0x6f, 0xd7, 0x2d, 0xae, 0x82, 0xd5, 0x64, 0x57, 0x22, 0xdd, 0x4f, 0x16, 0xb9, 0x61,
-	0x7a, 0x60, 0xc9, 0x3e, 0x57, 0x28, 0xe4, 0x88, 0xbe, 0x24, 0x44, 0x8b, 0xee, 0xf9, 0x1c, 0x95,
-	0x2e, 0xb2, 0xe1, 0x3c, 0xbc, 0x38, 0x5b, 0xdf, 0x9e, 0xa9, 0x7c, 0x24, 0x7a, 0x3e, 0xc7, 0x23,
-	0x0e, 0x49, 0x68, 0x3b, 0x1c, 0x13, 0xae, 0xd0, 0x5d, 0xd4, 0x18, 0x0e, 0x47, 0x95, 0x5f, 0x6a,
-	0xc2, 0x72, 0x62, 0x73, 0x99, 0xde, 0x3b, 0xc9, 0x11, 0x21, 0xfd, 0x45, 0xd7, 0x18, 0x28, 0xbd,
-	0x79, 0x63, 0x80, 0xba, 0x1f, 0xe6, 0x08, 0x2d, 0x39, 0x79, 0xdc, 0x48, 0x40, 0x9a, 0x85, 0x89,
-	0x50, 0x14, 0x7e, 0x2d, 0x14, 0x7b, 0x52, 0x33, 0x36, 0x55, 0x00, 0xdb, 0x18, 0xe7, 0x50, 0x14,
-	0x0e, 0x4d, 0x51, 0x8e, 0xdc, 0xff, 0xf1, 0x52, 0xf0, 0x8f, 0x37, 0xd7, 0xde, 0x23, 0x2b, 0x35,
-	0x75, 0xd0, 0x26, 0x99, 0x3f, 0x86, 0x51, 0x31, 0xa4, 0xf9, 0x92, 0xae, 0x92, 0xbf, 0x4e, 0x59,
-	0x32, 0x04, 0x43, 0xed, 0x9a, 0xcd, 0xd3, 0xb9, 0xc7, 0xd6, 0xee, 0xc7, 0x05, 0xf2, 0x8f, 0xbe,
-	0x72, 0x90, 0x34, 0x23, 0x37, 0x07, 0x6a, 0x62, 0xc8, 0xf2, 0xfc, 0x6f, 0xcd, 0xb0, 0xd1, 0x20,
-	0x0d, 0xe1, 0x3d, 0x84, 0xa5, 0xd4, 0xf6, 0x83, 0x99, 0xfa, 0xd5, 0x38, 0x57, 0x90, 0x66, 0x89,
-	0xd1, 0xc9, 0x9f, 0x58, 0x6a, 0xcf, 0xe0, 0x3a, 0xe0, 0x51, 0x0a, 0xa1, 0xa3, 0x5f, 0x63, 0x9d,
-	0xb9, 0x0f, 0x2c, 0x04, 0x59, 0x4b, 0x38, 0x73, 0x8e, 0x39, 0xe9, 0xd4, 0xb7, 0xf8, 0x42, 0xbc,
-	0xce, 0x42, 0x86, 0xf0, 0x33, 0xad, 0xae, 0xd5, 0x30, 0x4f, 0xe7, 0xd9, 0x27, 0xad, 0xab, 0xbd,
-	0x4d, 0x48, 0x36, 0x67, 0x90, 0x54, 0xbb, 0xfb, 0x31, 0x87, 0x24, 0x2b, 0xd5, 0xd7, 0x5a, 0xd1,
-	0xed, 0xeb, 0x3d, 0xfd, 0xe6, 0x0f, 0xd4, 0xee, 0x5d, 0x33, 0xdb, 0x48, 0xe8, 0x34, 0x3e, 0x9f,
-	0x77, 0xac, 0x2f, 0xe7, 0x1d, 0xeb, 0xeb, 0x79, 0xc7, 0xf2, 0xff, 0xd6, 0xbf, 0xb8, 0x47, 0xdf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x8e, 0xba, 0x2f, 0x66, 0x07, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xe3, 0xc4,
+	0x17, 0xff, 0xbb, 0xe9, 0xbf, 0x1f, 0xa7, 0x61, 0x71, 0x66, 0x57, 0x4b, 0x08, 0x4b, 0x5b, 0xb2,
+	0x42, 0xb4, 0xd0, 0x38, 0xdd, 0x20, 0x21, 0xe0, 0x8a, 0x86, 0x56, 0x34, 0xa8, 0x0a, 0x2b, 0xa7,
+	0x0b, 0x37, 0x48, 0xd1, 0xd8, 0x3e, 0x6b, 0x8f, 0xd6, 0xf1, 0x18, 0xcf, 0xa4, 0x90, 0x07, 0xe0,
+	0x31, 0x78, 0x10, 0xae, 0xb9, 0xe1, 0x92, 0x27, 0xa8, 0x50, 0xdf, 0x82, 0x5e, 0x21, 0xcf, 0x38,
+	0x89, 0x37, 0x76, 0x50, 0x4a, 0xc5, 0xdd, 0xcc, 0x99, 0x33, 0xbf, 0xdf, 0xf9, 0x9e, 0x81, 0x77,
+	0xe3, 0x84, 0x4b, 0xde, 0x16, 0x21, 0x15, 0x01, 0x8b, 0xfc, 0xd9, 0xc2, 0x52, 0x72, 0x52, 0x43,
+	0x19, 0x60, 0x82, 0xe3, 0x91, 0x35, 0x3d, 0x68, 0xec, 0xa1, 0x0c, 0xda, 0x57, 0xcf, 0x68, 0x18,
+	0x07, 0xf4, 0x59, 0xdb, 0x41, 0xea, 0xf2, 0x68, 0xe8, 0x84, 0xdc, 0x7d, 0xa5, 0xef, 0x34, 0x5a,
+	0x3e, 0x93, 0xc1, 0xd8, 0xb1, 0x5c, 0x3e, 0x6a, 0xfb, 0xdc, 0xe7, 0x6d, 0x25, 0x76, 0xc6, 0x2f,
+	0xd5, 0x4e, 0xf3, 0xa5, 0xab, 0x4c, 0xfd, 0x1d, 0x9f, 0x73, 0x3f, 0xc4, 0xb9, 0x16, 0x8e, 0x62,
+	0x39, 0xd1, 0x87, 0xcd, 0x2f, 0xe0, 0xed, 0x73, 0xe6, 0x07, 0x28, 0xe4, 0x89, 0x94, 0x28, 0x24,
+	0x95, 0x8c, 0x47, 0x36, 0xfe, 0x30, 0x46, 0x21, 0xc9, 0x53, 0x78, 0xe3, 0x8a, 0x86, 0xcc, 0xa3,
+	0x92, 0x27, 0x43, 0xe6, 0x89, 0xba, 0xb1, 0x5f, 0x39, 0x58, 0xb7, 0xab, 0x33, 0x61, 0xcf, 0x13,
+	0x4d, 0x1f, 0x1a, 0x65, 0x08, 0x22, 0xe6, 0x91, 0x40, 0xd2, 0x83, 0x2a, 0x9d, 0x8b, 0x35, 0xc2,
+	0x4e, 0xe7, 0x7d, 0xab, 0xe0, 0xb6, 0x55, 0x02, 0xf2, 0xda, 0xd5, 0xe6, 0x5f, 0x06, 0x90, 0xa2,
+	0x12, 0x79, 0x0f, 0xaa, 0x79, 0x23, 0xeb, 0xc6, 0xbe, 0x71, 0xb0, 0x6e, 0xef, 0xe4, 0x6c, 0x24,
+	0x43, 0x78, 0x14, 0xe8, 0x8b, 0x43, 0xc1, 0xc7, 0x89, 0x8b, 0x43, 0x8c, 0xb9, 0x1b, 0xd4, 0xd7,
+	0x52, 0xd5, 0x6e, 0xeb, 0xf6, 0x7a, 0xef, 0x30, 0x17, 0xd2, 0x38, 0x99, 0x88, 0x11, 0x95, 0xcc,
+	0x0d, 0xa9, 0x23, 0xda, 0x28, 0x83, 0x4e, 0x4b, 0x4e, 0x62, 0x14, 0xd6, 0x59, 0x7a, 0xc9, 0x26,
+	0x19, 0xd4, 0x40, 0x21, 0x29, 0x59, 0x9e, 0x40, 0xd2, 0xc4, 0x47, 0x99, 0x11, 0x54, 0xee, 0x43,
+	0x70, 0xa9, 0x90, 0x94, 0xac, 0xf9, 0x73, 0x05, 0xcc, 0x41, 0x16, 0x29, 0x31, 0x4d, 0x8f, 0x0b,
+	0xb5, 0x9c, 0xe7, 0x91, 0xc7, 0x5c, 0xcc, 0x52, 0xd4, 0xfd, 0xe4, 0xf6, 0x7a, 0xaf, 0xb3, 0x0a,
+	0xe5, 0xb7, 0xb3, 0x30, 0x45, 0x1e, 0xfe, 0x64, 0x9b, 0x57, 0xb9, 0x7d, 0x8a, 0x47, 0xfa, 0xb0,
+	0x23, 0x24, 0x4d, 0xe4, 0x7d, 0x42, 0x06, 0x0a, 0x41, 0x87, 0xea, 0x6b, 0xd8, 0xc6, 0xc8, 0xbb,
+	0x4f, 0x7c, 0xb6, 0x30, 0xf2, 0x34, 0x56, 0x17, 0x36, 0xd2, 0x2a, 0x18, 0x8b, 0xfa, 0xfa, 0xbe,
+	0x71, 0xf0, 0xa0, 0xf3, 0x61, 0x49, 0x59, 0x2d, 0x46, 0xcd, 0x1a, 0xa8, 0x1b, 0x76, 0x76, 0xb3,
+	0x79, 0x04, 0x1b, 0x5a, 0x42, 0x36, 0xa1, 0x72, 0x72, 0x71, 0x61, 0xfe, 0x8f, 0xec, 0xc0, 0xe6,
+	0xf3, 0xb3, 0xfe, 0x69, 0xaf, 0xff, 0x95, 0x69, 0x90, 0x2a, 0x6c, 0xf5, 0xfa, 0x5f, 0x5e, 0xbc,
+	0x38, 0x3d, 0x3b, 0x35, 0xd7, 0x9a, 0x31, 0xd4, 0x9f, 0x27, 0x3c, 0xe6, 0x02, 0x93, 0x29, 0xf0,
+	0xac, 0xd4, 0x2f, 0xa1, 0x16, 0x67, 0x67, 0xc3, 0x29, 0x7d, 0x56, 0xef, 0x1f, 0xcc, 0x0d, 0x43,
+	0x19, 0x58, 0xd3, 0xe6, 0xb6, 0x0a, 0x58, 0x66, 0xbc, 0x20, 0x69, 0x1e, 0xc2, 0xb6, 0x5a, 0x53,
+	0x27, 0x44, 0xf2, 0x04, 0xb6, 0xc5, 0x74, 0xa3, 0x0a, 0x7d, 0xcb, 0x9e, 0x0b, 0x52, 0xe3, 0x74,
+	0x63, 0x94, 0x1b, 0x47, 0xb3, 0xb3, 0x55, 0x8d, 0x2b, 0x60, 0x99, 0x74, 0x41, 0xd2, 0xfc, 0xcd,
+	0x80, 0x37, 0xb5, 0x0f, 0x34, 0x3c, 0x67, 0x42, 0xf2, 0x64, 0x42, 0xbe, 0x01, 0x50, 0xc9, 0x1d,
+	0x3a, 0x4c, 0x0a, 0x65, 0x64, 0xb5, 0x7b, 0x7c, 0x7b, 0xbd, 0x77, 0xb4, 0x34, 0xc3, 0x3e, 0x6f,
+	0x39, 0x4c, 0xbe, 0x64, 0x18, 0x7a, 0x56, 0x97, 0xc9, 0x90, 0x09, 0x69, 0x6f, 0x2b, 0x8c, 0x2e,
+	0x93, 0x22, 0x6d, 0xae, 0x90, 0xa6, 0xc4, 0xba, 0x68, 0x86, 0x3f, 0x26, 0x4c, 0x4a, 0x8c, 0xfe,
+	0x65, 0xf7, 0x6a, 0x28, 0xb5, 0xf9, 0x4e, 0x03, 0x35, 0x7f, 0x59, 0x03, 0x92, 0x9b, 0x28, 0x53,
+	0x47, 0x5c, 0x30, 0xb3, 0x66, 0x96, 0x3c, 0x9b, 0x1b, 0x59, 0xc4, 0x3e, 0x2b, 0xa9, 0xb3, 0x22,
+	0x80, 0xa5, 0x1b, 0xf8, 0x92, 0x67, 0x93, 0x22, 0x92, 0xc9, 0xc4, 0x7e, 0x20, 0x5f, 0x13, 0xfe,
+	0xe7, 0xce, 0x35, 0x4e, 0xe0, 0x61, 0x89, 0x1d, 0xc4, 0x84, 0xca, 0x2b, 0x9c, 0x64, 0xc3, 0x32,
+	0x5d, 0x92, 0x47, 0xf0, 0xff, 0x2b, 0x1a, 0x8e, 0x51, 0x53, 0xdb, 0x7a, 0xf3, 0xf9, 0xda, 0xa7,
+	0x46, 0xe7, 0xd7, 0x4d, 0xd8, 0x54, 0x29, 0xc7, 0x84, 0xc4, 0xf0, 0xb8, 0x27, 0x66, 0x05, 0x99,
+	0x9f, 0xc3, 0x87, 0x4b, 0xca, 0x48, 0x0d, 0x16, 0xf4, 0x72, 0xaa, 0x8d, 0x8f, 0x96, 0xc6, 0xaf,
+	0xa4, 0x72, 0x39, 0x98, 0x39, 0xc6, 0x6e, 0xfa, 0x0e, 0x12, 0x6b, 0x09, 0xd7, 0x80, 0xf9, 0x11,
+	0x7a, 0x5d, 0xf5, 0x64, 0x2a, 0xcd, 0x73, 0xa4, 0x1e, 0x26, 0xa5, 0x84, 0x4b, 0xfb, 0x98, 0xc1,
+	0x6e, 0xb9, 0x8b, 0x7d, 0xfe, 0x22, 0xf6, 0xa8, 0xc4, 0xbb, 0xb8, 0xfa, 0x64, 0xd9, 0x48, 0x52,
+	0xfd, 0xec, 0x40, 0x7d, 0xd1, 0xb7, 0x19, 0xc9, 0xc1, 0x12, 0x92, 0xa2, 0x77, 0xff, 0xcc, 0x91,
+	0xc0, 0xc3, 0xe2, 0xab, 0x29, 0xc8, 0xd1, 0x6a, 0x4f, 0xb0, 0x1e, 0x9a, 0x8d, 0xd6, 0x8a, 0xda,
+	0x59, 0x08, 0x11, 0x6a, 0x8b, 0xe1, 0x15, 0xe4, 0xe9, 0x0a, 0xd3, 0xf9, 0x6e, 0x99, 0x42, 0xa8,
+	0x2d, 0x96, 0xcd, 0x3d, 0x68, 0x96, 0x56, 0xe0, 0xf7, 0xf0, 0xd6, 0x40, 0x26, 0x48, 0x47, 0x45,
+	0x9f, 0x1e, 0x5b, 0xfa, 0x73, 0x65, 0x4d, 0x3f, 0x57, 0xd6, 0x59, 0xfa, 0xb9, 0x6a, 0xac, 0x3a,
+	0xf0, 0x8f, 0x8d, 0x39, 0x7a, 0xd1, 0x95, 0xbb, 0xa2, 0x2f, 0x22, 0x1c, 0x1b, 0xdd, 0xea, 0xef,
+	0x37, 0xbb, 0xc6, 0x1f, 0x37, 0xbb, 0xc6, 0x9f, 0x37, 0xbb, 0x86, 0xb3, 0xa1, 0x80, 0x3e, 0xfe,
+	0x7b, 0x00, 0xff, 0x17, 0xa3, 0xc8, 0x95, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -512,11 +642,26 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type SlasherClient interface {
+	// Returns any found attester slashings if the passed in attestation conflicts with a validators history.
 	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	// Returns any found proposer slashings if the passed in proposal conflicts with a validators history.
 	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+	// Returns if a given indexed attestation could be slashable when compared to the slashers history for the attesters.
+	// This function is read-only, and does not need the indexed attestation to be signed.
 	IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error)
+	// Returns if a given beacon block header could be slashable when compared to the slashers history for the proposer.
+	// This function is read-only, and does not need the beacon block header to be signed.
 	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
+	// Returns the highest source and target attestation for validator indexes that have been observed by the slasher.
 	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	// Returns the proposer slashings detected by the slasher which match the request filters.
+	ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+	// Returns the attester slashings detected by the slasher which match the request filters.
+	AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	// Streams proposer slashings as soon as they are detected by the slasher.
+	StreamProposerSlashings(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_StreamProposerSlashingsClient, error)
+	// Streams attester slashings as soon as they are detected by the slasher.
+	StreamAttesterSlashings(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_StreamAttesterSlashingsClient, error)
 }
 
 type slasherClient struct {
@@ -572,13 +717,110 @@ func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAtte
 	return out, nil
 }
 
+func (c *slasherClient) ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error) {
+	out := new(ProposerSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ProposerSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingResponse, error) {
+	out := new(AttesterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/AttesterSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) StreamProposerSlashings(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_StreamProposerSlashingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Slasher_serviceDesc.Streams[0], "/ethereum.slashing.Slasher/StreamProposerSlashings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &slasherStreamProposerSlashingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Slasher_StreamProposerSlashingsClient interface {
+	Recv() (*v1alpha1.ProposerSlashing, error)
+	grpc.ClientStream
+}
+
+type slasherStreamProposerSlashingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *slasherStreamProposerSlashingsClient) Recv() (*v1alpha1.ProposerSlashing, error) {
+	m := new(v1alpha1.ProposerSlashing)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *slasherClient) StreamAttesterSlashings(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_StreamAttesterSlashingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Slasher_serviceDesc.Streams[1], "/ethereum.slashing.Slasher/StreamAttesterSlashings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &slasherStreamAttesterSlashingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Slasher_StreamAttesterSlashingsClient interface {
+	Recv() (*v1alpha1.AttesterSlashing, error)
+	grpc.ClientStream
+}
+
+type slasherStreamAttesterSlashingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *slasherStreamAttesterSlashingsClient) Recv() (*v1alpha1.AttesterSlashing, error) {
+	m := new(v1alpha1.AttesterSlashing)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
+	// Returns any found attester slashings if the passed in attestation conflicts with a validators history.
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
+	// Returns any found proposer slashings if the passed in proposal conflicts with a validators history.
 	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
+	// Returns if a given indexed attestation could be slashable when compared to the slashers history for the attesters.
+	// This function is read-only, and does not need the indexed attestation to be signed.
 	IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error)
+	// Returns if a given beacon block header could be slashable when compared to the slashers history for the proposer.
+	// This function is read-only, and does not need the beacon block header to be signed.
 	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
+	// Returns the highest source and target attestation for validator indexes that have been observed by the slasher.
 	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	// Returns the proposer slashings detected by the slasher which match the request filters.
+	ProposerSlashings(context.Context, *SlashingsRequest) (*ProposerSlashingResponse, error)
+	// Returns the attester slashings detected by the slasher which match the request filters.
+	AttesterSlashings(context.Context, *SlashingsRequest) (*AttesterSlashingResponse, error)
+	// Streams proposer slashings as soon as they are detected by the slasher.
+	StreamProposerSlashings(*types.Empty, Slasher_StreamProposerSlashingsServer) error
+	// Streams attester slashings as soon as they are detected by the slasher.
+	StreamAttesterSlashings(*types.Empty, Slasher_StreamAttesterSlashingsServer) error
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -600,6 +842,18 @@ func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(ctx context.Context,
 func (*UnimplementedSlasherServer) HighestAttestations(ctx context.Context, req *HighestAttestationRequest) (*HighestAttestationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) ProposerSlashings(ctx context.Context, req *SlashingsRequest) (*ProposerSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) AttesterSlashings(ctx context.Context, req *SlashingsRequest) (*AttesterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) StreamProposerSlashings(req *types.Empty, srv Slasher_StreamProposerSlashingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProposerSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) StreamAttesterSlashings(req *types.Empty, srv Slasher_StreamAttesterSlashingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAttesterSlashings not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -695,6 +949,84 @@ func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_ProposerSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ProposerSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ProposerSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ProposerSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_AttesterSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).AttesterSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/AttesterSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).AttesterSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_StreamProposerSlashings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SlasherServer).StreamProposerSlashings(m, &slasherStreamProposerSlashingsServer{stream})
+}
+
+type Slasher_StreamProposerSlashingsServer interface {
+	Send(*v1alpha1.ProposerSlashing) error
+	grpc.ServerStream
+}
+
+type slasherStreamProposerSlashingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *slasherStreamProposerSlashingsServer) Send(m *v1alpha1.ProposerSlashing) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Slasher_StreamAttesterSlashings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SlasherServer).StreamAttesterSlashings(m, &slasherStreamAttesterSlashingsServer{stream})
+}
+
+type Slasher_StreamAttesterSlashingsServer interface {
+	Send(*v1alpha1.AttesterSlashing) error
+	grpc.ServerStream
+}
+
+type slasherStreamAttesterSlashingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *slasherStreamAttesterSlashingsServer) Send(m *v1alpha1.AttesterSlashing) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.slashing.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -719,8 +1051,27 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HighestAttestations",
 			Handler:    _Slasher_HighestAttestations_Handler,
 		},
+		{
+			MethodName: "ProposerSlashings",
+			Handler:    _Slasher_ProposerSlashings_Handler,
+		},
+		{
+			MethodName: "AttesterSlashings",
+			Handler:    _Slasher_AttesterSlashings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProposerSlashings",
+			Handler:       _Slasher_StreamProposerSlashings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAttesterSlashings",
+			Handler:       _Slasher_StreamAttesterSlashings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/slashing/slashing.proto",
 }
 
@@ -852,6 +1203,66 @@ func (m *HighestAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA4 := make([]byte, len(m.ValidatorIndices)*10)
+		var j3 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSlashing(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProposerSlashingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1126,6 +1537,34 @@ func (m *HighestAttestation) Size() (n int) {
 	return n
 }
 
+func (m *SlashingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.EndEpoch))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ProposerSlashingResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1545,6 +1984,190 @@ func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingsRequest_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Type of key manager for the wallet, either direct, derived, or remote.
 type KeymanagerKind int32
 
 const (
@@ -59,17 +60,25 @@ func (KeymanagerKind) EnumDescriptor() ([]byte, []int) {
 }
 
 type CreateWalletRequest struct {
-	Keymanager           KeymanagerKind `protobuf:"varint,1,opt,name=keymanager,proto3,enum=ethereum.validator.accounts.v2.KeymanagerKind" json:"keymanager,omitempty"`
-	WalletPassword       string         `protobuf:"bytes,2,opt,name=wallet_password,json=walletPassword,proto3" json:"wallet_password,omitempty"`
-	Mnemonic             string         `protobuf:"bytes,3,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
-	NumAccounts          uint64         `protobuf:"varint,4,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
-	RemoteAddr           string         `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
-	RemoteCrtPath        string         `protobuf:"bytes,6,opt,name=remote_crt_path,json=remoteCrtPath,proto3" json:"remote_crt_path,omitempty"`
-	RemoteKeyPath        string         `protobuf:"bytes,7,opt,name=remote_key_path,json=remoteKeyPath,proto3" json:"remote_key_path,omitempty"`
-	RemoteCaCrtPath      string         `protobuf:"bytes,8,opt,name=remote_ca_crt_path,json=remoteCaCrtPath,proto3" json:"remote_ca_crt_path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// Path on disk where the wallet will be stored.
+	Keymanager KeymanagerKind `protobuf:"varint,1,opt,name=keymanager,proto3,enum=ethereum.validator.accounts.v2.KeymanagerKind" json:"keymanager,omitempty"`
+	// Password for the wallet.
+	WalletPassword string `protobuf:"bytes,2,opt,name=wallet_password,json=walletPassword,proto3" json:"wallet_password,omitempty"`
+	// Mnemonic in case the user is creating a derived wallet.
+	Mnemonic string `protobuf:"bytes,3,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// Number of accounts.
+	NumAccounts uint64 `protobuf:"varint,4,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+	// Remote address such as host.example.com:4000 for a gRPC remote signer server.
+	RemoteAddr string `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	// Path to client.crt for secure TLS connections to a remote signer server.
+	RemoteCrtPath string `protobuf:"bytes,6,opt,name=remote_crt_path,json=remoteCrtPath,proto3" json:"remote_crt_path,omitempty"`
+	// Path to client.key for secure TLS connections to a remote signer server.
+	RemoteKeyPath string `protobuf:"bytes,7,opt,name=remote_key_path,json=remoteKeyPath,proto3" json:"remote_key_path,omitempty"`
+	// Path to ca.crt for secure TLS connections to a remote signer server.
+	RemoteCaCrtPath      string   `protobuf:"bytes,8,opt,name=remote_ca_crt_path,json=remoteCaCrtPath,proto3" json:"remote_ca_crt_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateWalletRequest) Reset()         { *m = CreateWalletRequest{} }
@@ -382,9 +391,16 @@ func (m *WalletResponse) GetKeymanagerKind() KeymanagerKind {
 }
 
 type ListAccountsRequest struct {
-	GetDepositTxData     bool     `protobuf:"varint,1,opt,name=get_deposit_tx_data,json=getDepositTxData,proto3" json:"get_deposit_tx_data,omitempty"`
-	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Whether or not to return the raw RLP deposit tx data.
+	GetDepositTxData bool `protobuf:"varint,1,opt,name=get_deposit_tx_data,json=getDepositTxData,proto3" json:"get_deposit_tx_data,omitempty"`
+	// The maximum number of accounts to return in the response.
+	// This field is optional.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A pagination token returned from a previous call to `ListAccounts`
+	// that indicates where this listing should continue from.
+	// This field is optional.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Whether to return all available accounts in a single response.
 	All                  bool     `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -453,12 +469,16 @@ func (m *ListAccountsRequest) GetAll() bool {
 }
 
 type ListAccountsResponse struct {
-	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	NextPageToken        string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalSize            int32      `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// A pagination token returned from a previous call to `ListAccounts`
+	// that indicates from where listing should continue.
+	// This field is optional.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total count matching the request.
+	TotalSize            int32    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
@@ -516,9 +536,13 @@ func (m *ListAccountsResponse) GetTotalSize() int32 {
 }
 
 type Account struct {
-	ValidatingPublicKey  []byte   `protobuf:"bytes,1,opt,name=validating_public_key,json=validatingPublicKey,proto3" json:"validating_public_key,omitempty"`
-	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	DepositTxData        []byte   `protobuf:"bytes,3,opt,name=deposit_tx_data,json=depositTxData,proto3" json:"deposit_tx_data,omitempty"`
+	// The validating public key.
+	ValidatingPublicKey []byte `protobuf:"bytes,1,opt,name=validating_public_key,json=validatingPublicKey,proto3" json:"validating_public_key,omitempty"`
+	// The human readable account name.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The deposit data transaction RLP bytes.
+	DepositTxData []byte `protobuf:"bytes,3,opt,name=deposit_tx_data,json=depositTxData,proto3" json:"deposit_tx_data,omitempty"`
+	// The derivation path (if using HD wallet).
 	DerivationPath       string   `protobuf:"bytes,4,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -587,7 +611,9 @@ func (m *Account) GetDerivationPath() string {
 }
 
 type AccountRequest struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	// A list of validator public keys.
+	PublicKeys [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	// A list of validator indices.
 	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -752,10 +778,16 @@ func (m *AuthResponse) GetTokenExpiration() uint64 {
 }
 
 type NodeConnectionResponse struct {
-	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	// The host address of the beacon node the validator
+	// client is connected to.
+	BeaconNodeEndpoint string `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	// Whether the connection is active.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Whether the beacon node is currently synchronizing to chain head.
+	Syncing bool `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// The chain genesis time.
+	GenesisTime uint64 `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	// Address of the validator deposit contract in the eth1 chain.
 	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
 	XXX_unrecognized       []byte   `json:"-"`
@@ -1004,6 +1036,7 @@ func (m *ChangePasswordRequest) GetPasswordConfirmation() string {
 }
 
 type HasWalletResponse struct {
+	// Whether or not the user has a wallet on disk.
 	WalletExists         bool     `protobuf:"varint,1,opt,name=wallet_exists,json=walletExists,proto3" json:"wallet_exists,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1051,7 +1084,9 @@ func (m *HasWalletResponse) GetWalletExists() bool {
 }
 
 type ImportKeystoresRequest struct {
-	KeystoresImported    []string `protobuf:"bytes,1,rep,name=keystores_imported,json=keystoresImported,proto3" json:"keystores_imported,omitempty"`
+	// JSON-encoded keystore files to import during wallet creation.
+	KeystoresImported []string `protobuf:"bytes,1,rep,name=keystores_imported,json=keystoresImported,proto3" json:"keystores_imported,omitempty"`
+	// Password to unlock imported keystore files.
 	KeystoresPassword    string   `protobuf:"bytes,2,opt,name=keystores_password,json=keystoresPassword,proto3" json:"keystores_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1255,15 +1290,22 @@ func (m *LogsResponse) GetLogs() []string {
 }
 
 type BeaconStatusResponse struct {
-	BeaconNodeEndpoint     string              `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool                `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool                `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64              `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
-	DepositContractAddress []byte              `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	ChainHead              *v1alpha1.ChainHead `protobuf:"bytes,6,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
-	XXX_unrecognized       []byte              `json:"-"`
-	XXX_sizecache          int32               `json:"-"`
+	// The host address of the beacon node the validator
+	// client is connected to.
+	BeaconNodeEndpoint string `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	// Whether the connection is active.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Whether the beacon node is currently synchronizing to chain head.
+	Syncing bool `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// The chain genesis time.
+	GenesisTime uint64 `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	// Address of the validator deposit contract in the eth1 chain.
+	DepositContractAddress []byte `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	// The head of the chain from the beacon node.
+	ChainHead            *v1alpha1.ChainHead `protobuf:"bytes,6,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *BeaconStatusResponse) Reset()         { *m = BeaconStatusResponse{} }
@@ -1342,6 +1384,7 @@ func (m *BeaconStatusResponse) GetChainHead() *v1alpha1.ChainHead {
 }
 
 type BackupAccountsRequest struct {
+	// List of public keys to backup.
 	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	BackupPassword       string   `protobuf:"bytes,2,opt,name=backup_password,json=backupPassword,proto3" json:"backup_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1397,6 +1440,7 @@ func (m *BackupAccountsRequest) GetBackupPassword() string {
 }
 
 type BackupAccountsResponse struct {
+	// Zip file containing backed up keystores.
 	ZipFile              []byte   `protobuf:"bytes,1,opt,name=zip_file,json=zipFile,proto3" json:"zip_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1443,6 +1487,169 @@ func (m *BackupAccountsResponse) GetZipFile() []byte {
 	return nil
 }
 
+type GraffitiRequest struct {
+	// Public key of the validator.
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraffitiRequest) Reset()         { *m = GraffitiRequest{} }
+func (m *GraffitiRequest) String() string { return proto.CompactTextString(m) }
+func (*GraffitiRequest) ProtoMessage()    {}
+func (*GraffitiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23}
+}
+func (m *GraffitiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GraffitiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GraffitiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GraffitiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraffitiRequest.Merge(m, src)
+}
+func (m *GraffitiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GraffitiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraffitiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraffitiRequest proto.InternalMessageInfo
+
+func (m *GraffitiRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type GraffitiResponse struct {
+	// Public key of the validator.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Graffiti or graffiti template set for the public key, empty if none.
+	Graffiti             string   `protobuf:"bytes,2,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraffitiResponse) Reset()         { *m = GraffitiResponse{} }
+func (m *GraffitiResponse) String() string { return proto.CompactTextString(m) }
+func (*GraffitiResponse) ProtoMessage()    {}
+func (*GraffitiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{24}
+}
+func (m *GraffitiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GraffitiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GraffitiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GraffitiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraffitiResponse.Merge(m, src)
+}
+func (m *GraffitiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GraffitiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraffitiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraffitiResponse proto.InternalMessageInfo
+
+func (m *GraffitiResponse) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *GraffitiResponse) GetGraffiti() string {
+	if m != nil {
+		return m.Graffiti
+	}
+	return ""
+}
+
+type SetGraffitiRequest struct {
+	// Public key of the validator.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Graffiti or graffiti template such as "{{.Index}} on {{.Eth1Client}}" for the public
+	// key. An empty graffiti removes the graffiti set for the public key.
+	Graffiti             string   `protobuf:"bytes,2,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetGraffitiRequest) Reset()         { *m = SetGraffitiRequest{} }
+func (m *SetGraffitiRequest) String() string { return proto.CompactTextString(m) }
+func (*SetGraffitiRequest) ProtoMessage()    {}
+func (*SetGraffitiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{25}
+}
+func (m *SetGraffitiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetGraffitiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetGraffitiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetGraffitiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGraffitiRequest.Merge(m, src)
+}
+func (m *SetGraffitiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetGraffitiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGraffitiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGraffitiRequest proto.InternalMessageInfo
+
+func (m *SetGraffitiRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SetGraffitiRequest) GetGraffiti() string {
+	if m != nil {
+		return m.Graffiti
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
 	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
@@ -1468,6 +1675,9 @@ func init() {
 	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
 	proto.RegisterType((*BackupAccountsRequest)(nil), "ethereum.validator.accounts.v2.BackupAccountsRequest")
 	proto.RegisterType((*BackupAccountsResponse)(nil), "ethereum.validator.accounts.v2.BackupAccountsResponse")
+	proto.RegisterType((*GraffitiRequest)(nil), "ethereum.validator.accounts.v2.GraffitiRequest")
+	proto.RegisterType((*GraffitiResponse)(nil), "ethereum.validator.accounts.v2.GraffitiResponse")
+	proto.RegisterType((*SetGraffitiRequest)(nil), "ethereum.validator.accounts.v2.SetGraffitiRequest")
 }
 
 func init() {
@@ -1475,140 +1685,145 @@ func init() {
 }
 
 var fileDescriptor_8a5153635bfe042e = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1c, 0x49,
-	0xf5, 0xff, 0xb6, 0xed, 0x4c, 0xc6, 0x6f, 0x26, 0xb6, 0x53, 0x76, 0x9c, 0xc9, 0xc4, 0xb1, 0x9d,
-	0xca, 0x0f, 0x3b, 0x4e, 0xbe, 0x33, 0xc9, 0x84, 0x4d, 0xa2, 0x5c, 0x50, 0x3c, 0x99, 0x4d, 0x22,
-	0xe7, 0x87, 0xe9, 0x64, 0x13, 0x71, 0xd9, 0x56, 0xb9, 0xbb, 0x32, 0x53, 0xf2, 0x4c, 0x77, 0x6f,
-	0x77, 0xcd, 0x24, 0x0e, 0x17, 0x58, 0x56, 0x42, 0x20, 0x71, 0x80, 0x3d, 0x20, 0x24, 0x84, 0x04,
-	0x37, 0x24, 0x2e, 0x48, 0x48, 0xfb, 0x2f, 0xc0, 0x0d, 0x89, 0x2b, 0x12, 0x28, 0xe2, 0x02, 0xfc,
-	0x13, 0xa8, 0xaa, 0xab, 0xfa, 0xc7, 0x64, 0x7a, 0xdb, 0x16, 0xe2, 0xc2, 0xad, 0xeb, 0xfd, 0xfc,
-	0xd4, 0xab, 0x57, 0xaf, 0xde, 0x6b, 0xb8, 0xe2, 0x07, 0x1e, 0xf7, 0x9a, 0x23, 0xd2, 0x67, 0x0e,
-	0xe1, 0x5e, 0xd0, 0x24, 0xb6, 0xed, 0x0d, 0x5d, 0x1e, 0x36, 0x47, 0xad, 0xe6, 0x1b, 0xba, 0x67,
-	0x11, 0x9f, 0x35, 0xa4, 0x0c, 0x5a, 0xa5, 0xbc, 0x47, 0x03, 0x3a, 0x1c, 0x34, 0x62, 0xe9, 0x86,
-	0x96, 0x6e, 0x8c, 0x5a, 0xf5, 0xf5, 0xc8, 0xd4, 0x1e, 0x25, 0xb6, 0xe7, 0x36, 0x03, 0xdf, 0x6e,
-	0x8e, 0x6e, 0x34, 0x7b, 0x94, 0xf4, 0x79, 0x2f, 0xb2, 0x50, 0x5f, 0xa3, 0xbc, 0xd7, 0x1c, 0xdd,
-	0x20, 0x7d, 0xbf, 0x47, 0x6e, 0x28, 0x41, 0xcb, 0xee, 0x11, 0xe6, 0x2a, 0x81, 0xd3, 0x19, 0x01,
-	0xd7, 0x73, 0xa8, 0x62, 0xac, 0x74, 0x3d, 0xaf, 0xdb, 0xa7, 0x4d, 0xe2, 0xb3, 0x26, 0x71, 0x5d,
-	0x8f, 0x13, 0xce, 0x3c, 0x37, 0x54, 0xdc, 0xb3, 0x8a, 0x2b, 0x57, 0x7b, 0xc3, 0xd7, 0x4d, 0x3a,
-	0xf0, 0xf9, 0x41, 0xc4, 0xc4, 0xff, 0x9a, 0x82, 0xc5, 0x76, 0x40, 0x09, 0xa7, 0xaf, 0x48, 0xbf,
-	0x4f, 0xb9, 0x49, 0x3f, 0x1b, 0xd2, 0x90, 0xa3, 0xa7, 0x00, 0xfb, 0xf4, 0x60, 0x40, 0x5c, 0xd2,
-	0xa5, 0x41, 0xcd, 0x58, 0x37, 0x36, 0xe7, 0x5a, 0x8d, 0xc6, 0xd7, 0xef, 0xb1, 0xb1, 0x13, 0x6b,
-	0xec, 0x30, 0xd7, 0x31, 0x53, 0x16, 0xd0, 0x06, 0xcc, 0xbf, 0x91, 0x0e, 0x2c, 0x9f, 0x84, 0xe1,
-	0x1b, 0x2f, 0x70, 0x6a, 0x53, 0xeb, 0xc6, 0xe6, 0xac, 0x39, 0x17, 0x91, 0x77, 0x15, 0x15, 0xd5,
-	0xa1, 0x3c, 0x70, 0xe9, 0xc0, 0x73, 0x99, 0x5d, 0x9b, 0x96, 0x12, 0xf1, 0x1a, 0x9d, 0x87, 0xaa,
-	0x3b, 0x1c, 0x58, 0xda, 0x65, 0x6d, 0x66, 0xdd, 0xd8, 0x9c, 0x31, 0x2b, 0xee, 0x70, 0x70, 0x4f,
-	0x91, 0xd0, 0x1a, 0x54, 0x02, 0x3a, 0xf0, 0x38, 0xb5, 0x88, 0xe3, 0x04, 0xb5, 0x63, 0xd2, 0x02,
-	0x44, 0xa4, 0x7b, 0x8e, 0x13, 0xa0, 0xcb, 0x30, 0xaf, 0x04, 0xec, 0x40, 0x80, 0xe1, 0xbd, 0x5a,
-	0x49, 0x0a, 0x9d, 0x88, 0xc8, 0xed, 0x80, 0xef, 0x12, 0xde, 0x4b, 0xc9, 0xed, 0xd3, 0x83, 0x48,
-	0xee, 0x78, 0x5a, 0x6e, 0x87, 0x1e, 0x48, 0xb9, 0xab, 0x80, 0xb4, 0x3d, 0x92, 0x98, 0x2c, 0x4b,
-	0x51, 0x65, 0xa1, 0x4d, 0x94, 0x51, 0xfc, 0x29, 0x2c, 0x65, 0x83, 0x1d, 0xfa, 0x9e, 0x1b, 0x52,
-	0xf4, 0x31, 0x94, 0xa2, 0x30, 0xc8, 0x48, 0x57, 0x8a, 0x23, 0x9d, 0xd5, 0x37, 0x95, 0x36, 0xfe,
-	0xca, 0x80, 0xd3, 0x1d, 0x87, 0xf1, 0x88, 0xdd, 0xf6, 0xdc, 0xd7, 0xac, 0xab, 0x4f, 0x74, 0x2c,
-	0x32, 0xc6, 0x61, 0x22, 0x33, 0x75, 0xc8, 0xc8, 0x4c, 0x1f, 0x3e, 0x32, 0x33, 0x93, 0x23, 0x73,
-	0x0b, 0x6a, 0x0f, 0xa8, 0x4b, 0x03, 0xc2, 0xe9, 0x13, 0x75, 0xdc, 0x71, 0x74, 0xd2, 0x29, 0x61,
-	0x64, 0x53, 0x02, 0xff, 0xc8, 0x80, 0xb9, 0xb1, 0x60, 0xae, 0x41, 0x25, 0x4e, 0x35, 0xde, 0xd3,
-	0x1b, 0xd5, 0x69, 0xc6, 0x7b, 0xe8, 0x15, 0xcc, 0x27, 0x99, 0x69, 0xed, 0x33, 0x37, 0xca, 0xc5,
-	0xa3, 0x27, 0xf8, 0xdc, 0x7e, 0x66, 0x8d, 0x7f, 0x6a, 0xc0, 0xe2, 0x63, 0x16, 0x72, 0x9d, 0x8d,
-	0x3a, 0xf4, 0xff, 0x0f, 0x8b, 0x5d, 0xca, 0x2d, 0x87, 0xfa, 0x5e, 0xc8, 0xb8, 0xc5, 0xdf, 0x5a,
-	0x0e, 0xe1, 0x44, 0x22, 0x2b, 0x9b, 0x0b, 0x5d, 0xca, 0xef, 0x47, 0x9c, 0x17, 0x6f, 0xef, 0x13,
-	0x4e, 0xd0, 0x59, 0x98, 0xf5, 0x49, 0x97, 0x5a, 0x21, 0x7b, 0x47, 0x25, 0xb2, 0x63, 0x66, 0x59,
-	0x10, 0x9e, 0xb3, 0x77, 0x14, 0x9d, 0x03, 0x90, 0x4c, 0xee, 0xed, 0x53, 0x57, 0x05, 0x5e, 0x8a,
-	0xbf, 0x10, 0x04, 0xb4, 0x00, 0xd3, 0xa4, 0xdf, 0x97, 0x51, 0x2e, 0x9b, 0xe2, 0x13, 0xff, 0xda,
-	0x80, 0xa5, 0x2c, 0x28, 0x15, 0xa7, 0x36, 0x94, 0xe3, 0x9b, 0x64, 0xac, 0x4f, 0x6f, 0x56, 0x5a,
-	0x1b, 0x45, 0xfb, 0x57, 0x36, 0xcc, 0x58, 0x51, 0x24, 0x83, 0x4b, 0xdf, 0x8a, 0x50, 0xc7, 0x98,
-	0x54, 0xd2, 0x08, 0xf2, 0x6e, 0x8c, 0xeb, 0x1c, 0x00, 0xf7, 0x38, 0xe9, 0x47, 0x9b, 0x9a, 0x96,
-	0x9b, 0x9a, 0x95, 0x14, 0xb1, 0x2b, 0xfc, 0x3b, 0x03, 0x8e, 0x2b, 0xe3, 0xa8, 0x05, 0xa7, 0x94,
-	0x77, 0xe6, 0x76, 0x2d, 0x7f, 0xb8, 0xd7, 0x67, 0xb6, 0x48, 0x35, 0x19, 0xaf, 0xaa, 0xb9, 0x98,
-	0x30, 0x77, 0x25, 0x6f, 0x87, 0x1e, 0x88, 0xca, 0xa0, 0x20, 0x59, 0x2e, 0x19, 0x50, 0x85, 0xa1,
-	0xa2, 0x68, 0x4f, 0xc9, 0x80, 0x0a, 0xa4, 0xe3, 0x07, 0x30, 0x2d, 0x0d, 0x9e, 0x70, 0x32, 0xd1,
-	0xdf, 0x10, 0x72, 0x01, 0x1b, 0xc9, 0x1a, 0x9a, 0xce, 0xd9, 0xb9, 0x84, 0x2c, 0x53, 0x76, 0x07,
-	0xe6, 0x74, 0x3c, 0x92, 0x2b, 0x96, 0xc0, 0x8d, 0x82, 0x5a, 0x35, 0xc1, 0xd7, 0x28, 0x43, 0x54,
-	0x83, 0xe3, 0xcc, 0x75, 0x98, 0x4d, 0xc3, 0xda, 0xd4, 0xfa, 0xf4, 0xe6, 0x8c, 0xa9, 0x97, 0xf8,
-	0x53, 0xa8, 0xdc, 0x1b, 0xf2, 0x9e, 0xb6, 0x54, 0x87, 0x72, 0x5c, 0x27, 0x55, 0xca, 0xeb, 0x35,
-	0xba, 0x09, 0xa7, 0xf4, 0xb7, 0x65, 0x8b, 0x2b, 0x1e, 0x0c, 0x24, 0x28, 0xb5, 0xe9, 0x25, 0xcd,
-	0x6c, 0xa7, 0x78, 0xf8, 0x19, 0x54, 0x23, 0xfb, 0xea, 0xf0, 0x97, 0xe0, 0x58, 0x74, 0x5a, 0x91,
-	0xf5, 0x68, 0x81, 0xae, 0xc0, 0x82, 0xfc, 0xb0, 0xe8, 0x5b, 0x9f, 0x05, 0x89, 0xd5, 0x19, 0x73,
-	0x5e, 0xd2, 0x3b, 0x31, 0x19, 0xff, 0xd5, 0x80, 0xe5, 0xa7, 0x9e, 0x43, 0xdb, 0x9e, 0xeb, 0x52,
-	0x5b, 0x90, 0x62, 0xdb, 0xd7, 0x61, 0x49, 0xbd, 0x5e, 0xe2, 0x8d, 0xb2, 0xa8, 0xeb, 0xf8, 0x1e,
-	0x73, 0xb9, 0x72, 0x85, 0x22, 0x9e, 0xd0, 0xed, 0x28, 0x0e, 0x5a, 0x81, 0x59, 0x3b, 0xb2, 0x43,
-	0xa3, 0xbb, 0x58, 0x36, 0x13, 0x82, 0x88, 0x5a, 0x78, 0xe0, 0xda, 0xcc, 0xed, 0xca, 0x13, 0x2b,
-	0x9b, 0x7a, 0x29, 0x8e, 0xbd, 0x4b, 0x5d, 0x1a, 0xb2, 0xd0, 0xe2, 0x6c, 0x40, 0xf5, 0x83, 0xa0,
-	0x68, 0x2f, 0xd8, 0x80, 0xa2, 0x3b, 0x50, 0xd3, 0xc7, 0x6e, 0x7b, 0x2e, 0x0f, 0x88, 0xcd, 0x65,
-	0x01, 0xa4, 0x61, 0x28, 0x5f, 0x87, 0xaa, 0xb9, 0xac, 0xf8, 0x6d, 0xc5, 0xbe, 0x17, 0x71, 0xf1,
-	0x77, 0xc5, 0xc5, 0xf1, 0xba, 0xa1, 0x46, 0x19, 0xef, 0xef, 0x16, 0x9c, 0x8e, 0xaf, 0x87, 0xd5,
-	0xf7, 0xba, 0xe1, 0xf8, 0x16, 0x4f, 0xc5, 0xec, 0xb4, 0x7e, 0x2a, 0x2e, 0x59, 0xa5, 0xa9, 0x74,
-	0x5c, 0xd2, 0x1a, 0xf8, 0x01, 0xcc, 0xbf, 0xa4, 0x41, 0x98, 0x0e, 0xee, 0x32, 0x94, 0x22, 0x41,
-	0xe5, 0x4b, 0xad, 0x44, 0x08, 0x63, 0xaf, 0xca, 0x62, 0x42, 0xc0, 0x5f, 0x1a, 0x70, 0xaa, 0xdd,
-	0x23, 0x6e, 0x97, 0xea, 0x87, 0x56, 0x67, 0xda, 0x15, 0x58, 0xb0, 0x87, 0x41, 0x40, 0xdd, 0xd4,
-	0xcb, 0x1c, 0x59, 0x9e, 0x57, 0xf4, 0xf4, 0xd3, 0x3c, 0xf6, 0x78, 0x1f, 0x22, 0x29, 0xa7, 0xbf,
-	0x26, 0x29, 0xef, 0xc0, 0xc9, 0x87, 0x24, 0x1c, 0x2b, 0xdf, 0x17, 0xe0, 0x84, 0x2a, 0xdf, 0xf4,
-	0x2d, 0x0b, 0x65, 0x6d, 0x12, 0x67, 0x5e, 0x8d, 0x88, 0x1d, 0x49, 0xc3, 0x23, 0x58, 0x7e, 0x34,
-	0xf0, 0xbd, 0x80, 0x8b, 0x6b, 0xc5, 0xbd, 0x80, 0xa6, 0x6a, 0x2d, 0xda, 0xd7, 0x34, 0x8b, 0x49,
-	0x19, 0xea, 0xc8, 0xab, 0x38, 0x6b, 0x9e, 0x8c, 0x39, 0x8f, 0x14, 0x23, 0x2b, 0x3e, 0xb6, 0xbb,
-	0x44, 0x5c, 0x87, 0x00, 0xef, 0xc0, 0xe9, 0x0f, 0xfc, 0x26, 0x59, 0xaf, 0xdd, 0x59, 0x1f, 0x56,
-	0x01, 0xa4, 0x79, 0x71, 0xcd, 0x0a, 0xf1, 0x2b, 0x40, 0x0f, 0x49, 0xf8, 0x49, 0x48, 0x9d, 0x57,
-	0x74, 0x2f, 0xb6, 0x83, 0xe1, 0x44, 0x8f, 0x84, 0x56, 0xc8, 0xba, 0x2e, 0x75, 0xac, 0xa1, 0xaf,
-	0xf6, 0x5f, 0xe9, 0x91, 0xf0, 0xb9, 0xa4, 0x7d, 0xe2, 0x8b, 0x6a, 0x2a, 0x64, 0x54, 0xcf, 0xa0,
-	0x2e, 0x4c, 0x4f, 0x87, 0x12, 0x63, 0xa8, 0x8a, 0x34, 0x8a, 0x4d, 0x22, 0x98, 0x11, 0x19, 0xa7,
-	0xa2, 0x20, 0xbf, 0xf1, 0x2f, 0xa7, 0x60, 0x69, 0x5b, 0xa6, 0xce, 0x73, 0x4e, 0xf8, 0x30, 0xfc,
-	0x1f, 0xbb, 0xbd, 0xe8, 0x9b, 0x00, 0xb2, 0x77, 0xb6, 0x7a, 0x94, 0x38, 0xb2, 0xc5, 0xab, 0xb4,
-	0xd6, 0x93, 0xf7, 0x8d, 0xf2, 0x5e, 0x43, 0xb7, 0xd2, 0x8d, 0xb6, 0x10, 0x7c, 0x48, 0x89, 0x63,
-	0xce, 0xda, 0xfa, 0x13, 0x13, 0x38, 0xb5, 0x4d, 0xec, 0xfd, 0xa1, 0x3f, 0xfe, 0x9a, 0x17, 0x56,
-	0xf9, 0x0d, 0x98, 0xdf, 0x93, 0x9a, 0x1f, 0xf4, 0xba, 0x11, 0x39, 0xce, 0xa6, 0x9b, 0xb0, 0x3c,
-	0xee, 0x42, 0x1d, 0xc2, 0x19, 0x28, 0xbf, 0x63, 0xbe, 0xf5, 0x9a, 0xf5, 0xa9, 0x7a, 0xf6, 0x8e,
-	0xbf, 0x63, 0xfe, 0xc7, 0xac, 0x4f, 0xb7, 0x6e, 0xc3, 0x5c, 0xb6, 0x0d, 0x41, 0x15, 0x38, 0x7e,
-	0xbf, 0x63, 0x3e, 0x7a, 0xd9, 0xb9, 0xbf, 0xf0, 0x7f, 0xa8, 0x0a, 0xe5, 0x47, 0x4f, 0x76, 0x9f,
-	0x99, 0x2f, 0x3a, 0xf7, 0x17, 0x0c, 0x04, 0x50, 0x32, 0x3b, 0x4f, 0x9e, 0xbd, 0xe8, 0x2c, 0x4c,
-	0xb5, 0xfe, 0x31, 0x03, 0xa5, 0x28, 0x41, 0xd0, 0xaf, 0x0c, 0xa8, 0xa6, 0x1b, 0x51, 0x74, 0xb3,
-	0xe8, 0xe5, 0x9f, 0x30, 0x23, 0xd4, 0xbf, 0x71, 0x34, 0xa5, 0x68, 0x6b, 0xf8, 0xf2, 0xe7, 0x7f,
-	0xfe, 0xfb, 0x97, 0x53, 0xeb, 0xf8, 0xac, 0x98, 0xa1, 0x92, 0xc9, 0x2a, 0xca, 0xe5, 0xa6, 0x2d,
-	0x55, 0xee, 0x1a, 0x5b, 0x88, 0x43, 0x35, 0xdd, 0xc6, 0xa2, 0xe5, 0x46, 0x34, 0xc7, 0x34, 0xf4,
-	0x1c, 0xd3, 0xe8, 0x88, 0x39, 0xa6, 0x7e, 0xc4, 0x5e, 0x19, 0xaf, 0x48, 0xff, 0xcb, 0x68, 0x69,
-	0x92, 0x7f, 0xf4, 0x63, 0x03, 0x16, 0xc6, 0x1b, 0xd1, 0x5c, 0xd7, 0x77, 0x8a, 0x5c, 0xe7, 0xb5,
-	0xb4, 0x78, 0x43, 0x82, 0x38, 0x8f, 0xd6, 0xb2, 0x20, 0x74, 0x5b, 0xdb, 0xec, 0x2a, 0x45, 0xf4,
-	0x7b, 0x03, 0xe6, 0xc7, 0x2a, 0x0e, 0xba, 0x55, 0xe4, 0x76, 0x72, 0x69, 0xac, 0xdf, 0x3e, 0xb2,
-	0x9e, 0x42, 0x7b, 0x5d, 0xa2, 0xdd, 0xc2, 0x97, 0x26, 0x1e, 0x59, 0x5c, 0x25, 0x9b, 0x51, 0x8d,
-	0xbb, 0x6b, 0x6c, 0xb5, 0xfe, 0x32, 0x0d, 0xe5, 0x78, 0x26, 0xfb, 0xb9, 0x01, 0xd5, 0x74, 0x07,
-	0x5a, 0x9c, 0x6d, 0x13, 0x9a, 0xe8, 0xe2, 0x6c, 0x9b, 0xd4, 0xe4, 0xe2, 0x55, 0x09, 0xbd, 0x86,
-	0x96, 0xb3, 0xd0, 0xe3, 0xfe, 0xf5, 0x37, 0x06, 0xcc, 0x65, 0xef, 0x20, 0xfa, 0xa8, 0xc8, 0xd1,
-	0xc4, 0xb2, 0x50, 0xbf, 0x75, 0x54, 0x35, 0x85, 0x70, 0x53, 0x22, 0xc4, 0xf8, 0xdc, 0x64, 0x84,
-	0xcd, 0xa8, 0x66, 0x88, 0x1b, 0xf1, 0x03, 0x03, 0xe6, 0xb2, 0x8f, 0x78, 0x31, 0xd6, 0x89, 0x8f,
-	0x7e, 0x3d, 0x27, 0xa1, 0xf3, 0xee, 0xa6, 0x2e, 0x63, 0x4d, 0xea, 0x30, 0x79, 0xbc, 0xdf, 0x2b,
-	0x43, 0x29, 0x7a, 0x3c, 0xd0, 0x17, 0x06, 0xcc, 0x3f, 0xa0, 0x3c, 0xfd, 0x94, 0xe4, 0xde, 0x97,
-	0xc2, 0x23, 0x9c, 0xf4, 0x20, 0xe1, 0x0b, 0x12, 0xd4, 0x39, 0x34, 0x06, 0x4a, 0xfd, 0x49, 0x09,
-	0x23, 0x97, 0x5f, 0x19, 0x70, 0xe6, 0x01, 0xe5, 0x2f, 0x35, 0x7b, 0x97, 0x04, 0x9c, 0xd9, 0xcc,
-	0x97, 0x8d, 0x06, 0xba, 0x9d, 0x53, 0xf8, 0x73, 0x35, 0x74, 0xa0, 0x3e, 0xca, 0x51, 0xcc, 0xd3,
-	0x52, 0x90, 0xb7, 0x24, 0xe4, 0x8b, 0x08, 0x4f, 0x84, 0xec, 0x67, 0xb0, 0xfd, 0xd6, 0x80, 0xd3,
-	0x19, 0x1c, 0x34, 0x78, 0xed, 0x05, 0x03, 0xe2, 0xda, 0x14, 0xb5, 0x0a, 0xdd, 0x27, 0xc2, 0x1a,
-	0xf2, 0xcd, 0x23, 0xe9, 0x64, 0x93, 0x10, 0xad, 0x4f, 0x06, 0x9c, 0x82, 0xf4, 0x43, 0x03, 0x4e,
-	0xa4, 0xe1, 0x86, 0xe8, 0x5a, 0x8e, 0x43, 0x71, 0x1f, 0x13, 0x31, 0x0d, 0xef, 0x7c, 0x11, 0xbc,
-	0x30, 0xaf, 0x38, 0x2a, 0x30, 0xa3, 0xc4, 0xf3, 0x2f, 0x0c, 0x58, 0x4a, 0x63, 0xd9, 0x26, 0x7d,
-	0x81, 0x31, 0x53, 0x60, 0xf2, 0x21, 0x69, 0x69, 0x8d, 0x6c, 0xb3, 0x08, 0x99, 0x56, 0xc0, 0x97,
-	0x24, 0xc0, 0x35, 0x74, 0x6e, 0x22, 0xc0, 0x3d, 0x8d, 0x62, 0x04, 0x27, 0xd3, 0xe8, 0xbe, 0x35,
-	0xa4, 0x43, 0x9a, 0x7b, 0x37, 0x2e, 0x15, 0x79, 0x97, 0xea, 0x18, 0x4b, 0xd7, 0x2b, 0xa8, 0x3e,
-	0xd1, 0xf5, 0x67, 0xd2, 0x85, 0x03, 0xe5, 0x07, 0x94, 0xef, 0x52, 0x1a, 0xe4, 0x5f, 0xc5, 0x95,
-	0x1c, 0x77, 0x52, 0xab, 0xc0, 0x8b, 0x2f, 0x64, 0x5a, 0x7f, 0x3c, 0x06, 0xa5, 0x87, 0xf2, 0xff,
-	0x25, 0xfa, 0x59, 0x94, 0xc2, 0xdb, 0x71, 0x6b, 0x98, 0x0c, 0x85, 0xb9, 0x00, 0x0a, 0xcb, 0xe5,
-	0xe4, 0xe1, 0x12, 0x5f, 0x93, 0xd0, 0x2e, 0xa3, 0x8b, 0x59, 0x68, 0xd1, 0x9f, 0x54, 0xf9, 0x53,
-	0xd4, 0xb2, 0x13, 0xef, 0xd1, 0x73, 0xce, 0xd3, 0x43, 0xd5, 0x7f, 0x50, 0x9e, 0x26, 0x4d, 0x83,
-	0xf8, 0xaa, 0x04, 0x74, 0x09, 0x5d, 0x98, 0x08, 0x48, 0xf4, 0xda, 0x4d, 0x1a, 0xbb, 0xfe, 0x0e,
-	0x80, 0x48, 0x89, 0x68, 0xa6, 0xcb, 0x05, 0xd2, 0x2c, 0x02, 0x32, 0x36, 0x14, 0xe2, 0x8b, 0x12,
-	0xc3, 0x2a, 0x5a, 0x99, 0x88, 0x61, 0xa4, 0xdc, 0x7d, 0xdf, 0x80, 0x85, 0xe7, 0x3c, 0xa0, 0x64,
-	0xb0, 0x1d, 0x8f, 0x9a, 0xb9, 0x18, 0x2e, 0x26, 0x18, 0xa2, 0x63, 0x6f, 0x04, 0xbe, 0xdd, 0x18,
-	0xdd, 0x68, 0xa4, 0x27, 0x0b, 0xdc, 0x94, 0x8e, 0xaf, 0xa0, 0x8d, 0xfc, 0xcd, 0xc7, 0x75, 0x5a,
-	0x38, 0xbe, 0x6e, 0xa0, 0x9f, 0x18, 0xb0, 0x18, 0xa1, 0x78, 0x99, 0x9e, 0x92, 0x73, 0x81, 0x5c,
-	0x3b, 0xcc, 0xa9, 0xc4, 0x80, 0x5a, 0x12, 0xd0, 0x35, 0xb4, 0x95, 0x0f, 0x28, 0xa1, 0x6a, 0x4c,
-	0xad, 0x7f, 0x4e, 0xc3, 0xcc, 0xbd, 0x21, 0xef, 0x89, 0xf3, 0x49, 0x46, 0xb2, 0x5c, 0x48, 0xad,
-	0x22, 0x48, 0x1f, 0x8e, 0x75, 0xf8, 0xbc, 0x04, 0x76, 0x16, 0x9d, 0xc9, 0x02, 0x63, 0x2e, 0xe3,
-	0x8c, 0xf4, 0xd9, 0x3b, 0xea, 0xa0, 0xcf, 0x0d, 0x38, 0xf6, 0xd8, 0xeb, 0x32, 0x17, 0x5d, 0x2d,
-	0xfc, 0x11, 0x97, 0xfc, 0x2b, 0x2a, 0x0e, 0x50, 0xfa, 0xc7, 0x8f, 0x6e, 0x88, 0xf0, 0x62, 0x16,
-	0x47, 0x5f, 0xf8, 0x15, 0x4d, 0xc6, 0x17, 0x06, 0x94, 0xc4, 0x9c, 0x39, 0xf4, 0xff, 0x9b, 0x28,
-	0xd6, 0x24, 0x8a, 0x33, 0x78, 0xac, 0x09, 0x0f, 0xa5, 0x63, 0x01, 0xe3, 0xdb, 0x50, 0x7a, 0xec,
-	0x75, 0xbd, 0x21, 0xcf, 0x3d, 0x84, 0xbc, 0x1e, 0x26, 0xc7, 0x74, 0x5f, 0x5a, 0xbb, 0x6b, 0x6c,
-	0x6d, 0x57, 0xff, 0xf0, 0x7e, 0xd5, 0xf8, 0xd3, 0xfb, 0x55, 0xe3, 0x6f, 0xef, 0x57, 0x8d, 0xbd,
-	0x92, 0x54, 0xbf, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xac, 0xc3, 0xc9, 0xeb, 0x19,
-	0x00, 0x00,
+	// 2198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0xa7, 0x6d, 0xc7, 0x19, 0x7f, 0x33, 0xb1, 0x9d, 0xb2, 0xe3, 0x4c, 0x26, 0x7e, 0xa5, 0xf3,
+	0xb0, 0xf3, 0x60, 0x26, 0x99, 0xec, 0x26, 0x11, 0x17, 0x14, 0x3b, 0xb3, 0x4e, 0xe4, 0x3c, 0x4c,
+	0x27, 0x9b, 0x88, 0xcb, 0xb6, 0xca, 0xdd, 0xe5, 0x99, 0x92, 0x67, 0xba, 0x7b, 0xbb, 0x6b, 0x26,
+	0x71, 0xe0, 0x00, 0xcb, 0x4a, 0x08, 0x24, 0x24, 0x60, 0x0f, 0x08, 0x81, 0x90, 0xe0, 0x86, 0xc4,
+	0x05, 0x09, 0x69, 0xff, 0x05, 0xb8, 0x21, 0x71, 0x45, 0x02, 0x45, 0x5c, 0x80, 0x7f, 0x02, 0xd5,
+	0xab, 0x1f, 0x93, 0x69, 0xb7, 0x2d, 0xc4, 0x85, 0x5b, 0xd7, 0xf7, 0xfc, 0xd5, 0x57, 0x5f, 0x7d,
+	0xf5, 0x7d, 0x0d, 0x57, 0x83, 0xd0, 0x67, 0x7e, 0x63, 0x80, 0xbb, 0xd4, 0xc5, 0xcc, 0x0f, 0x1b,
+	0xd8, 0x71, 0xfc, 0xbe, 0xc7, 0xa2, 0xc6, 0xa0, 0xd9, 0x78, 0x4d, 0x76, 0x6d, 0x1c, 0xd0, 0xba,
+	0x90, 0x41, 0xcb, 0x84, 0x75, 0x48, 0x48, 0xfa, 0xbd, 0x7a, 0x2c, 0x5d, 0xd7, 0xd2, 0xf5, 0x41,
+	0xb3, 0xb6, 0x2a, 0x4d, 0xed, 0x12, 0xec, 0xf8, 0x5e, 0x23, 0x0c, 0x9c, 0xc6, 0xe0, 0x56, 0xa3,
+	0x43, 0x70, 0x97, 0x75, 0xa4, 0x85, 0xda, 0x0a, 0x61, 0x9d, 0xc6, 0xe0, 0x16, 0xee, 0x06, 0x1d,
+	0x7c, 0x4b, 0x09, 0xda, 0x4e, 0x07, 0x53, 0x4f, 0x09, 0x9c, 0xcd, 0x08, 0x78, 0xbe, 0x4b, 0x14,
+	0x63, 0xb1, 0xed, 0xfb, 0xed, 0x2e, 0x69, 0xe0, 0x80, 0x36, 0xb0, 0xe7, 0xf9, 0x0c, 0x33, 0xea,
+	0x7b, 0x91, 0xe2, 0x9e, 0x57, 0x5c, 0xb1, 0xda, 0xed, 0xef, 0x35, 0x48, 0x2f, 0x60, 0x07, 0x92,
+	0x69, 0xfe, 0x7b, 0x0c, 0xe6, 0x36, 0x43, 0x82, 0x19, 0x79, 0x85, 0xbb, 0x5d, 0xc2, 0x2c, 0xf2,
+	0x69, 0x9f, 0x44, 0x0c, 0x3d, 0x05, 0xd8, 0x27, 0x07, 0x3d, 0xec, 0xe1, 0x36, 0x09, 0xab, 0xc6,
+	0xaa, 0xb1, 0x3e, 0xdd, 0xac, 0xd7, 0x0f, 0xdf, 0x63, 0x7d, 0x3b, 0xd6, 0xd8, 0xa6, 0x9e, 0x6b,
+	0xa5, 0x2c, 0xa0, 0x35, 0x98, 0x79, 0x2d, 0x1c, 0xd8, 0x01, 0x8e, 0xa2, 0xd7, 0x7e, 0xe8, 0x56,
+	0xc7, 0x56, 0x8d, 0xf5, 0x29, 0x6b, 0x5a, 0x92, 0x77, 0x14, 0x15, 0xd5, 0xa0, 0xd4, 0xf3, 0x48,
+	0xcf, 0xf7, 0xa8, 0x53, 0x1d, 0x17, 0x12, 0xf1, 0x1a, 0x5d, 0x80, 0x8a, 0xd7, 0xef, 0xd9, 0xda,
+	0x65, 0x75, 0x62, 0xd5, 0x58, 0x9f, 0xb0, 0xca, 0x5e, 0xbf, 0x77, 0x5f, 0x91, 0xd0, 0x0a, 0x94,
+	0x43, 0xd2, 0xf3, 0x19, 0xb1, 0xb1, 0xeb, 0x86, 0xd5, 0x13, 0xc2, 0x02, 0x48, 0xd2, 0x7d, 0xd7,
+	0x0d, 0xd1, 0x15, 0x98, 0x51, 0x02, 0x4e, 0xc8, 0xc1, 0xb0, 0x4e, 0x75, 0x52, 0x08, 0x9d, 0x92,
+	0xe4, 0xcd, 0x90, 0xed, 0x60, 0xd6, 0x49, 0xc9, 0xed, 0x93, 0x03, 0x29, 0x77, 0x32, 0x2d, 0xb7,
+	0x4d, 0x0e, 0x84, 0xdc, 0x75, 0x40, 0xda, 0x1e, 0x4e, 0x4c, 0x96, 0x84, 0xa8, 0xb2, 0xb0, 0x89,
+	0x95, 0x51, 0xf3, 0x13, 0x98, 0xcf, 0x06, 0x3b, 0x0a, 0x7c, 0x2f, 0x22, 0xe8, 0x23, 0x98, 0x94,
+	0x61, 0x10, 0x91, 0x2e, 0x17, 0x47, 0x3a, 0xab, 0x6f, 0x29, 0x6d, 0xf3, 0x4b, 0x03, 0xce, 0xb6,
+	0x5c, 0xca, 0x24, 0x7b, 0xd3, 0xf7, 0xf6, 0x68, 0x5b, 0x9f, 0xe8, 0x50, 0x64, 0x8c, 0xa3, 0x44,
+	0x66, 0xec, 0x88, 0x91, 0x19, 0x3f, 0x7a, 0x64, 0x26, 0x46, 0x47, 0xe6, 0x0e, 0x54, 0xb7, 0x88,
+	0x47, 0x42, 0xcc, 0xc8, 0x13, 0x75, 0xdc, 0x71, 0x74, 0xd2, 0x29, 0x61, 0x64, 0x53, 0xc2, 0xfc,
+	0xa1, 0x01, 0xd3, 0x43, 0xc1, 0x5c, 0x81, 0x72, 0x9c, 0x6a, 0xac, 0xa3, 0x37, 0xaa, 0xd3, 0x8c,
+	0x75, 0xd0, 0x2b, 0x98, 0x49, 0x32, 0xd3, 0xde, 0xa7, 0x9e, 0xcc, 0xc5, 0xe3, 0x27, 0xf8, 0xf4,
+	0x7e, 0x66, 0x6d, 0xfe, 0xd4, 0x80, 0xb9, 0xc7, 0x34, 0x62, 0x3a, 0x1b, 0x75, 0xe8, 0xbf, 0x0a,
+	0x73, 0x6d, 0xc2, 0x6c, 0x97, 0x04, 0x7e, 0x44, 0x99, 0xcd, 0xde, 0xd8, 0x2e, 0x66, 0x58, 0x20,
+	0x2b, 0x59, 0xb3, 0x6d, 0xc2, 0x1e, 0x48, 0xce, 0x8b, 0x37, 0x0f, 0x30, 0xc3, 0xe8, 0x3c, 0x4c,
+	0x05, 0xb8, 0x4d, 0xec, 0x88, 0xbe, 0x25, 0x02, 0xd9, 0x09, 0xab, 0xc4, 0x09, 0xcf, 0xe9, 0x5b,
+	0x82, 0x96, 0x00, 0x04, 0x93, 0xf9, 0xfb, 0xc4, 0x53, 0x81, 0x17, 0xe2, 0x2f, 0x38, 0x01, 0xcd,
+	0xc2, 0x38, 0xee, 0x76, 0x45, 0x94, 0x4b, 0x16, 0xff, 0x34, 0x7f, 0x63, 0xc0, 0x7c, 0x16, 0x94,
+	0x8a, 0xd3, 0x26, 0x94, 0xe2, 0x9b, 0x64, 0xac, 0x8e, 0xaf, 0x97, 0x9b, 0x6b, 0x45, 0xfb, 0x57,
+	0x36, 0xac, 0x58, 0x91, 0x27, 0x83, 0x47, 0xde, 0x30, 0x3b, 0x85, 0x49, 0x25, 0x0d, 0x27, 0xef,
+	0xc4, 0xb8, 0x96, 0x00, 0x98, 0xcf, 0x70, 0x57, 0x6e, 0x6a, 0x5c, 0x6c, 0x6a, 0x4a, 0x50, 0xf8,
+	0xae, 0xcc, 0xdf, 0x1b, 0x70, 0x52, 0x19, 0x47, 0x4d, 0x38, 0xa3, 0xbc, 0x53, 0xaf, 0x6d, 0x07,
+	0xfd, 0xdd, 0x2e, 0x75, 0x78, 0xaa, 0x89, 0x78, 0x55, 0xac, 0xb9, 0x84, 0xb9, 0x23, 0x78, 0xdb,
+	0xe4, 0x80, 0x57, 0x06, 0x05, 0xc9, 0xf6, 0x70, 0x8f, 0x28, 0x0c, 0x65, 0x45, 0x7b, 0x8a, 0x7b,
+	0x84, 0x23, 0x1d, 0x3e, 0x80, 0x71, 0x61, 0xf0, 0x94, 0x9b, 0x89, 0xfe, 0x1a, 0x97, 0x0b, 0xe9,
+	0x40, 0xd4, 0xd0, 0x74, 0xce, 0x4e, 0x27, 0x64, 0x91, 0xb2, 0xdb, 0x30, 0xad, 0xe3, 0x91, 0x5c,
+	0xb1, 0x04, 0xae, 0x0c, 0x6a, 0xc5, 0x82, 0x40, 0xa3, 0x8c, 0x50, 0x15, 0x4e, 0x52, 0xcf, 0xa5,
+	0x0e, 0x89, 0xaa, 0x63, 0xab, 0xe3, 0xeb, 0x13, 0x96, 0x5e, 0x9a, 0x9f, 0x40, 0xf9, 0x7e, 0x9f,
+	0x75, 0xb4, 0xa5, 0x1a, 0x94, 0xe2, 0x3a, 0xa9, 0x52, 0x5e, 0xaf, 0xd1, 0x6d, 0x38, 0xa3, 0xbf,
+	0x6d, 0x87, 0x5f, 0xf1, 0xb0, 0x27, 0x40, 0xa9, 0x4d, 0xcf, 0x6b, 0xe6, 0x66, 0x8a, 0x67, 0x3e,
+	0x83, 0x8a, 0xb4, 0xaf, 0x0e, 0x7f, 0x1e, 0x4e, 0xc8, 0xd3, 0x92, 0xd6, 0xe5, 0x02, 0x5d, 0x85,
+	0x59, 0xf1, 0x61, 0x93, 0x37, 0x01, 0x0d, 0x13, 0xab, 0x13, 0xd6, 0x8c, 0xa0, 0xb7, 0x62, 0xb2,
+	0xf9, 0x37, 0x03, 0x16, 0x9e, 0xfa, 0x2e, 0xd9, 0xf4, 0x3d, 0x8f, 0x38, 0x9c, 0x14, 0xdb, 0xbe,
+	0x09, 0xf3, 0xea, 0xf5, 0xe2, 0x6f, 0x94, 0x4d, 0x3c, 0x37, 0xf0, 0xa9, 0xc7, 0x94, 0x2b, 0x24,
+	0x79, 0x5c, 0xb7, 0xa5, 0x38, 0x68, 0x11, 0xa6, 0x1c, 0x69, 0x87, 0xc8, 0xbb, 0x58, 0xb2, 0x12,
+	0x02, 0x8f, 0x5a, 0x74, 0xe0, 0x39, 0xd4, 0x6b, 0x8b, 0x13, 0x2b, 0x59, 0x7a, 0xc9, 0x8f, 0xbd,
+	0x4d, 0x3c, 0x12, 0xd1, 0xc8, 0x66, 0xb4, 0x47, 0xf4, 0x83, 0xa0, 0x68, 0x2f, 0x68, 0x8f, 0xa0,
+	0x7b, 0x50, 0xd5, 0xc7, 0xee, 0xf8, 0x1e, 0x0b, 0xb1, 0xc3, 0x44, 0x01, 0x24, 0x51, 0x24, 0x5e,
+	0x87, 0x8a, 0xb5, 0xa0, 0xf8, 0x9b, 0x8a, 0x7d, 0x5f, 0x72, 0xcd, 0xef, 0xf0, 0x8b, 0xe3, 0xb7,
+	0x23, 0x8d, 0x32, 0xde, 0xdf, 0x1d, 0x38, 0x1b, 0x5f, 0x0f, 0xbb, 0xeb, 0xb7, 0xa3, 0xe1, 0x2d,
+	0x9e, 0x89, 0xd9, 0x69, 0xfd, 0x54, 0x5c, 0xb2, 0x4a, 0x63, 0xe9, 0xb8, 0xa4, 0x35, 0xcc, 0x2d,
+	0x98, 0x79, 0x49, 0xc2, 0x28, 0x1d, 0xdc, 0x05, 0x98, 0x94, 0x82, 0xca, 0x97, 0x5a, 0xf1, 0x10,
+	0xc6, 0x5e, 0x95, 0xc5, 0x84, 0x60, 0x7e, 0x61, 0xc0, 0x99, 0xcd, 0x0e, 0xf6, 0xda, 0x44, 0x3f,
+	0xb4, 0x3a, 0xd3, 0xae, 0xc2, 0xac, 0xd3, 0x0f, 0x43, 0xe2, 0xa5, 0x5e, 0x66, 0x69, 0x79, 0x46,
+	0xd1, 0xd3, 0x4f, 0xf3, 0xd0, 0xe3, 0x7d, 0x84, 0xa4, 0x1c, 0x3f, 0x24, 0x29, 0xef, 0xc1, 0xe9,
+	0x87, 0x38, 0x1a, 0x2a, 0xdf, 0x17, 0xe1, 0x94, 0x2a, 0xdf, 0xe4, 0x0d, 0x8d, 0x44, 0x6d, 0xe2,
+	0x67, 0x5e, 0x91, 0xc4, 0x96, 0xa0, 0x99, 0x03, 0x58, 0x78, 0xd4, 0x0b, 0xfc, 0x90, 0xf1, 0x6b,
+	0xc5, 0xfc, 0x90, 0xa4, 0x6a, 0x2d, 0xda, 0xd7, 0x34, 0x9b, 0x0a, 0x19, 0xe2, 0x8a, 0xab, 0x38,
+	0x65, 0x9d, 0x8e, 0x39, 0x8f, 0x14, 0x23, 0x2b, 0x3e, 0xb4, 0xbb, 0x44, 0x5c, 0x87, 0xc0, 0xdc,
+	0x86, 0xb3, 0xef, 0xf9, 0x4d, 0xb2, 0x5e, 0xbb, 0xb3, 0xdf, 0xaf, 0x02, 0x48, 0xf3, 0xe2, 0x9a,
+	0x15, 0x99, 0xaf, 0x00, 0x3d, 0xc4, 0xd1, 0xc7, 0x11, 0x71, 0x5f, 0x91, 0xdd, 0xd8, 0x8e, 0x09,
+	0xa7, 0x3a, 0x38, 0xb2, 0x23, 0xda, 0xf6, 0x88, 0x6b, 0xf7, 0x03, 0xb5, 0xff, 0x72, 0x07, 0x47,
+	0xcf, 0x05, 0xed, 0xe3, 0x80, 0x57, 0x53, 0x2e, 0xa3, 0x7a, 0x06, 0x75, 0x61, 0x3a, 0x3a, 0x94,
+	0xa6, 0x09, 0x15, 0x9e, 0x46, 0xb1, 0x49, 0x04, 0x13, 0x3c, 0xe3, 0x54, 0x14, 0xc4, 0xb7, 0xf9,
+	0xab, 0x31, 0x98, 0xdf, 0x10, 0xa9, 0xf3, 0x9c, 0x61, 0xd6, 0x8f, 0xfe, 0xcf, 0x6e, 0x2f, 0xfa,
+	0x3a, 0x80, 0xe8, 0x9d, 0xed, 0x0e, 0xc1, 0xae, 0x68, 0xf1, 0xca, 0xcd, 0xd5, 0xe4, 0x7d, 0x23,
+	0xac, 0x53, 0xd7, 0xad, 0x74, 0x7d, 0x93, 0x0b, 0x3e, 0x24, 0xd8, 0xb5, 0xa6, 0x1c, 0xfd, 0x69,
+	0x62, 0x38, 0xb3, 0x81, 0x9d, 0xfd, 0x7e, 0x30, 0xfc, 0x9a, 0x17, 0x56, 0xf9, 0x35, 0x98, 0xd9,
+	0x15, 0x9a, 0xef, 0xf5, 0xba, 0x92, 0x1c, 0x67, 0xd3, 0x6d, 0x58, 0x18, 0x76, 0xa1, 0x0e, 0xe1,
+	0x1c, 0x94, 0xde, 0xd2, 0xc0, 0xde, 0xa3, 0x5d, 0xa2, 0x9e, 0xbd, 0x93, 0x6f, 0x69, 0xf0, 0x11,
+	0xed, 0x12, 0xf3, 0x26, 0xcc, 0x6c, 0x85, 0x78, 0x6f, 0x8f, 0x32, 0xaa, 0x11, 0x2d, 0x81, 0x72,
+	0x9f, 0x7a, 0x26, 0xa7, 0x62, 0x40, 0xe6, 0x13, 0x98, 0x4d, 0x34, 0x94, 0x83, 0xc3, 0x55, 0xf8,
+	0x55, 0x6f, 0x2b, 0x15, 0x7d, 0xd5, 0xf5, 0xda, 0x7c, 0x06, 0xe8, 0x39, 0x61, 0xc7, 0xc3, 0x70,
+	0x98, 0xc1, 0x6b, 0x77, 0x61, 0x3a, 0xdb, 0x58, 0xa1, 0x32, 0x9c, 0x7c, 0xd0, 0xb2, 0x1e, 0xbd,
+	0x6c, 0x3d, 0x98, 0xfd, 0x0a, 0xaa, 0x40, 0xe9, 0xd1, 0x93, 0x9d, 0x67, 0xd6, 0x8b, 0xd6, 0x83,
+	0x59, 0x03, 0x01, 0x4c, 0x5a, 0xad, 0x27, 0xcf, 0x5e, 0xb4, 0x66, 0xc7, 0x9a, 0xff, 0x9c, 0x80,
+	0x49, 0x99, 0xf2, 0xe8, 0xd7, 0x06, 0x54, 0xd2, 0xad, 0x35, 0xba, 0x5d, 0xd4, 0xcb, 0x8c, 0x98,
+	0x7a, 0x6a, 0x1f, 0x1c, 0x4f, 0x49, 0xc6, 0xd2, 0xbc, 0xf2, 0xd9, 0x5f, 0xfe, 0xf1, 0xc5, 0xd8,
+	0xea, 0xd7, 0x8c, 0x6b, 0xe6, 0x79, 0x3e, 0x18, 0xc6, 0xaa, 0x0d, 0x79, 0x41, 0x1b, 0x8e, 0xd0,
+	0x42, 0x0c, 0x2a, 0xe9, 0xc6, 0x1c, 0x2d, 0xd4, 0xe5, 0x64, 0x56, 0xd7, 0x93, 0x59, 0xbd, 0xc5,
+	0x27, 0xb3, 0xda, 0x31, 0xbb, 0x7f, 0x73, 0x51, 0xf8, 0x5f, 0x40, 0xf3, 0xa3, 0x9c, 0xa3, 0x1f,
+	0x19, 0x30, 0x3b, 0xdc, 0x5a, 0xe7, 0xba, 0xbe, 0x57, 0xe4, 0x3a, 0xaf, 0x49, 0x37, 0xd7, 0x04,
+	0x88, 0x0b, 0x68, 0x25, 0x0b, 0x42, 0x37, 0xea, 0x8d, 0xb6, 0x52, 0x44, 0x7f, 0x30, 0x60, 0x66,
+	0xa8, 0x86, 0xa2, 0x3b, 0x45, 0x6e, 0x47, 0x17, 0xfb, 0xda, 0xdd, 0x63, 0xeb, 0x29, 0xb4, 0x37,
+	0x05, 0xda, 0x6b, 0xfc, 0xc8, 0x2e, 0x8f, 0x3c, 0xb2, 0xb8, 0xf4, 0x37, 0x64, 0xe1, 0x6e, 0xfe,
+	0x75, 0x1c, 0x4a, 0xf1, 0x94, 0xf9, 0x73, 0x03, 0x2a, 0xe9, 0x9e, 0xba, 0x38, 0xdb, 0x46, 0x8c,
+	0x05, 0xb5, 0x0f, 0x8e, 0xa7, 0xa4, 0xa0, 0x2f, 0x0b, 0xe8, 0x55, 0xb4, 0x90, 0xc5, 0xad, 0xf5,
+	0xd0, 0x6f, 0x0d, 0x98, 0xce, 0x56, 0x15, 0xf4, 0x61, 0x91, 0xa3, 0x91, 0x85, 0xae, 0x76, 0xe7,
+	0xb8, 0x6a, 0x0a, 0xe1, 0xba, 0x40, 0x68, 0xf2, 0xe0, 0x2e, 0x8d, 0x06, 0xd9, 0x90, 0x85, 0x10,
+	0x7d, 0xdf, 0x80, 0xe9, 0x6c, 0x5b, 0x52, 0x8c, 0x75, 0x64, 0x1b, 0x53, 0xcb, 0x49, 0xe8, 0x43,
+	0xee, 0xa6, 0xae, 0xcd, 0x0d, 0xe2, 0x52, 0xd6, 0xfc, 0x6e, 0x09, 0x26, 0xe5, 0x73, 0x88, 0x3e,
+	0x37, 0x60, 0x66, 0x8b, 0xb0, 0xf4, 0xe3, 0x98, 0x7b, 0x5f, 0x0a, 0x8f, 0x70, 0xd4, 0x13, 0x6b,
+	0x5e, 0x14, 0xa0, 0x96, 0xd0, 0x10, 0x22, 0xf5, 0x6f, 0x28, 0x92, 0x2e, 0xbf, 0x34, 0xe0, 0xdc,
+	0x16, 0x61, 0x2f, 0x35, 0x7b, 0x07, 0x87, 0x8c, 0x3a, 0x34, 0x10, 0xad, 0x13, 0xba, 0x9b, 0xf3,
+	0x94, 0xe5, 0x6a, 0xe8, 0x40, 0x7d, 0x98, 0xa3, 0x98, 0xa7, 0xa5, 0x20, 0x5f, 0x13, 0x90, 0x2f,
+	0x21, 0x73, 0x24, 0xe4, 0x20, 0x83, 0xed, 0x77, 0x06, 0x9c, 0xcd, 0xe0, 0x20, 0xe1, 0x9e, 0x1f,
+	0xf6, 0xb0, 0xe7, 0x10, 0xd4, 0x2c, 0x74, 0x9f, 0x08, 0x6b, 0xc8, 0xb7, 0x8f, 0xa5, 0x93, 0x4d,
+	0x42, 0xb4, 0x3a, 0x1a, 0x70, 0x0a, 0xd2, 0x0f, 0x0c, 0x38, 0x95, 0x86, 0x1b, 0xa1, 0x1b, 0x39,
+	0x0e, 0xf9, 0x7d, 0x4c, 0xc4, 0x34, 0xbc, 0x0b, 0x45, 0xf0, 0xa2, 0xbc, 0xe2, 0xa8, 0xc0, 0x0c,
+	0x12, 0xcf, 0xbf, 0x34, 0x60, 0x3e, 0x8d, 0x65, 0x03, 0x77, 0x39, 0xc6, 0x4c, 0x81, 0xc9, 0x87,
+	0xa4, 0xa5, 0x35, 0xb2, 0xf5, 0x22, 0x64, 0x5a, 0xc1, 0xbc, 0x2c, 0x00, 0xae, 0xa0, 0xa5, 0x91,
+	0x00, 0x77, 0x35, 0x8a, 0x01, 0x9c, 0x4e, 0xa3, 0xfb, 0x46, 0x9f, 0xf4, 0x49, 0xee, 0xdd, 0xb8,
+	0x5c, 0xe4, 0x5d, 0xa8, 0x9b, 0xa6, 0x70, 0xbd, 0x88, 0x6a, 0x23, 0x5d, 0x7f, 0x2a, 0x5c, 0xb8,
+	0x50, 0xda, 0x22, 0x6c, 0x87, 0x90, 0x30, 0xff, 0x2a, 0x2e, 0xe6, 0xb8, 0x13, 0x5a, 0x05, 0x5e,
+	0x02, 0x2e, 0xd3, 0xfc, 0xd3, 0x09, 0x98, 0x7c, 0x28, 0xfe, 0xc8, 0xa2, 0x9f, 0xc9, 0x14, 0xde,
+	0x88, 0x9b, 0xdd, 0x64, 0xcc, 0xcd, 0x05, 0x50, 0x58, 0x2e, 0x47, 0x8f, 0xcb, 0xe6, 0x0d, 0x01,
+	0xed, 0x0a, 0xba, 0x94, 0x85, 0x26, 0xff, 0x0d, 0x8b, 0xdf, 0xbc, 0xb6, 0x93, 0x78, 0x97, 0xcf,
+	0x39, 0x4b, 0x8f, 0x89, 0xff, 0x45, 0x79, 0x1a, 0x35, 0xdf, 0x9a, 0xd7, 0x05, 0xa0, 0xcb, 0xe8,
+	0xe2, 0x48, 0x40, 0x7c, 0x7a, 0x68, 0x90, 0xd8, 0xf5, 0xb7, 0x00, 0x78, 0x4a, 0xc8, 0x29, 0x35,
+	0x17, 0x48, 0xa3, 0x08, 0xc8, 0xd0, 0x98, 0x6b, 0x5e, 0x12, 0x18, 0x96, 0xd1, 0xe2, 0x48, 0x0c,
+	0x03, 0xe5, 0xee, 0x7b, 0x06, 0xcc, 0x3e, 0x67, 0x21, 0xc1, 0xbd, 0x8d, 0x78, 0x78, 0xce, 0xc5,
+	0x70, 0x29, 0xc1, 0x20, 0x8f, 0xbd, 0x1e, 0x06, 0x4e, 0x7d, 0x70, 0xab, 0x9e, 0x9e, 0x95, 0xcc,
+	0x86, 0x70, 0x7c, 0x15, 0xad, 0xe5, 0x6f, 0x3e, 0xae, 0xd3, 0xdc, 0xf1, 0x4d, 0x03, 0xfd, 0xc4,
+	0x80, 0x39, 0x89, 0xe2, 0x65, 0x7a, 0xee, 0xcf, 0x05, 0x72, 0xe3, 0x28, 0xa7, 0x12, 0x03, 0x6a,
+	0x0a, 0x40, 0x37, 0xd0, 0xb5, 0x7c, 0x40, 0x09, 0x55, 0x63, 0x6a, 0xfe, 0x6b, 0x1c, 0x26, 0xf8,
+	0x0f, 0x1f, 0x7e, 0x3e, 0xc9, 0x90, 0x99, 0x0b, 0xa9, 0x59, 0x04, 0xe9, 0xfd, 0x41, 0xd5, 0xbc,
+	0x20, 0x80, 0x9d, 0x47, 0xe7, 0xb2, 0xc0, 0xa8, 0x47, 0x19, 0xc5, 0x5d, 0xfa, 0x96, 0xb8, 0xe8,
+	0x33, 0x03, 0x4e, 0x3c, 0xf6, 0xdb, 0xd4, 0x43, 0xd7, 0x0b, 0x7f, 0x2d, 0x26, 0x7f, 0xbf, 0x6a,
+	0x37, 0x8e, 0x26, 0x9c, 0x6d, 0x88, 0xf8, 0x13, 0x3f, 0x97, 0x85, 0xd2, 0x15, 0xae, 0x3f, 0x37,
+	0x60, 0x92, 0x4f, 0xce, 0xfd, 0xe0, 0x7f, 0x89, 0x62, 0x45, 0xa0, 0x38, 0xc7, 0x51, 0x0c, 0xf5,
+	0xe1, 0x91, 0xf4, 0xfd, 0x4d, 0x98, 0x7c, 0xec, 0xb7, 0xfd, 0x3e, 0xcb, 0x3d, 0x84, 0x1c, 0xfa,
+	0x21, 0xa6, 0xbb, 0xc2, 0x60, 0xf3, 0x17, 0x63, 0x50, 0xd2, 0xf3, 0x18, 0xfa, 0xb1, 0x01, 0xe5,
+	0xad, 0x64, 0x3e, 0x43, 0x85, 0x57, 0x6f, 0x68, 0x92, 0xab, 0xdd, 0x3c, 0xba, 0xc2, 0xe1, 0x2d,
+	0xa9, 0x1e, 0xf0, 0xd0, 0xb7, 0xa1, 0x9c, 0x9a, 0x18, 0x51, 0x61, 0xb2, 0xbd, 0x3f, 0x5e, 0xe6,
+	0xc6, 0x46, 0x25, 0x21, 0x8f, 0x4d, 0x8e, 0xf7, 0x8d, 0xca, 0x1f, 0xdf, 0x2d, 0x1b, 0x7f, 0x7e,
+	0xb7, 0x6c, 0xfc, 0xfd, 0xdd, 0xb2, 0xb1, 0x3b, 0x29, 0x0c, 0xdc, 0xfe, 0xcf, 0x00, 0x5c, 0x7a,
+	0x94, 0xa3, 0xdb, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2682,6 +2897,114 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
+// GraffitiClient is the client API for Graffiti service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GraffitiClient interface {
+	GetGraffiti(ctx context.Context, in *GraffitiRequest, opts ...grpc.CallOption) (*GraffitiResponse, error)
+	SetGraffiti(ctx context.Context, in *SetGraffitiRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type graffitiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGraffitiClient(cc *grpc.ClientConn) GraffitiClient {
+	return &graffitiClient{cc}
+}
+
+func (c *graffitiClient) GetGraffiti(ctx context.Context, in *GraffitiRequest, opts ...grpc.CallOption) (*GraffitiResponse, error) {
+	out := new(GraffitiResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Graffiti/GetGraffiti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graffitiClient) SetGraffiti(ctx context.Context, in *SetGraffitiRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Graffiti/SetGraffiti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GraffitiServer is the server API for Graffiti service.
+type GraffitiServer interface {
+	GetGraffiti(context.Context, *GraffitiRequest) (*GraffitiResponse, error)
+	SetGraffiti(context.Context, *SetGraffitiRequest) (*types.Empty, error)
+}
+
+// UnimplementedGraffitiServer can be embedded to have forward compatible implementations.
+type UnimplementedGraffitiServer struct {
+}
+
+func (*UnimplementedGraffitiServer) GetGraffiti(ctx context.Context, req *GraffitiRequest) (*GraffitiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGraffiti not implemented")
+}
+func (*UnimplementedGraffitiServer) SetGraffiti(ctx context.Context, req *SetGraffitiRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGraffiti not implemented")
+}
+
+func RegisterGraffitiServer(s *grpc.Server, srv GraffitiServer) {
+	s.RegisterService(&_Graffiti_serviceDesc, srv)
+}
+
+func _Graffiti_GetGraffiti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraffitiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraffitiServer).GetGraffiti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Graffiti/GetGraffiti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraffitiServer).GetGraffiti(ctx, req.(*GraffitiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graffiti_SetGraffiti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGraffitiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraffitiServer).SetGraffiti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Graffiti/SetGraffiti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraffitiServer).SetGraffiti(ctx, req.(*SetGraffitiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Graffiti_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Graffiti",
+	HandlerType: (*GraffitiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGraffiti",
+			Handler:    _Graffiti_GetGraffiti_Handler,
+		},
+		{
+			MethodName: "SetGraffiti",
+			Handler:    _Graffiti_SetGraffiti_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
 func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3779,66 +4102,182 @@ func (m *BackupAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWebApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GraffitiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GraffitiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GraffitiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Keymanager != 0 {
-		n += 1 + sovWebApi(uint64(m.Keymanager))
-	}
-	l = len(m.WalletPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.NumAccounts != 0 {
-		n += 1 + sovWebApi(uint64(m.NumAccounts))
-	}
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GraffitiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GraffitiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GraffitiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Wallet != nil {
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetGraffitiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGraffitiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGraffitiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keymanager != 0 {
+		n += 1 + sovWebApi(uint64(m.Keymanager))
+	}
+	l = len(m.WalletPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.NumAccounts != 0 {
+		n += 1 + sovWebApi(uint64(m.NumAccounts))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Wallet != nil {
 		l = m.Wallet.Size()
 		n += 1 + l + sovWebApi(uint64(l))
 	}
@@ -4308,6 +4747,62 @@ func (m *BackupAccountsResponse) Size() (n int) {
 	return n
 }
 
+func (m *GraffitiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GraffitiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetGraffitiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWebApi(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7291,6 +7786,325 @@ func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GraffitiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraffitiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraffitiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GraffitiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GraffitiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GraffitiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetGraffitiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetGraffitiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetGraffitiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWebApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
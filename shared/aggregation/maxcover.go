@@ -23,11 +23,17 @@ var ErrInvalidMaxCoverProblem = errors.New("invalid max_cover problem")
 // additional invariant is enforced: all elements of S' must be disjoint. This comes handy when
 // we need to aggregate bitsets, and overlaps are not allowed.
 //
+// When weights are provided, the weighted variant of the problem is solved instead: each element
+// of U has a weight, and the collection S' maximizing the total weight of covered elements is
+// searched for.
+//
 // For more details, see:
 // "Analysis of the Greedy Approach in Problems of Maximum k-Coverage" by Hochbaum and Pathria.
 // https://hochbaum.ieor.berkeley.edu/html/pub/HPathria-max-k-coverage-greedy.pdf
 type MaxCoverProblem struct {
 	Candidates MaxCoverCandidates
+	// Weights holds an optional weight for each element of U, indexed by bit position.
+	Weights []uint64
 }
 
 // MaxCoverCandidate represents a candidate set to be used in aggregation.
@@ -67,12 +73,20 @@ func (mc *MaxCoverProblem) Cover(k int, allowOverlaps bool) (*Aggregation, error
 	if remainingBits == nil {
 		return nil, errors.Wrap(ErrInvalidMaxCoverProblem, "empty bitlists")
 	}
+	if mc.Weights != nil && uint64(len(mc.Weights)) != remainingBits.Len() {
+		return nil, errors.Wrap(ErrInvalidMaxCoverProblem, "weights do not match bitlist length")
+	}
 
 	for len(solution.Keys) < k && len(mc.Candidates) > 0 {
 		// Score candidates against remaining bits.
 		// Filter out processed and overlapping (when disallowed).
 		// Sort by score in a descending order.
-		mc.Candidates.score(remainingBits).filter(solution.Coverage, allowOverlaps).sort()
+		if mc.Weights != nil {
+			mc.Candidates.weightedScore(remainingBits, mc.Weights)
+		} else {
+			mc.Candidates.score(remainingBits)
+		}
+		mc.Candidates.filter(solution.Coverage, allowOverlaps).sort()
 
 		for _, candidate := range mc.Candidates {
 			if len(solution.Keys) >= k {
@@ -181,6 +195,21 @@ func (cl *MaxCoverCandidates) score(uncovered bitfield.Bitlist) *MaxCoverCandida
 	return cl
 }
 
+// weightedScore updates scores of candidates to the total weight of their uncovered elements.
+func (cl *MaxCoverCandidates) weightedScore(uncovered bitfield.Bitlist, weights []uint64) *MaxCoverCandidates {
+	for i := 0; i < len(*cl); i++ {
+		if (*cl)[i].bits.Len() != uncovered.Len() {
+			continue
+		}
+		score := uint64(0)
+		for _, idx := range (*cl)[i].bits.And(uncovered).BitIndices() {
+			score += weights[idx]
+		}
+		(*cl)[i].score = score
+	}
+	return cl
+}
+
 // filter removes processed, overlapping and zero-score candidates.
 func (cl *MaxCoverCandidates) filter(covered bitfield.Bitlist, allowOverlaps bool) *MaxCoverCandidates {
 	overlaps := func(e bitfield.Bitlist) bool {
@@ -492,6 +492,74 @@ func TestMaxCover_MaxCoverProblem_Cover(t *testing.T) {
 	}
 }
 
+func TestMaxCover_MaxCoverProblem_WeightedCover(t *testing.T) {
+	problemSet := func() MaxCoverCandidates {
+		return MaxCoverCandidates{
+			{0, &bitfield.Bitlist{0b00000100, 0b1}, 0, false},
+			{1, &bitfield.Bitlist{0b00011011, 0b1}, 0, false},
+			{2, &bitfield.Bitlist{0b00011011, 0b1}, 0, false},
+			{3, &bitfield.Bitlist{0b00000001, 0b1}, 0, false},
+			{4, &bitfield.Bitlist{0b00011010, 0b1}, 0, false},
+		}
+	}
+	tests := []struct {
+		name      string
+		k         int
+		weights   []uint64
+		want      *Aggregation
+		wantedErr string
+	}{
+		{
+			name:      "weights of wrong length",
+			k:         1,
+			weights:   []uint64{1, 1, 1},
+			wantedErr: "weights do not match bitlist length",
+		},
+		{
+			name:    "heavy element preferred over larger set",
+			k:       1,
+			weights: []uint64{1, 1, 100, 1, 1, 0, 0, 0},
+			want: &Aggregation{
+				Coverage: bitfield.Bitlist{0b00000100, 0b1},
+				Keys:     []int{0},
+			},
+		},
+		{
+			name:    "k=2",
+			k:       2,
+			weights: []uint64{1, 1, 100, 1, 1, 0, 0, 0},
+			want: &Aggregation{
+				Coverage: bitfield.Bitlist{0b00011111, 0b1},
+				Keys:     []int{0, 1},
+			},
+		},
+		{
+			name:    "zero weight sets are not selected",
+			k:       5,
+			weights: []uint64{1, 0, 0, 0, 0, 0, 0, 0},
+			want: &Aggregation{
+				Coverage: bitfield.Bitlist{0b00011011, 0b1},
+				Keys:     []int{1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MaxCoverProblem{
+				Candidates: problemSet(),
+				Weights:    tt.weights,
+			}
+			got, err := mc.Cover(tt.k, true)
+			if tt.wantedErr != "" {
+				assert.ErrorContains(t, tt.wantedErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.DeepEqual(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func TestMaxCover_MaxCover(t *testing.T) {
 	problemSet := func() []*bitfield.Bitlist64 {
 		return []*bitfield.Bitlist64{
@@ -18,6 +18,13 @@ var (
 		Name:  "e2e-config",
 		Usage: "Use the E2E testing config, only for use within end-to-end testing.",
 	}
+	// E2EClockSkewFlag shifts the clock of the client by the given duration, only for use
+	// within end-to-end testing.
+	E2EClockSkewFlag = &cli.DurationFlag{
+		Name:   "e2e-clock-skew",
+		Usage:  "Shift the clock of the client by the given duration, only for use within end-to-end testing.",
+		Hidden: true,
+	}
 	// RPCMaxPageSizeFlag defines the maximum numbers per page returned in RPC responses from this
 	// beacon node (default: 500).
 	RPCMaxPageSizeFlag = &cli.IntFlag{
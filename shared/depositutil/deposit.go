@@ -3,6 +3,8 @@
 package depositutil
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -115,3 +117,46 @@ func VerifyDepositSignature(dd *ethpb.Deposit_Data, domain []byte) error {
 	}
 	return nil
 }
+
+// DepositDataJSON is an entry of the deposit_data.json files produced by the
+// eth2.0-deposit-cli and accepted by the eth2 launchpad. Byte fields are hex
+// encoded without a 0x prefix.
+type DepositDataJSON struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	Eth2NetworkName       string `json:"eth2_network_name"`
+}
+
+// DepositDataToJSON converts deposit data signed for the genesis fork version of
+// the current beacon config, such as the one built by DepositInput, to its
+// deposit_data.json representation.
+func DepositDataToJSON(dd *ethpb.Deposit_Data) (*DepositDataJSON, error) {
+	depositMessage := &p2ppb.DepositMessage{
+		PublicKey:             dd.PublicKey,
+		WithdrawalCredentials: dd.WithdrawalCredentials,
+		Amount:                dd.Amount,
+	}
+	messageRoot, err := depositMessage.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit message root")
+	}
+	dataRoot, err := dd.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit data root")
+	}
+	return &DepositDataJSON{
+		PubKey:                fmt.Sprintf("%x", dd.PublicKey),
+		WithdrawalCredentials: fmt.Sprintf("%x", dd.WithdrawalCredentials),
+		Amount:                dd.Amount,
+		Signature:             fmt.Sprintf("%x", dd.Signature),
+		DepositMessageRoot:    fmt.Sprintf("%x", messageRoot),
+		DepositDataRoot:       fmt.Sprintf("%x", dataRoot),
+		ForkVersion:           fmt.Sprintf("%x", params.BeaconConfig().GenesisForkVersion),
+		Eth2NetworkName:       params.BeaconConfig().ConfigName,
+	}, nil
+}
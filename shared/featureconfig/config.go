@@ -48,6 +48,9 @@ type Flags struct {
 	WriteWalletPasswordOnWebOnboarding bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
 	DisableAttestingHistoryDBCache     bool // DisableAttestingHistoryDBCache for the validator client increases disk reads/writes.
 	UpdateHeadTimely                   bool // UpdateHeadTimely updates head right after state transition.
+	EnableRewardMaximizingAttestations bool // EnableRewardMaximizingAttestations packs attestations into blocks by maximizing the proposer reward.
+	EnableAttestationPackingReport     bool // EnableAttestationPackingReport measures the quality of the attestations packed into proposed blocks.
+	EnableBlockIndices                 bool // EnableBlockIndices maintains the db indices of blocks by proposer index, graffiti and attesters.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -184,6 +187,18 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(updateHeadTimely.Name, updateHeadTimely.Usage).Warn(enabledFeatureFlag)
 		cfg.UpdateHeadTimely = true
 	}
+	if ctx.Bool(enableRewardMaximizingAttestations.Name) {
+		log.WithField(enableRewardMaximizingAttestations.Name, enableRewardMaximizingAttestations.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableRewardMaximizingAttestations = true
+	}
+	if ctx.Bool(enableAttestationPackingReport.Name) {
+		log.WithField(enableAttestationPackingReport.Name, enableAttestationPackingReport.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableAttestationPackingReport = true
+	}
+	if ctx.Bool(enableBlockIndices.Name) {
+		log.WithField(enableBlockIndices.Name, enableBlockIndices.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableBlockIndices = true
+	}
 	Init(cfg)
 }
 
@@ -109,6 +109,21 @@ var (
 		Name:  "update-head-timely",
 		Usage: "Improves update head time by updating head right after state transition",
 	}
+	enableRewardMaximizingAttestations = &cli.BoolFlag{
+		Name: "enable-reward-maximizing-attestations",
+		Usage: "Selects the attestations packed into proposed blocks to maximize the proposer reward, " +
+			"skipping votes already counted on chain",
+	}
+	enableAttestationPackingReport = &cli.BoolFlag{
+		Name: "enable-attestation-packing-report",
+		Usage: "Measures the quality of the attestations packed into each proposed block, exposed as metrics " +
+			"and on the debug endpoint, at the cost of a state copy per proposal",
+	}
+	enableBlockIndices = &cli.BoolFlag{
+		Name: "enable-block-indices",
+		Usage: "Maintains database indices of blocks by proposer index, graffiti and included attesters " +
+			"for block explorers, at the cost of disk space",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -162,6 +177,9 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableNextSlotStateCache,
 	forceOptMaxCoverAggregationStategy,
 	updateHeadTimely,
+	enableRewardMaximizingAttestations,
+	enableAttestationPackingReport,
+	enableBlockIndices,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
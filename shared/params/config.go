@@ -111,6 +111,7 @@ type BeaconChainConfig struct {
 	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPeersToSync            int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
 	SlotsPerArchivedPoint     types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	SlotsPerStateDiff         types.Slot    // SlotsPerStateDiff defines the number of slots per one state diff between archived points, 0 to disable state diffs.
 	GenesisCountdownInterval  time.Duration // How often to log the countdown until the genesis time is reached.
 	BeaconStateFieldCount     int           // BeaconStateFieldCount defines how many fields are in beacon state.
 
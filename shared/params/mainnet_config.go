@@ -158,6 +158,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DefaultPageSize:           250,
 	MaxPeersToSync:            15,
 	SlotsPerArchivedPoint:     2048,
+	SlotsPerStateDiff:         0,
 	GenesisCountdownInterval:  time.Minute,
 	ConfigName:                ConfigNames[Mainnet],
 	BeaconStateFieldCount:     21,
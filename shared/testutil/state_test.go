@@ -12,11 +12,11 @@ import (
 func TestNewBeaconState(t *testing.T) {
 	st, err := NewBeaconState()
 	require.NoError(t, err)
-	b, err := st.InnerStateUnsafe().MarshalSSZ()
+	b, err := st.ToProtoUnsafe().MarshalSSZ()
 	require.NoError(t, err)
 	got := &pb.BeaconState{}
 	require.NoError(t, got.UnmarshalSSZ(b))
-	if !reflect.DeepEqual(st.InnerStateUnsafe(), got) {
+	if !reflect.DeepEqual(st.ToProtoUnsafe(), got) {
 		t.Fatal("State did not match after round trip marshal")
 	}
 }
@@ -0,0 +1,67 @@
+package detection
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+)
+
+// markIncludedSlashings looks for slashings previously detected by the slasher
+// within the body of a block received from the beacon node and updates their
+// status in the slasher DB to included.
+func (s *Service) markIncludedSlashings(ctx context.Context, body *ethpb.BeaconBlockBody) error {
+	ctx, span := trace.StartSpan(ctx, "detection.markIncludedSlashings")
+	defer span.End()
+	if body == nil {
+		return nil
+	}
+	for _, slashing := range body.ProposerSlashings {
+		if slashing == nil {
+			continue
+		}
+		// The beacon node may order the headers differently from the slasher,
+		// so we check for the detected slashing in both orders.
+		candidates := []*ethpb.ProposerSlashing{
+			slashing,
+			{Header_1: slashing.Header_2, Header_2: slashing.Header_1},
+		}
+		for _, candidate := range candidates {
+			found, st, err := s.slasherDB.HasProposerSlashing(ctx, candidate)
+			if err != nil {
+				return err
+			}
+			if !found || st == status.Included {
+				continue
+			}
+			if err := s.slasherDB.SaveProposerSlashing(ctx, status.Included, candidate); err != nil {
+				return err
+			}
+			proposerSlashingsIncluded.Inc()
+		}
+	}
+	for _, slashing := range body.AttesterSlashings {
+		if slashing == nil {
+			continue
+		}
+		candidates := []*ethpb.AttesterSlashing{
+			slashing,
+			{Attestation_1: slashing.Attestation_2, Attestation_2: slashing.Attestation_1},
+		}
+		for _, candidate := range candidates {
+			found, st, err := s.slasherDB.HasAttesterSlashing(ctx, candidate)
+			if err != nil {
+				return err
+			}
+			if !found || st == status.Included {
+				continue
+			}
+			if err := s.slasherDB.SaveAttesterSlashing(ctx, status.Included, candidate); err != nil {
+				return err
+			}
+			attesterSlashingsIncluded.Inc()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	testDetect "github.com/prysmaticlabs/prysm/slasher/detection/testing"
+)
+
+func TestService_markIncludedSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := Service{
+		ctx:       ctx,
+		slasherDB: db,
+	}
+	hdr1, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	hdr2, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	proposerSlashing := &ethpb.ProposerSlashing{Header_1: hdr1, Header_2: hdr2}
+	require.NoError(t, db.SaveProposerSlashing(ctx, status.Active, proposerSlashing))
+
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+				BeaconBlockRoot: make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		},
+		Attestation_2: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+				BeaconBlockRoot: make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		},
+	}
+	require.NoError(t, db.SaveAttesterSlashing(ctx, status.Active, attesterSlashing))
+
+	// The block includes the proposer slashing with its headers swapped.
+	body := &ethpb.BeaconBlockBody{
+		ProposerSlashings: []*ethpb.ProposerSlashing{{Header_1: hdr2, Header_2: hdr1}},
+		AttesterSlashings: []*ethpb.AttesterSlashing{attesterSlashing},
+	}
+	require.NoError(t, ds.markIncludedSlashings(ctx, body))
+
+	found, st, err := db.HasProposerSlashing(ctx, proposerSlashing)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, status.SlashingStatus(status.Included), st)
+	found, st, err = db.HasAttesterSlashing(ctx, attesterSlashing)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, status.SlashingStatus(status.Included), st)
+
+	pending, err := db.ProposalSlashingsByStatus(ctx, status.Active)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pending))
+}
@@ -26,6 +26,13 @@ func (s *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Signe
 	for {
 		select {
 		case signedBlock := <-ch:
+			// Slashings included in the block are marked regardless of the outcome
+			// of the detection on the block itself.
+			if signedBlock.Block != nil {
+				if err := s.markIncludedSlashings(ctx, signedBlock.Block.Body); err != nil {
+					log.WithError(err).Error("Could not update status of included slashings")
+				}
+			}
 			signedBlkHdr, err := blockutil.SignedBeaconBlockHeaderFromBlock(signedBlock)
 			if err != nil {
 				log.WithError(err).Error("Could not get block header from block")
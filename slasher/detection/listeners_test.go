@@ -9,8 +9,10 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
+	testDetect "github.com/prysmaticlabs/prysm/slasher/detection/testing"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -58,6 +60,47 @@ func TestService_DetectIncomingBlocks(t *testing.T) {
 	require.LogsContain(t, hook, "Context canceled")
 }
 
+func TestService_DetectIncomingBlocks_MarksIncludedSlashingsOnDetectionFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := testDB.SetupSlasherDB(t, false)
+	ds := Service{
+		notifier:          &mockNotifier{},
+		proposalsDetector: proposals.NewProposeDetector(db),
+		slasherDB:         db,
+	}
+	hdr1, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	hdr2, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	proposerSlashing := &ethpb.ProposerSlashing{Header_1: hdr1, Header_2: hdr2}
+	require.NoError(t, db.SaveProposerSlashing(context.Background(), status.Active, proposerSlashing))
+
+	// The block header cannot be computed from a body without randao reveal.
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot: 1,
+			Body: &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{proposerSlashing}},
+		},
+		Signature: make([]byte, 96),
+	}
+	exitRoutine := make(chan bool)
+	blocksChan := make(chan *ethpb.SignedBeaconBlock)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func(tt *testing.T) {
+		ds.detectIncomingBlocks(ctx, blocksChan)
+		<-exitRoutine
+	}(t)
+	blocksChan <- blk
+	cancel()
+	exitRoutine <- true
+	require.LogsContain(t, hook, "Could not get block header from block")
+
+	found, st, err := db.HasProposerSlashing(context.Background(), proposerSlashing)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, status.SlashingStatus(status.Included), st)
+}
+
 func TestService_DetectIncomingAttestations(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ds := Service{
@@ -18,4 +18,20 @@ var (
 		Name: "surrounded_votes_detected_total",
 		Help: "The # of surrounded slashable events detected",
 	})
+	proposerSlashingsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_slashings_detected_total",
+		Help: "The # of proposer slashings detected and submitted to the beacon node",
+	})
+	attesterSlashingsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "attester_slashings_detected_total",
+		Help: "The # of attester slashings detected and submitted to the beacon node",
+	})
+	proposerSlashingsIncluded = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_slashings_included_total",
+		Help: "The # of detected proposer slashings observed as included in a block",
+	})
+	attesterSlashingsIncluded = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "attester_slashings_included_total",
+		Help: "The # of detected attester slashings observed as included in a block",
+	})
 )
@@ -224,6 +224,7 @@ func (s *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethp
 	ctx, span := trace.StartSpan(ctx, "detection.submitAttesterSlashings")
 	defer span.End()
 	for i := 0; i < len(slashings); i++ {
+		attesterSlashingsDetected.Inc()
 		s.attesterSlashingsFeed.Send(slashings[i])
 	}
 }
@@ -238,6 +239,7 @@ func (s *Service) submitProposerSlashing(ctx context.Context, slashing *ethpb.Pr
 			"proposerIdxHeader1": slashing.Header_1.Header.ProposerIndex,
 			"proposerIdxHeader2": slashing.Header_2.Header.ProposerIndex,
 		}).Info("Found a proposer slashing! Submitting to beacon node")
+		proposerSlashingsDetected.Inc()
 		s.proposerSlashingsFeed.Send(slashing)
 	}
 }
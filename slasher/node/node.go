@@ -270,13 +270,15 @@ func (n *SlasherNode) registerRPCService() error {
 	cert := n.cliCtx.String(flags.CertFlag.Name)
 	key := n.cliCtx.String(flags.KeyFlag.Name)
 	rpcService := rpc.NewService(n.ctx, &rpc.Config{
-		Host:         host,
-		Port:         port,
-		CertFlag:     cert,
-		KeyFlag:      key,
-		Detector:     detectionService,
-		SlasherDB:    n.db,
-		BeaconClient: bs,
+		Host:                  host,
+		Port:                  port,
+		CertFlag:              cert,
+		KeyFlag:               key,
+		Detector:              detectionService,
+		SlasherDB:             n.db,
+		BeaconClient:          bs,
+		AttesterSlashingsFeed: n.attesterSlashingsFeed,
+		ProposerSlashingsFeed: n.proposerSlashingsFeed,
 	})
 
 	return n.services.RegisterService(rpcService)
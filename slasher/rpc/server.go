@@ -11,6 +11,7 @@ import (
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
@@ -31,6 +32,9 @@ type Server struct {
 	beaconClient    *beaconclient.Service
 	attestationLock sync.Mutex
 	proposeLock     sync.Mutex
+
+	attesterSlashingsFeed *event.Feed
+	proposerSlashingsFeed *event.Feed
 }
 
 // HighestAttestations returns the highest observed attestation source and epoch for a given validator id.
@@ -13,6 +13,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -38,17 +39,22 @@ type Service struct {
 	withKey         string
 	credentialError error
 	beaconclient    *beaconclient.Service
+
+	attesterSlashingsFeed *event.Feed
+	proposerSlashingsFeed *event.Feed
 }
 
 // Config options for the slasher node RPC server.
 type Config struct {
-	Host         string
-	Port         string
-	CertFlag     string
-	KeyFlag      string
-	Detector     *detection.Service
-	SlasherDB    db.Database
-	BeaconClient *beaconclient.Service
+	Host                  string
+	Port                  string
+	CertFlag              string
+	KeyFlag               string
+	Detector              *detection.Service
+	SlasherDB             db.Database
+	BeaconClient          *beaconclient.Service
+	AttesterSlashingsFeed *event.Feed
+	ProposerSlashingsFeed *event.Feed
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -65,6 +71,9 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		withCert:     cfg.CertFlag,
 		withKey:      cfg.KeyFlag,
 		beaconclient: cfg.BeaconClient,
+
+		attesterSlashingsFeed: cfg.AttesterSlashingsFeed,
+		proposerSlashingsFeed: cfg.ProposerSlashingsFeed,
 	}
 }
 
@@ -117,6 +126,9 @@ func (s *Service) Start() {
 		detector:     s.detector,
 		slasherDB:    s.slasherDB,
 		beaconClient: s.beaconclient,
+
+		attesterSlashingsFeed: s.attesterSlashingsFeed,
+		proposerSlashingsFeed: s.proposerSlashingsFeed,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
 
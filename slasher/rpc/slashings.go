@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProposerSlashings returns all proposer slashings detected by the slasher which
+// match the validator indices, epoch range and inclusion status in the request.
+func (s *Server) ProposerSlashings(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.ProposerSlashingResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "rpc.ProposerSlashings")
+	defer span.End()
+
+	if err := validateSlashingsRequest(req); err != nil {
+		return nil, err
+	}
+	ret := make([]*ethpb.ProposerSlashing, 0)
+	for _, st := range statusesForRequest(req.Status) {
+		slashings, err := s.slasherDB.ProposalSlashingsByStatus(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve proposer slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			if proposerSlashingMatches(req, slashing) {
+				ret = append(ret, slashing)
+			}
+		}
+	}
+	return &slashpb.ProposerSlashingResponse{
+		ProposerSlashing: ret,
+	}, nil
+}
+
+// AttesterSlashings returns all attester slashings detected by the slasher which
+// match the validator indices, epoch range and inclusion status in the request.
+func (s *Server) AttesterSlashings(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.AttesterSlashingResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "rpc.AttesterSlashings")
+	defer span.End()
+
+	if err := validateSlashingsRequest(req); err != nil {
+		return nil, err
+	}
+	ret := make([]*ethpb.AttesterSlashing, 0)
+	for _, st := range statusesForRequest(req.Status) {
+		slashings, err := s.slasherDB.AttesterSlashings(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve attester slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			if attesterSlashingMatches(req, slashing) {
+				ret = append(ret, slashing)
+			}
+		}
+	}
+	return &slashpb.AttesterSlashingResponse{
+		AttesterSlashing: ret,
+	}, nil
+}
+
+// StreamProposerSlashings sends proposer slashings to the client as soon as they
+// are detected by the slasher.
+func (s *Server) StreamProposerSlashings(_ *ptypes.Empty, stream slashpb.Slasher_StreamProposerSlashingsServer) error {
+	if s.proposerSlashingsFeed == nil {
+		return status.Error(codes.Unavailable, "proposer slashings feed is not available")
+	}
+	ch := make(chan *ethpb.ProposerSlashing, 1)
+	sub := s.proposerSlashingsFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case slashing := <-ch:
+			if err := stream.Send(slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "context canceled")
+		}
+	}
+}
+
+// StreamAttesterSlashings sends attester slashings to the client as soon as they
+// are detected by the slasher.
+func (s *Server) StreamAttesterSlashings(_ *ptypes.Empty, stream slashpb.Slasher_StreamAttesterSlashingsServer) error {
+	if s.attesterSlashingsFeed == nil {
+		return status.Error(codes.Unavailable, "attester slashings feed is not available")
+	}
+	ch := make(chan *ethpb.AttesterSlashing, 1)
+	sub := s.attesterSlashingsFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case slashing := <-ch:
+			if err := stream.Send(slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "context canceled")
+		}
+	}
+}
+
+func validateSlashingsRequest(req *slashpb.SlashingsRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "nil request provided")
+	}
+	if req.EndEpoch != 0 && req.StartEpoch > req.EndEpoch {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"start epoch %d cannot be greater than end epoch %d",
+			req.StartEpoch,
+			req.EndEpoch,
+		)
+	}
+	return nil
+}
+
+// statusesForRequest maps the requested inclusion status to the slashing
+// statuses stored in the slasher database. Reverted slashings are relevant
+// again and are therefore treated as pending.
+func statusesForRequest(st slashpb.SlashingsRequest_Status) []dbtypes.SlashingStatus {
+	switch st {
+	case slashpb.SlashingsRequest_PENDING:
+		return []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Reverted}
+	case slashpb.SlashingsRequest_INCLUDED:
+		return []dbtypes.SlashingStatus{dbtypes.Included}
+	default:
+		return []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Reverted, dbtypes.Included}
+	}
+}
+
+func epochInRange(req *slashpb.SlashingsRequest, epoch types.Epoch) bool {
+	if epoch < req.StartEpoch {
+		return false
+	}
+	return req.EndEpoch == 0 || epoch <= req.EndEpoch
+}
+
+func proposerSlashingMatches(req *slashpb.SlashingsRequest, slashing *ethpb.ProposerSlashing) bool {
+	if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+		return false
+	}
+	header := slashing.Header_1.Header
+	if !epochInRange(req, helpers.SlotToEpoch(header.Slot)) {
+		return false
+	}
+	if len(req.ValidatorIndices) == 0 {
+		return true
+	}
+	for _, idx := range req.ValidatorIndices {
+		if idx == header.ProposerIndex {
+			return true
+		}
+	}
+	return false
+}
+
+func attesterSlashingMatches(req *slashpb.SlashingsRequest, slashing *ethpb.AttesterSlashing) bool {
+	if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return false
+	}
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att1.Data == nil || att1.Data.Target == nil || att2.Data == nil || att2.Data.Target == nil {
+		return false
+	}
+	if !epochInRange(req, att1.Data.Target.Epoch) && !epochInRange(req, att2.Data.Target.Epoch) {
+		return false
+	}
+	if len(req.ValidatorIndices) == 0 {
+		return true
+	}
+	slashableIndices := sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+	for _, idx := range req.ValidatorIndices {
+		if sliceutil.IsInUint64(uint64(idx), slashableIndices) {
+			return true
+		}
+	}
+	return false
+}
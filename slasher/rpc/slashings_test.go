@@ -0,0 +1,164 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"google.golang.org/grpc"
+)
+
+func proposerSlashingForTest(slot types.Slot, proposer types.ValidatorIndex) *ethpb.ProposerSlashing {
+	header := func(graffiti byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposer,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      bytesutil.PadTo([]byte{graffiti}, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+}
+
+func attesterSlashingForTest(target types.Epoch, indices []uint64) *ethpb.AttesterSlashing {
+	att := func(root byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+				BeaconBlockRoot: bytesutil.PadTo([]byte{root}, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.AttesterSlashing{Attestation_1: att(1), Attestation_2: att(2)}
+}
+
+func TestServer_ProposerSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	s := &Server{ctx: ctx, slasherDB: db}
+
+	epoch2Slot, err := helpers.StartSlot(2)
+	require.NoError(t, err)
+	epoch5Slot, err := helpers.StartSlot(5)
+	require.NoError(t, err)
+	pending := proposerSlashingForTest(epoch2Slot, 1)
+	included := proposerSlashingForTest(epoch5Slot, 2)
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Active, pending))
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Included, included))
+
+	tests := []struct {
+		name string
+		req  *slashpb.SlashingsRequest
+		want []*ethpb.ProposerSlashing
+	}{
+		{
+			name: "pending only",
+			req:  &slashpb.SlashingsRequest{Status: slashpb.SlashingsRequest_PENDING},
+			want: []*ethpb.ProposerSlashing{pending},
+		},
+		{
+			name: "included only",
+			req:  &slashpb.SlashingsRequest{Status: slashpb.SlashingsRequest_INCLUDED},
+			want: []*ethpb.ProposerSlashing{included},
+		},
+		{
+			name: "filter by validator index",
+			req:  &slashpb.SlashingsRequest{ValidatorIndices: []types.ValidatorIndex{2}},
+			want: []*ethpb.ProposerSlashing{included},
+		},
+		{
+			name: "filter by epoch range",
+			req:  &slashpb.SlashingsRequest{StartEpoch: 1, EndEpoch: 3},
+			want: []*ethpb.ProposerSlashing{pending},
+		},
+		{
+			name: "no match",
+			req:  &slashpb.SlashingsRequest{StartEpoch: 6},
+			want: []*ethpb.ProposerSlashing{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.ProposerSlashings(ctx, tt.req)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, res.ProposerSlashing)
+		})
+	}
+
+	_, err = s.ProposerSlashings(ctx, &slashpb.SlashingsRequest{StartEpoch: 4, EndEpoch: 3})
+	assert.ErrorContains(t, "cannot be greater than end epoch", err)
+}
+
+func TestServer_AttesterSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	s := &Server{ctx: ctx, slasherDB: db}
+
+	pending := attesterSlashingForTest(3, []uint64{1, 2})
+	included := attesterSlashingForTest(7, []uint64{3})
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Active, pending))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Included, included))
+
+	res, err := s.AttesterSlashings(ctx, &slashpb.SlashingsRequest{Status: slashpb.SlashingsRequest_PENDING})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{pending}, res.AttesterSlashing)
+
+	res, err = s.AttesterSlashings(ctx, &slashpb.SlashingsRequest{ValidatorIndices: []types.ValidatorIndex{3}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{included}, res.AttesterSlashing)
+
+	res, err = s.AttesterSlashings(ctx, &slashpb.SlashingsRequest{StartEpoch: 2, EndEpoch: 4})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{pending}, res.AttesterSlashing)
+}
+
+type mockProposerSlashingsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.ProposerSlashing
+}
+
+func (m *mockProposerSlashingsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockProposerSlashingsStream) Send(slashing *ethpb.ProposerSlashing) error {
+	m.sent <- slashing
+	return nil
+}
+
+func TestServer_StreamProposerSlashings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	feed := new(event.Feed)
+	s := &Server{ctx: context.Background(), proposerSlashingsFeed: feed}
+	stream := &mockProposerSlashingsStream{ctx: ctx, sent: make(chan *ethpb.ProposerSlashing, 1)}
+
+	exitRoutine := make(chan bool)
+	go func() {
+		assert.ErrorContains(t, "context canceled", s.StreamProposerSlashings(&ptypes.Empty{}, stream))
+		<-exitRoutine
+	}()
+	// Wait for the stream to subscribe to the feed.
+	for feed.Send(proposerSlashingForTest(1, 1)) == 0 {
+	}
+	assert.DeepEqual(t, proposerSlashingForTest(1, 1), <-stream.sent)
+	cancel()
+	exitRoutine <- true
+}
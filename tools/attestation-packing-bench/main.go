@@ -0,0 +1,148 @@
+/**
+ * Attestation packing benchmark
+ *
+ * Given a DB, start slot and end slot. This tool replays the block proposals of
+ * the canonical blocks in the range with every attestation aggregation strategy
+ * and reports how many uncounted validator votes and how much proposer reward
+ * each strategy packs, compared to the best achievable with the available pool.
+ * The attestation pool of a proposal is approximated by the attestations
+ * included in the block itself and in the blocks of the following slots.
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// Required fields
+	datadir   = flag.String("datadir", "", "Path to data directory.")
+	startSlot = flag.Uint("startSlot", 0, "Start slot of the replayed proposals")
+	endSlot   = flag.Uint("endSlot", 0, "End slot of the replayed proposals")
+	// Optional fields
+	lookahead  = flag.Uint("lookahead", uint(params.BeaconConfig().SlotsPerEpoch), "Number of following slots whose block attestations are added to the pool")
+	strategies = flag.String("strategies", "naive,max_cover,opt_max_cover,reward_max_cover", "Comma separated list of attestation aggregation strategies to compare")
+)
+
+// totals accumulates the packing results of a strategy over all replayed proposals.
+type totals struct {
+	proposals   uint64
+	packed      uint64
+	newVotes    uint64
+	maxNewVotes uint64
+	reward      uint64
+	maxReward   uint64
+	timeMs      uint64
+}
+
+// strategyFlags returns the feature flags enabling the given packing strategy. The reward_max_cover
+// strategy selects attestations by proposer reward on top of the opt_max_cover aggregation.
+func strategyFlags(name string) *featureconfig.Flags {
+	if name == "reward_max_cover" {
+		return &featureconfig.Flags{
+			AttestationAggregationStrategy:     "opt_max_cover",
+			EnableRewardMaximizingAttestations: true,
+		}
+	}
+	return &featureconfig.Flags{AttestationAggregationStrategy: name}
+}
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+	d, err := db.NewDB(ctx, *datadir, &kv.Config{})
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	sg := stategen.New(d)
+
+	start := types.Slot(*startSlot)
+	end := types.Slot(*endSlot)
+	filter := filters.NewFilter().SetStartSlot(start).SetEndSlot(end + types.Slot(*lookahead))
+	blks, _, err := d.Blocks(ctx, filter)
+	if err != nil {
+		panic(err)
+	}
+	attsBySlot := make(map[types.Slot][]*ethpb.Attestation)
+	for _, b := range blks {
+		attsBySlot[b.Block.Slot] = append(attsBySlot[b.Block.Slot], b.Block.Body.Attestations...)
+	}
+
+	names := strings.Split(*strategies, ",")
+	results := make(map[string]*totals, len(names))
+	for _, name := range names {
+		results[name] = &totals{}
+	}
+	for _, b := range blks {
+		slot := b.Block.Slot
+		if slot > end || slot == 0 {
+			continue
+		}
+		pool := make([]*ethpb.Attestation, 0)
+		for s := slot; s <= slot+types.Slot(*lookahead); s++ {
+			pool = append(pool, attsBySlot[s]...)
+		}
+		preState, err := sg.StateByRoot(ctx, bytesutil.ToBytes32(b.Block.ParentRoot))
+		if err != nil {
+			panic(err)
+		}
+		preState, err = state.ProcessSlots(ctx, preState, slot)
+		if err != nil {
+			panic(err)
+		}
+		for _, name := range names {
+			featureconfig.Init(strategyFlags(name))
+			report, err := validator.ReplayAttestationPacking(ctx, preState, pool)
+			if err != nil {
+				panic(err)
+			}
+			t := results[name]
+			t.proposals++
+			t.packed += report.PackedAttestations
+			t.newVotes += report.NewVotesIncluded
+			t.maxNewVotes += report.MaxNewVotes
+			t.reward += report.ExpectedReward
+			t.maxReward += report.MaxExpectedReward
+			t.timeMs += report.PackingTimeMs
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "strategy\tproposals\tpacked\tnew votes\tmax new votes\treward (gwei)\tmax reward (gwei)\treward ratio\ttime (ms)")
+	for _, name := range names {
+		t := results[name]
+		ratio := 0.0
+		if t.maxReward > 0 {
+			ratio = float64(t.reward) / float64(t.maxReward)
+		}
+		fmt.Fprintf(
+			w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%.4f\t%d\n",
+			name, t.proposals, t.packed, t.newVotes, t.maxNewVotes, t.reward, t.maxReward, ratio, t.timeMs,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
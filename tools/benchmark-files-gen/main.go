@@ -147,7 +147,7 @@ func generateMarshalledFullStateAndBlock() error {
 		return err
 	}
 
-	beaconBytes, err := beaconState.InnerStateUnsafe().MarshalSSZ()
+	beaconBytes, err := beaconState.ToProtoUnsafe().MarshalSSZ()
 	if err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func generate2FullEpochState() error {
 		}
 	}
 
-	beaconBytes, err := beaconState.InnerStateUnsafe().MarshalSSZ()
+	beaconBytes, err := beaconState.ToProtoUnsafe().MarshalSSZ()
 	if err != nil {
 		return err
 	}
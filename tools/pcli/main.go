@@ -191,8 +191,8 @@ func main() {
 					if err := dataFetcher(expectedPostStatePath, expectedState); err != nil {
 						log.Fatal(err)
 					}
-					if !sszutil.DeepEqual(expectedState, postState.InnerStateUnsafe()) {
-						diff, _ := messagediff.PrettyDiff(expectedState, postState.InnerStateUnsafe())
+					if !sszutil.DeepEqual(expectedState, postState.ToProtoUnsafe()) {
+						diff, _ := messagediff.PrettyDiff(expectedState, postState.ToProtoUnsafe())
 						log.Errorf("Derived state differs from provided post state: %s", diff)
 					}
 				}
@@ -0,0 +1,171 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// DepositDataConfig to generate a bundle of deposit data for a range of HD wallet accounts.
+type DepositDataConfig struct {
+	Mnemonic         string
+	Mnemonic25thWord string
+	StartIndex       int
+	NumAccounts      int
+	AmountGwei       uint64
+	OutputDir        string
+}
+
+// WithdrawalCredentialsReport lists the withdrawal credentials of an account
+// of a deposit data bundle, along with the derivation paths of its keys.
+type WithdrawalCredentialsReport struct {
+	AccountIndex          int    `json:"account_index"`
+	ValidatingPubKey      string `json:"validating_pubkey"`
+	ValidatingKeyPath     string `json:"validating_key_path"`
+	WithdrawalPubKey      string `json:"withdrawal_pubkey"`
+	WithdrawalKeyPath     string `json:"withdrawal_key_path"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+}
+
+// DepositDataCli generates, fully offline, a deposit_data.json bundle for a range of
+// accounts derived from a mnemonic, along with a report of their withdrawal credentials.
+func DepositDataCli(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+	}
+	mnemonic, err := inputMnemonic(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	cfg := &DepositDataConfig{
+		Mnemonic:    mnemonic,
+		StartIndex:  cliCtx.Int(flags.DepositStartIndexFlag.Name),
+		NumAccounts: cliCtx.Int(flags.NumAccountsFlag.Name),
+		AmountGwei:  cliCtx.Uint64(flags.DepositAmountFlag.Name),
+		OutputDir:   cliCtx.String(flags.DepositOutputDirFlag.Name),
+	}
+	if cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		cfg.Mnemonic25thWord, err = promptutil.InputPassword(
+			cliCtx,
+			flags.Mnemonic25thWordFileFlag,
+			mnemonicPassphrasePromptText,
+			"",
+			false, /* Should confirm password */
+			func(input string) error {
+				if strings.TrimSpace(input) == "" {
+					return errors.New("input cannot be empty")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	_, _, err = GenerateDepositData(cliCtx.Context, cfg)
+	return err
+}
+
+// GenerateDepositData derives the keys of the accounts in the configured range, builds and
+// verifies their deposits and writes a deposit_data.json bundle and a withdrawal credentials
+// report to the output directory. It returns the paths of both files.
+func GenerateDepositData(ctx context.Context, cfg *DepositDataConfig) (string, string, error) {
+	amount := cfg.AmountGwei
+	if amount == 0 {
+		amount = params.BeaconConfig().MaxEffectiveBalance
+	}
+	if amount < params.BeaconConfig().MinDepositAmount || amount > params.BeaconConfig().MaxEffectiveBalance {
+		return "", "", fmt.Errorf(
+			"deposit amount %d Gwei is not between %d and %d Gwei",
+			amount, params.BeaconConfig().MinDepositAmount, params.BeaconConfig().MaxEffectiveBalance,
+		)
+	}
+	accounts, err := derived.AccountKeysFromMnemonic(cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.StartIndex, cfg.NumAccounts)
+	if err != nil {
+		return "", "", err
+	}
+	domain, err := helpers.ComputeDomain(
+		params.BeaconConfig().DomainDeposit,
+		nil, /*forkVersion*/
+		nil, /*genesisValidatorsRoot*/
+	)
+	if err != nil {
+		return "", "", err
+	}
+	depositData := make([]*depositutil.DepositDataJSON, len(accounts))
+	report := make([]*WithdrawalCredentialsReport, len(accounts))
+	for i, account := range accounts {
+		if ctx.Err() != nil {
+			return "", "", ctx.Err()
+		}
+		data, dataRoot, err := depositutil.DepositInput(account.ValidatingKey, account.WithdrawalKey, amount)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "could not build deposit of account %d", account.Index)
+		}
+		if err := depositutil.VerifyDepositSignature(data, domain); err != nil {
+			return "", "", errors.Wrapf(err, "could not verify deposit signature of account %d", account.Index)
+		}
+		depositData[i], err = depositutil.DepositDataToJSON(data)
+		if err != nil {
+			return "", "", err
+		}
+		if depositData[i].DepositDataRoot != fmt.Sprintf("%x", dataRoot) {
+			return "", "", fmt.Errorf("deposit data root of account %d does not match its deposit", account.Index)
+		}
+		report[i] = &WithdrawalCredentialsReport{
+			AccountIndex:          account.Index,
+			ValidatingPubKey:      fmt.Sprintf("%#x", account.ValidatingKey.PublicKey().Marshal()),
+			ValidatingKeyPath:     account.ValidatingKeyPath,
+			WithdrawalPubKey:      fmt.Sprintf("%#x", account.WithdrawalKey.PublicKey().Marshal()),
+			WithdrawalKeyPath:     account.WithdrawalKeyPath,
+			WithdrawalCredentials: fmt.Sprintf("%#x", data.WithdrawalCredentials),
+		}
+	}
+
+	outputDir, err := fileutil.ExpandPath(cfg.OutputDir)
+	if err != nil {
+		return "", "", err
+	}
+	if err := fileutil.MkdirAll(outputDir); err != nil {
+		return "", "", errors.Wrapf(err, "could not create output directory %s", outputDir)
+	}
+	now := time.Now().Unix()
+	depositDataPath := filepath.Join(outputDir, fmt.Sprintf("deposit_data-%d.json", now))
+	reportPath := filepath.Join(outputDir, fmt.Sprintf("withdrawal_credentials-%d.json", now))
+	for path, v := range map[string]interface{}{depositDataPath: depositData, reportPath: report} {
+		if fileutil.FileExists(path) {
+			return "", "", fmt.Errorf("file already exists at path %s", path)
+		}
+		encoded, err := json.MarshalIndent(v, "", "\t")
+		if err != nil {
+			return "", "", err
+		}
+		if err := fileutil.WriteFile(path, encoded); err != nil {
+			return "", "", errors.Wrapf(err, "could not write %s", path)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"depositData":           depositDataPath,
+		"withdrawalCredentials": reportPath,
+		"forkVersion":           fmt.Sprintf("%#x", params.BeaconConfig().GenesisForkVersion),
+	}).Infof(
+		"Generated and verified deposits of %d Gwei for accounts %d to %d",
+		amount, cfg.StartIndex, cfg.StartIndex+cfg.NumAccounts-1,
+	)
+	return depositDataPath, reportPath, nil
+}
@@ -0,0 +1,89 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/tyler-smith/go-bip39"
+	util "github.com/wealdtech/go-eth2-util"
+)
+
+func TestGenerateDepositData(t *testing.T) {
+	depositDataPath, reportPath, err := GenerateDepositData(context.Background(), &DepositDataConfig{
+		Mnemonic:    mnemonic,
+		StartIndex:  3,
+		NumAccounts: 2,
+		OutputDir:   t.TempDir() + "/deposits",
+	})
+	require.NoError(t, err)
+
+	encoded, err := ioutil.ReadFile(depositDataPath)
+	require.NoError(t, err)
+	var depositData []*depositutil.DepositDataJSON
+	require.NoError(t, json.Unmarshal(encoded, &depositData))
+	encoded, err = ioutil.ReadFile(reportPath)
+	require.NoError(t, err)
+	var report []*WithdrawalCredentialsReport
+	require.NoError(t, json.Unmarshal(encoded, &report))
+	require.Equal(t, 2, len(depositData))
+	require.Equal(t, 2, len(report))
+
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	seed := bip39.NewSeed(mnemonic, "")
+	for i, entry := range depositData {
+		index := 3 + i
+		validatingKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf("m/12381/3600/%d/0/0", index))
+		require.NoError(t, err)
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf("m/12381/3600/%d/0", index))
+		require.NoError(t, err)
+
+		assert.Equal(t, fmt.Sprintf("%x", validatingKey.PublicKey().Marshal()), entry.PubKey)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, entry.Amount)
+		assert.Equal(t, fmt.Sprintf("%x", params.BeaconConfig().GenesisForkVersion), entry.ForkVersion)
+
+		// The bundle holds everything needed to verify and submit the deposit.
+		data := &ethpb.Deposit_Data{
+			PublicKey:             decodeHex(t, entry.PubKey),
+			WithdrawalCredentials: decodeHex(t, entry.WithdrawalCredentials),
+			Amount:                entry.Amount,
+			Signature:             decodeHex(t, entry.Signature),
+		}
+		require.NoError(t, depositutil.VerifyDepositSignature(data, domain))
+		dataRoot, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%x", dataRoot), entry.DepositDataRoot)
+
+		assert.Equal(t, index, report[i].AccountIndex)
+		assert.Equal(t, fmt.Sprintf("m/12381/3600/%d/0", index), report[i].WithdrawalKeyPath)
+		assert.Equal(t, fmt.Sprintf("m/12381/3600/%d/0/0", index), report[i].ValidatingKeyPath)
+		assert.Equal(t, fmt.Sprintf("%#x", withdrawalKey.PublicKey().Marshal()), report[i].WithdrawalPubKey)
+		assert.Equal(t, "0x"+entry.WithdrawalCredentials, report[i].WithdrawalCredentials)
+	}
+}
+
+func TestGenerateDepositData_InvalidAmount(t *testing.T) {
+	_, _, err := GenerateDepositData(context.Background(), &DepositDataConfig{
+		Mnemonic:    mnemonic,
+		NumAccounts: 1,
+		AmountGwei:  params.BeaconConfig().MaxEffectiveBalance + 1,
+		OutputDir:   t.TempDir(),
+	})
+	assert.ErrorContains(t, "is not between", err)
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
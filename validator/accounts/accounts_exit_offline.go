@@ -0,0 +1,369 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// ExitSigningConfig is the network information needed to sign voluntary exits offline:
+// the fork and genesis validators root of the target network, and the indices of the
+// validators to exit.
+type ExitSigningConfig struct {
+	GenesisValidatorsRoot string            `json:"genesis_validators_root"`
+	Fork                  *ForkJSON         `json:"fork"`
+	ValidatorIndices      map[string]string `json:"validator_indices"`
+}
+
+// ForkJSON is the JSON representation of a fork, like in the beacon node API.
+type ForkJSON struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// PresignedExits is a set of voluntary exits signed offline for a network.
+type PresignedExits struct {
+	GenesisValidatorsRoot string           `json:"genesis_validators_root"`
+	ForkVersion           string           `json:"fork_version"`
+	Exits                 []*PresignedExit `json:"exits"`
+}
+
+// PresignedExit is a signed voluntary exit along with the public key of its validator.
+// Its message and signature are formatted like the body of the voluntary exits pool
+// endpoint of the beacon node API, so it can also be submitted there.
+type PresignedExit struct {
+	PubKey    string             `json:"pubkey"`
+	Message   *VoluntaryExitJSON `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// VoluntaryExitJSON is the JSON representation of a voluntary exit, like in the beacon node API.
+type VoluntaryExitJSON struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// PresignExitsCli signs voluntary exits for a future epoch on selected accounts, without
+// any beacon node, and writes them to a file they can later be broadcast from.
+func PresignExitsCli(cliCtx *cli.Context, r io.Reader) error {
+	outputFile := cliCtx.String(flags.PresignedExitsFileFlag.Name)
+	if outputFile == "" {
+		return fmt.Errorf("no output file specified, please set --%s", flags.PresignedExitsFileFlag.Name)
+	}
+	if fileutil.FileExists(outputFile) {
+		return fmt.Errorf("file already exists at path %s", outputFile)
+	}
+	signingCfg, err := readExitSigningConfig(cliCtx.String(flags.ExitSigningConfigFlag.Name))
+	if err != nil {
+		return err
+	}
+	validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+	rawPubKeys, _, err := interact(cliCtx, r, validatingPublicKeys)
+	if err != nil {
+		return err
+	}
+	// User decided to cancel the voluntary exit.
+	if rawPubKeys == nil {
+		return nil
+	}
+	epoch := types.Epoch(cliCtx.Uint64(flags.ExitEpochFlag.Name))
+	exits, err := presignExits(cliCtx.Context, kManager.Sign, signingCfg, rawPubKeys, epoch)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(exits, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFile(outputFile, encoded); err != nil {
+		return errors.Wrapf(err, "could not write pre-signed exits to %s", outputFile)
+	}
+	log.WithField("file", outputFile).Infof(
+		"Pre-signed voluntary exits of %d accounts valid from epoch %d", len(exits.Exits), epoch,
+	)
+	return nil
+}
+
+// BroadcastExitsCli verifies voluntary exits signed offline against the network of the
+// beacon node and submits them.
+func BroadcastExitsCli(cliCtx *cli.Context) error {
+	exitsFile := cliCtx.String(flags.PresignedExitsFileFlag.Name)
+	if exitsFile == "" {
+		return fmt.Errorf("no pre-signed exits file specified, please set --%s", flags.PresignedExitsFileFlag.Name)
+	}
+	encoded, err := fileutil.ReadFileAsBytes(exitsFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read pre-signed exits")
+	}
+	exits := &PresignedExits{}
+	if err := json.Unmarshal(encoded, exits); err != nil {
+		return errors.Wrap(err, "could not unmarshal pre-signed exits")
+	}
+	validatorClient, nodeClient, err := prepareClients(cliCtx)
+	if err != nil {
+		return err
+	}
+	rawExitedKeys, formattedExitedKeys, err := broadcastExits(cliCtx.Context, *validatorClient, *nodeClient, exits)
+	if err != nil {
+		return err
+	}
+	displayExitInfo(rawExitedKeys, formattedExitedKeys)
+	return nil
+}
+
+func readExitSigningConfig(path string) (*ExitSigningConfig, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no exit signing config specified, please set --%s", flags.ExitSigningConfigFlag.Name)
+	}
+	encoded, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read exit signing config")
+	}
+	cfg := &ExitSigningConfig{}
+	if err := json.Unmarshal(encoded, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal exit signing config")
+	}
+	if cfg.Fork == nil {
+		return nil, errors.New("exit signing config has no fork")
+	}
+	return cfg, nil
+}
+
+// presignExits signs a voluntary exit at the given epoch for each public key, using the
+// fork of the signing config which is active at that epoch.
+func presignExits(
+	ctx context.Context,
+	signer func(context.Context, *validatorpb.SignRequest) (bls.Signature, error),
+	cfg *ExitSigningConfig,
+	rawPubKeys [][]byte,
+	epoch types.Epoch,
+) (*PresignedExits, error) {
+	genesisValidatorsRoot, err := decodeRoot(cfg.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+	fork, err := forkFromJSON(cfg.Fork)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fork")
+	}
+	forkVersion := fork.CurrentVersion
+	if epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
+	}
+	domain, err := helpers.Domain(fork, epoch, params.BeaconConfig().DomainVoluntaryExit, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	indices := make(map[string]string, len(cfg.ValidatorIndices))
+	for pubKey, index := range cfg.ValidatorIndices {
+		indices[strings.ToLower(strings.TrimPrefix(pubKey, "0x"))] = index
+	}
+
+	exits := make([]*PresignedExit, len(rawPubKeys))
+	for i, pubKey := range rawPubKeys {
+		formattedPubKey := hexutil.Encode(pubKey)
+		rawIndex, ok := indices[strings.TrimPrefix(formattedPubKey, "0x")]
+		if !ok {
+			return nil, fmt.Errorf("no validator index in exit signing config for public key %s", formattedPubKey)
+		}
+		index, err := strconv.ParseUint(rawIndex, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index for public key %s", formattedPubKey)
+		}
+		exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: types.ValidatorIndex(index)}
+		exitRoot, err := helpers.ComputeSigningRoot(exit, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute signing root of voluntary exit")
+		}
+		sig, err := signer(ctx, &validatorpb.SignRequest{
+			PublicKey:       pubKey,
+			SigningRoot:     exitRoot[:],
+			SignatureDomain: domain,
+			Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign voluntary exit for public key %s", formattedPubKey)
+		}
+		if err := helpers.VerifySigningRoot(exit, pubKey, sig.Marshal(), domain); err != nil {
+			return nil, errors.Wrapf(err, "could not verify voluntary exit for public key %s", formattedPubKey)
+		}
+		exits[i] = &PresignedExit{
+			PubKey: formattedPubKey,
+			Message: &VoluntaryExitJSON{
+				Epoch:          strconv.FormatUint(uint64(epoch), 10),
+				ValidatorIndex: rawIndex,
+			},
+			Signature: hexutil.Encode(sig.Marshal()),
+		}
+	}
+	return &PresignedExits{
+		GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+		ForkVersion:           hexutil.Encode(forkVersion),
+		Exits:                 exits,
+	}, nil
+}
+
+// broadcastExits submits pre-signed voluntary exits to a beacon node. Each exit is first
+// verified against its own signing domain, then against the validator index and signing
+// domain of the beacon node, so exits signed for another network are never submitted.
+// Exits which are not valid yet are skipped.
+func broadcastExits(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	nodeClient ethpb.NodeClient,
+	exits *PresignedExits,
+) (rawExitedKeys [][]byte, formattedExitedKeys []string, err error) {
+	genesisValidatorsRoot, err := decodeRoot(exits.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+	forkVersion, err := hexutil.Decode(exits.ForkVersion)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid fork version")
+	}
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	genesisResponse, err := nodeClient.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "gRPC call to get genesis time failed")
+	}
+	totalSecondsPassed := timeutils.Now().Unix() - genesisResponse.GenesisTime.Seconds
+	currentEpoch := types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
+
+	rawExitedKeys = make([][]byte, 0)
+	formattedExitedKeys = make([]string, 0)
+	for _, presigned := range exits.Exits {
+		signedExit, pubKey, err := presigned.toProto()
+		if err != nil {
+			return nil, nil, err
+		}
+		formattedPubKey := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
+		if err := helpers.VerifySigningRoot(signedExit.Exit, pubKey, signedExit.Signature, domain); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify pre-signed exit of account %s", formattedPubKey)
+		}
+		if signedExit.Exit.Epoch > currentEpoch {
+			log.Warningf(
+				"Skipping pre-signed exit of account %s which is not valid before epoch %d, current epoch is %d",
+				formattedPubKey, signedExit.Exit.Epoch, currentEpoch,
+			)
+			continue
+		}
+		if err := verifyExitWithBeaconNode(ctx, validatorClient, signedExit.Exit, pubKey, domain); err != nil {
+			return nil, nil, errors.Wrapf(err, "pre-signed exit of account %s does not match the beacon node", formattedPubKey)
+		}
+		if _, err := validatorClient.ProposeExit(ctx, signedExit); err != nil {
+			log.WithError(err).Errorf("voluntary exit failed for account %s", formattedPubKey)
+			continue
+		}
+		rawExitedKeys = append(rawExitedKeys, pubKey)
+		formattedExitedKeys = append(formattedExitedKeys, formattedPubKey)
+	}
+	return rawExitedKeys, formattedExitedKeys, nil
+}
+
+func verifyExitWithBeaconNode(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	exit *ethpb.VoluntaryExit,
+	pubKey []byte,
+	domain []byte,
+) error {
+	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return errors.Wrap(err, "gRPC call to get validator index failed")
+	}
+	if indexResponse.Index != exit.ValidatorIndex {
+		return fmt.Errorf("exit is for validator index %d, but the beacon node knows the account as %d",
+			exit.ValidatorIndex, indexResponse.Index)
+	}
+	domainResponse, err := validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  exit.Epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "gRPC call to get signing domain failed")
+	}
+	if domainResponse == nil || !bytes.Equal(domainResponse.SignatureDomain, domain) {
+		return errors.New("exit was signed for a different network or fork")
+	}
+	return nil
+}
+
+func (e *PresignedExit) toProto() (*ethpb.SignedVoluntaryExit, []byte, error) {
+	if e.Message == nil {
+		return nil, nil, errors.New("pre-signed exit has no message")
+	}
+	pubKey, err := hexutil.Decode(e.PubKey)
+	if err != nil || len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+		return nil, nil, fmt.Errorf("invalid public key %s", e.PubKey)
+	}
+	epoch, err := strconv.ParseUint(e.Message.Epoch, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid epoch in pre-signed exit of %s", e.PubKey)
+	}
+	index, err := strconv.ParseUint(e.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid validator index in pre-signed exit of %s", e.PubKey)
+	}
+	sig, err := hexutil.Decode(e.Signature)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid signature in pre-signed exit of %s", e.PubKey)
+	}
+	return &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: types.Epoch(epoch), ValidatorIndex: types.ValidatorIndex(index)},
+		Signature: sig,
+	}, pubKey, nil
+}
+
+func forkFromJSON(f *ForkJSON) (*pb.Fork, error) {
+	previousVersion, err := hexutil.Decode(f.PreviousVersion)
+	if err != nil || len(previousVersion) != 4 {
+		return nil, fmt.Errorf("invalid previous version %s", f.PreviousVersion)
+	}
+	currentVersion, err := hexutil.Decode(f.CurrentVersion)
+	if err != nil || len(currentVersion) != 4 {
+		return nil, fmt.Errorf("invalid current version %s", f.CurrentVersion)
+	}
+	epoch, err := strconv.ParseUint(f.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid fork epoch %s", f.Epoch)
+	}
+	return &pb.Fork{PreviousVersion: previousVersion, CurrentVersion: currentVersion, Epoch: types.Epoch(epoch)}, nil
+}
+
+func decodeRoot(s string) ([]byte, error) {
+	root, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(root) != 32 {
+		return nil, fmt.Errorf("expected 32 bytes, received %d", len(root))
+	}
+	return root, nil
+}
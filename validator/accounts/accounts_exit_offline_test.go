@@ -0,0 +1,158 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func presignTestExits(t *testing.T, key bls.SecretKey, epoch uint64) *PresignedExits {
+	cfg := &ExitSigningConfig{
+		GenesisValidatorsRoot: hexutil.Encode(bytesutil.PadTo([]byte("genesis"), 32)),
+		Fork: &ForkJSON{
+			PreviousVersion: "0x00000000",
+			CurrentVersion:  "0x01000000",
+			Epoch:           "10",
+		},
+		ValidatorIndices: map[string]string{hexutil.Encode(key.PublicKey().Marshal()): "7"},
+	}
+	signer := func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		return key.Sign(req.SigningRoot), nil
+	}
+	exits, err := presignExits(context.Background(), signer, cfg, [][]byte{key.PublicKey().Marshal()}, types.Epoch(epoch))
+	require.NoError(t, err)
+	return exits
+}
+
+func TestPresignExits_UsesForkActiveAtEpoch(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+
+	exits := presignTestExits(t, key, 5)
+	assert.Equal(t, "0x00000000", exits.ForkVersion)
+	exits = presignTestExits(t, key, 10)
+	assert.Equal(t, "0x01000000", exits.ForkVersion)
+	require.Equal(t, 1, len(exits.Exits))
+	assert.Equal(t, "7", exits.Exits[0].Message.ValidatorIndex)
+	assert.Equal(t, "10", exits.Exits[0].Message.Epoch)
+
+	signed, pubKey, err := exits.Exits[0].toProto()
+	require.NoError(t, err)
+	domain, err := helpers.ComputeDomain(
+		params.BeaconConfig().DomainVoluntaryExit,
+		[]byte{1, 0, 0, 0},
+		bytesutil.PadTo([]byte("genesis"), 32),
+	)
+	require.NoError(t, err)
+	require.NoError(t, helpers.VerifySigningRoot(signed.Exit, pubKey, signed.Signature, domain))
+}
+
+func TestPresignExits_MissingValidatorIndex(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+	cfg := &ExitSigningConfig{
+		GenesisValidatorsRoot: hexutil.Encode(make([]byte, 32)),
+		Fork:                  &ForkJSON{PreviousVersion: "0x00000000", CurrentVersion: "0x00000000", Epoch: "0"},
+		ValidatorIndices:      map[string]string{hexutil.Encode(otherKey.PublicKey().Marshal()): "1"},
+	}
+	signer := func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		return key.Sign(req.SigningRoot), nil
+	}
+	_, err = presignExits(context.Background(), signer, cfg, [][]byte{key.PublicKey().Marshal()}, 1)
+	assert.ErrorContains(t, "no validator index", err)
+}
+
+func TestBroadcastExits(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	exits := presignTestExits(t, key, 10)
+	signed, _, err := exits.Exits[0].toProto()
+	require.NoError(t, err)
+	domain, err := helpers.ComputeDomain(
+		params.BeaconConfig().DomainVoluntaryExit,
+		[]byte{1, 0, 0, 0},
+		bytesutil.PadTo([]byte("genesis"), 32),
+	)
+	require.NoError(t, err)
+	// Any time far enough in the past for the exit epoch to be reached.
+	genesis := &ethpb.Genesis{GenesisTime: &ptypes.Timestamp{Seconds: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix()}}
+
+	tests := []struct {
+		name        string
+		genesis     *ethpb.Genesis
+		index       uint64
+		domain      []byte
+		tamper      bool
+		wantErr     string
+		wantExited  int
+		wantPropose bool
+	}{
+		{name: "valid exit", genesis: genesis, index: 7, domain: domain, wantExited: 1, wantPropose: true},
+		{name: "not valid yet", genesis: &ethpb.Genesis{GenesisTime: ptypes.TimestampNow()}, index: 7, domain: domain},
+		{name: "other validator index", genesis: genesis, index: 8, domain: domain, wantErr: "beacon node knows the account as 8"},
+		{name: "other network", genesis: genesis, index: 7, domain: make([]byte, 32), wantErr: "different network or fork"},
+		{name: "invalid signature", genesis: genesis, index: 7, domain: domain, tamper: true, wantErr: "could not verify pre-signed exit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+			mockNodeClient := mock.NewMockNodeClient(ctrl)
+			mockNodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).Return(tt.genesis, nil)
+			mockValidatorClient.EXPECT().
+				ValidatorIndex(gomock.Any(), gomock.Any()).
+				Return(&ethpb.ValidatorIndexResponse{Index: types.ValidatorIndex(tt.index)}, nil).
+				AnyTimes()
+			mockValidatorClient.EXPECT().
+				DomainData(gomock.Any(), gomock.Any()).
+				Return(&ethpb.DomainResponse{SignatureDomain: tt.domain}, nil).
+				AnyTimes()
+			if tt.wantPropose {
+				mockValidatorClient.EXPECT().
+					ProposeExit(gomock.Any(), signed).
+					Return(&ethpb.ProposeExitResponse{}, nil)
+			}
+
+			toBroadcast := &PresignedExits{
+				GenesisValidatorsRoot: exits.GenesisValidatorsRoot,
+				ForkVersion:           exits.ForkVersion,
+				Exits: []*PresignedExit{{
+					PubKey:    exits.Exits[0].PubKey,
+					Message:   &VoluntaryExitJSON{Epoch: exits.Exits[0].Message.Epoch, ValidatorIndex: "7"},
+					Signature: exits.Exits[0].Signature,
+				}},
+			}
+			if tt.tamper {
+				toBroadcast.Exits[0].Message.Epoch = "11"
+			}
+			exitedKeys, formattedKeys, err := broadcastExits(context.Background(), mockValidatorClient, mockNodeClient, toBroadcast)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantExited, len(exitedKeys))
+			if tt.wantExited > 0 {
+				assert.Equal(t, fmt.Sprintf("%#x", bytesutil.Trunc(key.PublicKey().Marshal())), formattedKeys[0])
+			}
+		})
+	}
+}
@@ -100,6 +100,38 @@ var AccountCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "deposit-data",
+			Description: "generates offline a deposit_data.json bundle for a range of HD wallet accounts derived " +
+				"from a mnemonic, verifying every deposit signature, along with a report of their withdrawal " +
+				"credentials and derivation paths",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.MnemonicFileFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.DepositStartIndexFlag,
+				flags.NumAccountsFlag,
+				flags.DepositAmountFlag,
+				flags.DepositOutputDirFlag,
+				cmd.ChainConfigFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := DepositDataCli(cliCtx); err != nil {
+					log.Fatalf("Could not generate deposit data: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "import",
 			Description: `imports eth2 validator accounts stored in EIP-2335 keystore.json files from an external directory`,
@@ -162,5 +194,68 @@ var AccountCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "presign-exit",
+			Description: "Signs voluntary exits valid from a future epoch on selected accounts without a beacon node, " +
+				"using the network information of --exit-signing-config, and writes them to --presigned-exits-file",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitAllFlag,
+				flags.ExitEpochFlag,
+				flags.ExitSigningConfigFlag,
+				flags.PresignedExitsFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := PresignExitsCli(cliCtx, os.Stdin); err != nil {
+					log.Fatalf("Could not pre-sign voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "broadcast-exit",
+			Description: "Verifies the voluntary exits of --presigned-exits-file against the network of the beacon node " +
+				"and submits the ones which are already valid",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.PresignedExitsFileFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := BroadcastExitsCli(cliCtx); err != nil {
+					log.Fatalf("Could not broadcast pre-signed voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,283 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	// archiveVersion of the backup archive layout, bumped on incompatible changes.
+	archiveVersion = 1
+	// archivePrefix and archiveExtension of the archive files written to the backups directory.
+	archivePrefix    = "prysm_wallet_"
+	archiveExtension = ".backup"
+	// Names of the entries inside of an archive.
+	manifestFileName           = "manifest.json"
+	slashingProtectionFileName = "slashing_protection.json"
+	walletDirName              = "wallet"
+)
+
+// manifest describes the contents of an archive. Every entry is listed
+// with its sha256 checksum, which is verified before anything is restored.
+type manifest struct {
+	Version        int               `json:"version"`
+	CreatedAt      int64             `json:"created_at"`
+	KeymanagerKind string            `json:"keymanager_kind"`
+	Files          map[string]string `json:"files"`
+}
+
+// encryptedArchive is the on-disk representation of a backup: a zip archive
+// encrypted with the EIP-2335 keystore scheme used for the wallet itself.
+type encryptedArchive struct {
+	Crypto    map[string]interface{} `json:"crypto"`
+	ID        string                 `json:"uuid"`
+	Version   uint                   `json:"version"`
+	Name      string                 `json:"name"`
+	CreatedAt int64                  `json:"created_at"`
+}
+
+// archiveContents are the decrypted and verified entries of an archive.
+type archiveContents struct {
+	manifest *manifest
+	// walletFiles by path relative to the wallet directory.
+	walletFiles        map[string][]byte
+	slashingProtection []byte
+}
+
+// createArchive packages the keystores, the keymanager options of the wallet and an
+// EIP-3076 export of the slashing protection database into an encrypted archive.
+func createArchive(
+	ctx context.Context, w *wallet.Wallet, validatorDB db.Database, password string, now time.Time,
+) ([]byte, error) {
+	files, err := walletFiles(w)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read wallet files")
+	}
+	protection, err := slashingprotection.ExportStandardProtectionJSON(ctx, validatorDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not export slashing protection history")
+	}
+	encodedProtection, err := json.MarshalIndent(protection, "", "\t")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal slashing protection history")
+	}
+	entries := make(map[string][]byte, len(files)+1)
+	for name, data := range files {
+		entries[path.Join(walletDirName, name)] = data
+	}
+	entries[slashingProtectionFileName] = encodedProtection
+
+	m := &manifest{
+		Version:        archiveVersion,
+		CreatedAt:      now.Unix(),
+		KeymanagerKind: w.KeymanagerKind().String(),
+		Files:          make(map[string]string, len(entries)),
+	}
+	for name, data := range entries {
+		m.Files[name] = checksum(data)
+	}
+	encodedManifest, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal manifest")
+	}
+	entries[manifestFileName] = encodedManifest
+
+	zipped, err := zipEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(zipped, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt archive")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(&encryptedArchive{
+		Crypto:    cryptoFields,
+		ID:        id.String(),
+		Version:   encryptor.Version(),
+		Name:      encryptor.Name(),
+		CreatedAt: now.Unix(),
+	}, "", "\t")
+}
+
+// openArchive decrypts an archive and verifies every entry against the checksums of its manifest.
+func openArchive(encoded []byte, password string) (*archiveContents, error) {
+	archive := &encryptedArchive{}
+	if err := json.Unmarshal(encoded, archive); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal archive")
+	}
+	decryptor := keystorev4.New()
+	zipped, err := decryptor.Decrypt(archive.Crypto, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt archive, the password is wrong or the archive is corrupted")
+	}
+	entries, err := unzipEntries(zipped)
+	if err != nil {
+		return nil, err
+	}
+	encodedManifest, ok := entries[manifestFileName]
+	if !ok {
+		return nil, errors.New("archive has no manifest")
+	}
+	delete(entries, manifestFileName)
+	m := &manifest{}
+	if err := json.Unmarshal(encodedManifest, m); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal manifest")
+	}
+	if m.Version != archiveVersion {
+		return nil, fmt.Errorf("archive version %d is not supported, wanted %d", m.Version, archiveVersion)
+	}
+	if _, err := keymanager.ParseKind(m.KeymanagerKind); err != nil {
+		return nil, err
+	}
+	if len(entries) != len(m.Files) {
+		return nil, fmt.Errorf("archive has %d entries but its manifest lists %d", len(entries), len(m.Files))
+	}
+	contents := &archiveContents{
+		manifest:    m,
+		walletFiles: make(map[string][]byte),
+	}
+	for name, data := range entries {
+		want, ok := m.Files[name]
+		if !ok {
+			return nil, fmt.Errorf("archive entry %s is not listed in its manifest", name)
+		}
+		if checksum(data) != want {
+			return nil, fmt.Errorf("checksum of archive entry %s does not match its manifest", name)
+		}
+		if name == slashingProtectionFileName {
+			contents.slashingProtection = data
+			continue
+		}
+		if !strings.HasPrefix(name, walletDirName+"/") || path.Clean(name) != name || strings.Contains(name, "..") {
+			return nil, fmt.Errorf("archive entry %s is outside of the wallet directory", name)
+		}
+		contents.walletFiles[strings.TrimPrefix(name, walletDirName+"/")] = data
+	}
+	if contents.slashingProtection == nil {
+		return nil, errors.New("archive has no slashing protection history")
+	}
+	return contents, nil
+}
+
+// walletFiles reads the keymanager options and the keystores of a wallet, keyed by
+// their path relative to the wallet directory. The validator database and any
+// other files which may share the directory are left out.
+func walletFiles(w *wallet.Wallet) (map[string][]byte, error) {
+	kindDir := w.KeymanagerKind().String()
+	files := make(map[string][]byte)
+	configPath := filepath.Join(w.AccountsDir(), wallet.KeymanagerConfigFileName)
+	if fileutil.FileExists(configPath) {
+		data, err := ioutil.ReadFile(configPath)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(kindDir, wallet.KeymanagerConfigFileName)] = data
+	}
+	keystoresDir := filepath.Join(w.AccountsDir(), imported.AccountsPath)
+	hasDir, err := fileutil.HasDir(keystoresDir)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		return files, nil
+	}
+	err = filepath.Walk(keystoresDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(w.AccountsDir(), p)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[path.Join(kindDir, filepath.ToSlash(rel))] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func zipEntries(entries map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for _, name := range names {
+		f, err := writer.Create(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not add %s to archive", name)
+		}
+		if _, err := f.Write(entries[name]); err != nil {
+			return nil, errors.Wrapf(err, "could not write %s to archive", name)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close archive")
+	}
+	return buf.Bytes(), nil
+}
+
+func unzipEntries(zipped []byte) (map[string][]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(zipped), int64(len(zipped)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read archive")
+	}
+	entries := make(map[string][]byte, len(reader.File))
+	for _, f := range reader.File {
+		if _, ok := entries[f.Name]; ok {
+			return nil, fmt.Errorf("archive has duplicate entry %s", f.Name)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open archive entry %s", f.Name)
+		}
+		data, err := ioutil.ReadAll(rc)
+		if closeErr := rc.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read archive entry %s", f.Name)
+		}
+		entries[f.Name] = data
+	}
+	return entries, nil
+}
+
+func checksum(data []byte) string {
+	h := sha256.Sum256(data)
+	return fmt.Sprintf("%x", h)
+}
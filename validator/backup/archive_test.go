@@ -0,0 +1,116 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const testPassword = "Passw0rdz2020%"
+
+var (
+	testKeystore = []byte(`{"crypto": {}, "version": 4}`)
+	testOpts     = []byte(`{"direct_eip_version": "EIP-2335"}`)
+)
+
+func setupWallet(t *testing.T) *wallet.Wallet {
+	walletDir := t.TempDir()
+	kindDir := filepath.Join(walletDir, keymanager.Imported.String())
+	require.NoError(t, fileutil.MkdirAll(filepath.Join(kindDir, imported.AccountsPath)))
+	require.NoError(t, fileutil.WriteFile(
+		filepath.Join(kindDir, imported.AccountsPath, imported.AccountsKeystoreFileName), testKeystore,
+	))
+	require.NoError(t, fileutil.WriteFile(filepath.Join(kindDir, wallet.KeymanagerConfigFileName), testOpts))
+	// The validator database may live in the wallet, but is backed up as slashing protection history.
+	require.NoError(t, fileutil.WriteFile(filepath.Join(kindDir, "validator.db"), []byte("database")))
+	w, err := wallet.OpenWallet(context.Background(), &wallet.Config{WalletDir: walletDir})
+	require.NoError(t, err)
+	return w
+}
+
+func saveSigningHistory(t *testing.T, validatorDB db.Database, pubKey [48]byte, slot types.Slot, target types.Epoch) {
+	ctx := context.Background()
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, bytesutil.PadTo([]byte("genesis"), 32)))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, bytesutil.PadTo([]byte("block"), 32)))
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(ctx, pubKey, [][32]byte{{'a'}}, []*ethpb.IndexedAttestation{
+		{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: target - 1},
+				Target: &ethpb.Checkpoint{Epoch: target},
+			},
+		},
+	}))
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	w := setupWallet(t)
+	validatorDB := dbtest.SetupDB(t, nil)
+	saveSigningHistory(t, validatorDB, [48]byte{1}, 10, 3)
+
+	now := time.Unix(1600000000, 0)
+	encoded, err := createArchive(ctx, w, validatorDB, testPassword, now)
+	require.NoError(t, err)
+
+	contents, err := openArchive(encoded, testPassword)
+	require.NoError(t, err)
+	assert.Equal(t, archiveVersion, contents.manifest.Version)
+	assert.Equal(t, now.Unix(), contents.manifest.CreatedAt)
+	assert.Equal(t, keymanager.Imported.String(), contents.manifest.KeymanagerKind)
+	kindDir := keymanager.Imported.String()
+	assert.DeepEqual(t, map[string][]byte{
+		kindDir + "/accounts/all-accounts.keystore.json": testKeystore,
+		kindDir + "/keymanageropts.json":                 testOpts,
+	}, contents.walletFiles)
+	assert.Equal(t, true, strings.Contains(string(contents.slashingProtection), fmt.Sprintf("%#x", [48]byte{1})))
+}
+
+func TestOpenArchive_WrongPassword(t *testing.T) {
+	encoded, err := createArchive(context.Background(), setupWallet(t), dbtest.SetupDB(t, nil), testPassword, time.Now())
+	require.NoError(t, err)
+	_, err = openArchive(encoded, "wrong password")
+	assert.ErrorContains(t, "could not decrypt archive", err)
+}
+
+func TestOpenArchive_ChecksumMismatch(t *testing.T) {
+	ctx := context.Background()
+	encoded, err := createArchive(ctx, setupWallet(t), dbtest.SetupDB(t, nil), testPassword, time.Now())
+	require.NoError(t, err)
+
+	// Tamper with a keystore and re-encrypt the archive with the right password,
+	// so only the checksums of the manifest can detect the change.
+	archive := &encryptedArchive{}
+	require.NoError(t, json.Unmarshal(encoded, archive))
+	zipped, err := keystorev4.New().Decrypt(archive.Crypto, testPassword)
+	require.NoError(t, err)
+	entries, err := unzipEntries(zipped)
+	require.NoError(t, err)
+	keystoreEntry := "wallet/" + keymanager.Imported.String() + "/accounts/all-accounts.keystore.json"
+	entries[keystoreEntry] = []byte("{}")
+	zipped, err = zipEntries(entries)
+	require.NoError(t, err)
+	archive.Crypto, err = keystorev4.New().Encrypt(zipped, testPassword)
+	require.NoError(t, err)
+	encoded, err = json.Marshal(archive)
+	require.NoError(t, err)
+
+	_, err = openArchive(encoded, testPassword)
+	assert.ErrorContains(t, "checksum of archive entry "+keystoreEntry, err)
+}
@@ -0,0 +1,148 @@
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for creating and restoring encrypted wallet backups.
+var Commands = &cli.Command{
+	Name:     "wallet-backups",
+	Category: "wallet-backups",
+	Usage:    "defines commands for creating and restoring encrypted backups of a wallet and its slashing protection history",
+	Subcommands: []*cli.Command{
+		{
+			Name: "create",
+			Description: "writes an encrypted archive of the keystores, the wallet configuration and the slashing " +
+				"protection history to --wallet-backup-dir, like the validator client does with --wallet-backup-interval",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+				flags.WalletBackupDirFlag,
+				flags.WalletBackupRetentionFlag,
+				flags.WalletBackupPasswordFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := createBackupCli(cliCtx); err != nil {
+					log.Fatalf("Could not back up wallet: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "restore",
+			Description: "verifies the integrity of a wallet backup and restores its wallet into --wallet-dir and its " +
+				"slashing protection history into the validator database, refusing to replace more recent history",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+				flags.WalletBackupFileFlag,
+				flags.WalletBackupPasswordFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := restoreBackupCli(cliCtx); err != nil {
+					log.Fatalf("Could not restore wallet backup: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+func createBackupCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: cliCtx.String(flags.WalletDirFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.WalletBackupPasswordFileFlag,
+		"Enter a password for your wallet backup",
+		"Confirm password",
+		true, /* Confirm password */
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for wallet backup")
+	}
+	dataDir := w.AccountsDir()
+	if cliCtx.String(cmd.DataDirFlag.Name) != cmd.DefaultDataDir() {
+		dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	archivePath, err := WriteBackup(
+		cliCtx.Context,
+		w,
+		validatorDB,
+		password,
+		cliCtx.String(flags.WalletBackupDirFlag.Name),
+		cliCtx.Int(flags.WalletBackupRetentionFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	log.WithField("backup", archivePath).Info("Wrote encrypted wallet backup")
+	return nil
+}
+
+func restoreBackupCli(cliCtx *cli.Context) error {
+	backupFile := cliCtx.String(flags.WalletBackupFileFlag.Name)
+	if backupFile == "" {
+		return errors.Errorf("no wallet backup specified, please set --%s", flags.WalletBackupFileFlag.Name)
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.WalletBackupPasswordFileFlag,
+		"Enter the password of your wallet backup",
+		"",
+		false, /* Do not confirm password */
+		wallet.ValidateExistingPass,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for wallet backup")
+	}
+	var dataDir string
+	if cliCtx.String(cmd.DataDirFlag.Name) != cmd.DefaultDataDir() {
+		dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	return Restore(cliCtx.Context, backupFile, password, cliCtx.String(flags.WalletDirFlag.Name), dataDir)
+}
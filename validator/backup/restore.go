@@ -0,0 +1,172 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+)
+
+// ErrOutdatedSlashingProtection is returned when the slashing protection history of a
+// backup is older than the one of the validator database it would be restored into.
+var ErrOutdatedSlashingProtection = errors.New("slashing protection history of the backup is older than the one on disk")
+
+// Restore verifies the integrity of a backup and restores its slashing protection history
+// into the validator database at the data directory and its wallet into the wallet directory.
+// An empty data directory defaults to the accounts directory of the restored wallet, like
+// for the validator client. Nothing is restored if the backup is corrupted, a wallet already
+// exists in the wallet directory or the slashing protection history on disk is more recent
+// than the backup's.
+func Restore(ctx context.Context, backupFile, password, walletDir, dataDir string) error {
+	encoded, err := fileutil.ReadFileAsBytes(backupFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read backup")
+	}
+	contents, err := openArchive(encoded, password)
+	if err != nil {
+		return err
+	}
+	exists, err := wallet.Exists(walletDir)
+	if err != nil {
+		return errors.Wrap(err, wallet.CheckExistsErrMsg)
+	}
+	if exists {
+		return fmt.Errorf("a wallet already exists at %s", walletDir)
+	}
+	if dataDir == "" {
+		dataDir = filepath.Join(walletDir, contents.manifest.KeymanagerKind)
+	}
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	return restore(ctx, contents, walletDir, validatorDB)
+}
+
+func restore(ctx context.Context, contents *archiveContents, walletDir string, validatorDB db.Database) error {
+	backupProtection := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(contents.slashingProtection, backupProtection); err != nil {
+		return errors.Wrap(err, "could not unmarshal slashing protection history of backup")
+	}
+	diskProtection, err := slashingprotection.ExportStandardProtectionJSON(ctx, validatorDB)
+	if err != nil {
+		return errors.Wrap(err, "could not read slashing protection history on disk")
+	}
+	if err := verifyNotOutdated(backupProtection, diskProtection); err != nil {
+		return err
+	}
+
+	// Slashing protection is restored first: if writing the wallet fails afterwards, the
+	// database has only gained history, which can never make signing less safe.
+	if len(backupProtection.Data) > 0 {
+		if err := slashingprotection.ImportStandardProtectionJSON(
+			ctx, validatorDB, bytes.NewReader(contents.slashingProtection),
+		); err != nil {
+			return errors.Wrap(err, "could not restore slashing protection history")
+		}
+	}
+	for name, data := range contents.walletFiles {
+		filePath := filepath.Join(walletDir, filepath.FromSlash(name))
+		if err := fileutil.MkdirAll(filepath.Dir(filePath)); err != nil {
+			return errors.Wrapf(err, "could not create directory for %s", filePath)
+		}
+		if err := fileutil.WriteFile(filePath, data); err != nil {
+			return errors.Wrapf(err, "could not restore %s", filePath)
+		}
+	}
+	log.WithField("walletDir", walletDir).Infof(
+		"Restored %s wallet and slashing protection history of %d public keys from backup",
+		contents.manifest.KeymanagerKind, len(backupProtection.Data),
+	)
+	return nil
+}
+
+// signingWatermark is the highest signed block slot and attestation target epoch of a public key.
+type signingWatermark struct {
+	blockSlot   types.Slot
+	hasBlock    bool
+	targetEpoch types.Epoch
+	hasTarget   bool
+}
+
+// verifyNotOutdated checks that the backup holds, for every public key with history on
+// disk, signed blocks and attestations at least as recent as the ones on disk.
+func verifyNotOutdated(backup, disk *format.EIPSlashingProtectionFormat) error {
+	backupMarks, err := signingWatermarks(backup)
+	if err != nil {
+		return errors.Wrap(err, "could not parse slashing protection history of backup")
+	}
+	diskMarks, err := signingWatermarks(disk)
+	if err != nil {
+		return errors.Wrap(err, "could not parse slashing protection history on disk")
+	}
+	for pubKey, onDisk := range diskMarks {
+		inBackup, ok := backupMarks[pubKey]
+		if !ok {
+			inBackup = &signingWatermark{}
+		}
+		if onDisk.hasBlock && (!inBackup.hasBlock || inBackup.blockSlot < onDisk.blockSlot) {
+			return errors.Wrapf(
+				ErrOutdatedSlashingProtection,
+				"public key %s signed a block at slot %d which is not in the backup", pubKey, onDisk.blockSlot,
+			)
+		}
+		if onDisk.hasTarget && (!inBackup.hasTarget || inBackup.targetEpoch < onDisk.targetEpoch) {
+			return errors.Wrapf(
+				ErrOutdatedSlashingProtection,
+				"public key %s signed an attestation with target epoch %d which is not in the backup", pubKey, onDisk.targetEpoch,
+			)
+		}
+	}
+	return nil
+}
+
+func signingWatermarks(protection *format.EIPSlashingProtectionFormat) (map[string]*signingWatermark, error) {
+	marks := make(map[string]*signingWatermark, len(protection.Data))
+	for _, data := range protection.Data {
+		pubKey, err := slashingprotection.PubKeyFromHex(data.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%#x", pubKey)
+		mark, ok := marks[key]
+		if !ok {
+			mark = &signingWatermark{}
+			marks[key] = mark
+		}
+		for _, block := range data.SignedBlocks {
+			slot, err := slashingprotection.SlotFromString(block.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if !mark.hasBlock || slot > mark.blockSlot {
+				mark.blockSlot, mark.hasBlock = slot, true
+			}
+		}
+		for _, att := range data.SignedAttestations {
+			target, err := slashingprotection.EpochFromString(att.TargetEpoch)
+			if err != nil {
+				return nil, err
+			}
+			if !mark.hasTarget || target > mark.targetEpoch {
+				mark.targetEpoch, mark.hasTarget = target, true
+			}
+		}
+	}
+	return marks, nil
+}
@@ -0,0 +1,104 @@
+package backup
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+)
+
+// writeTestBackup writes a backup and closes its database, as only one validator
+// database can be opened at a time.
+func writeTestBackup(t *testing.T) string {
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	saveSigningHistory(t, validatorDB, [48]byte{1}, 10, 3)
+	archivePath, err := WriteBackup(ctx, setupWallet(t), validatorDB, testPassword, filepath.Join(t.TempDir(), "backups"), 1)
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.Close())
+	return archivePath
+}
+
+func TestRestore_OK(t *testing.T) {
+	ctx := context.Background()
+	archivePath := writeTestBackup(t)
+	walletDir := filepath.Join(t.TempDir(), "wallet")
+
+	require.NoError(t, Restore(ctx, archivePath, testPassword, walletDir, ""))
+
+	w, err := wallet.OpenWallet(ctx, &wallet.Config{WalletDir: walletDir})
+	require.NoError(t, err)
+	keystore, err := fileutil.ReadFileAsBytes(
+		filepath.Join(w.AccountsDir(), imported.AccountsPath, imported.AccountsKeystoreFileName),
+	)
+	require.NoError(t, err)
+	assert.DeepEqual(t, testKeystore, keystore)
+
+	// The slashing protection history is restored into the database of the wallet.
+	validatorDB, err := kv.NewKVStore(ctx, w.AccountsDir(), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	slot, ok, err := validatorDB.HighestSignedProposal(ctx, [48]byte{1})
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(10), slot)
+}
+
+func TestRestore_ExistingWallet(t *testing.T) {
+	archivePath := writeTestBackup(t)
+	walletDir := filepath.Dir(setupWallet(t).AccountsDir())
+	err := Restore(context.Background(), archivePath, testPassword, walletDir, "")
+	assert.ErrorContains(t, "a wallet already exists", err)
+}
+
+func TestRestore_OutdatedSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	archivePath := writeTestBackup(t)
+	encoded, err := fileutil.ReadFileAsBytes(archivePath)
+	require.NoError(t, err)
+	contents, err := openArchive(encoded, testPassword)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		slot     types.Slot
+		target   types.Epoch
+		pubKey   [48]byte
+		wantErr  string
+		restored bool
+	}{
+		{name: "same history", pubKey: [48]byte{1}, slot: 10, target: 3, restored: true},
+		{name: "older history", pubKey: [48]byte{1}, slot: 5, target: 2, restored: true},
+		{name: "newer block", pubKey: [48]byte{1}, slot: 11, target: 3, wantErr: "signed a block at slot 11"},
+		{name: "newer attestation", pubKey: [48]byte{1}, slot: 10, target: 4, wantErr: "target epoch 4"},
+		{name: "unknown key", pubKey: [48]byte{2}, slot: 1, target: 1, wantErr: "signed a block at slot 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorDB := dbtest.SetupDB(t, nil)
+			saveSigningHistory(t, validatorDB, tt.pubKey, tt.slot, tt.target)
+			walletDir := filepath.Join(t.TempDir(), "wallet")
+			err := restore(ctx, contents, walletDir, validatorDB)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				assert.ErrorContains(t, ErrOutdatedSlashingProtection.Error(), err)
+			} else {
+				require.NoError(t, err)
+			}
+			exists, err := wallet.Exists(walletDir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.restored, exists)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Package backup defines a service which periodically writes encrypted archives of a
+// validator's wallet and slashing protection history, and the means to restore them.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/sirupsen/logrus"
+)
+
+// Config for the wallet backup service.
+type Config struct {
+	Wallet      *wallet.Wallet
+	ValidatorDB db.Database
+	OutputDir   string
+	Interval    time.Duration
+	// Retention is the number of most recent archives kept in the output directory.
+	Retention int
+	Password  string
+}
+
+// Service writes an encrypted archive of the wallet and the slashing protection
+// history at a regular interval, deleting the oldest archives beyond the retention.
+type Service struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cfg     *Config
+	lock    sync.RWMutex
+	lastErr error
+}
+
+// NewService instantiates a wallet backup service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Wallet == nil || cfg.ValidatorDB == nil {
+		return nil, errors.New("wallet backups need a wallet and a validator database")
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("wallet backup interval must be positive, got %v", cfg.Interval)
+	}
+	if cfg.Retention <= 0 {
+		return nil, fmt.Errorf("wallet backup retention must be positive, got %d", cfg.Retention)
+	}
+	if cfg.Password == "" {
+		return nil, errors.New("wallet backups need a password")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}, nil
+}
+
+// Start writes a first backup and schedules the following ones.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"interval":  s.cfg.Interval,
+		"outputDir": s.cfg.OutputDir,
+		"retention": s.cfg.Retention,
+	}).Info("Scheduling encrypted wallet backups")
+	go s.run()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the last backup, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		s.backup()
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) backup() {
+	archivePath, err := WriteBackup(s.ctx, s.cfg.Wallet, s.cfg.ValidatorDB, s.cfg.Password, s.cfg.OutputDir, s.cfg.Retention)
+	s.lock.Lock()
+	s.lastErr = err
+	s.lock.Unlock()
+	if err != nil {
+		log.WithError(err).Error("Could not back up wallet")
+		return
+	}
+	log.WithField("backup", archivePath).Info("Wrote encrypted wallet backup")
+}
+
+// WriteBackup writes an encrypted archive of the wallet and the slashing protection history
+// to the output directory, keeping the most recent archives up to the retention.
+// It returns the path of the new archive.
+func WriteBackup(
+	ctx context.Context, w *wallet.Wallet, validatorDB db.Database, password, outputDir string, retention int,
+) (string, error) {
+	dir, err := fileutil.ExpandPath(outputDir)
+	if err != nil {
+		return "", err
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return "", errors.Wrapf(err, "could not create directory %s", dir)
+	}
+	now := time.Now()
+	archive, err := createArchive(ctx, w, validatorDB, password, now)
+	if err != nil {
+		return "", err
+	}
+	// The archive is renamed into place once fully written, so a backup interrupted
+	// halfway is never mistaken for a complete one.
+	archivePath := filepath.Join(dir, fmt.Sprintf("%s%d%s", archivePrefix, now.Unix(), archiveExtension))
+	tmpPath := archivePath + ".tmp"
+	if err := fileutil.WriteFile(tmpPath, archive); err != nil {
+		return "", errors.Wrap(err, "could not write archive")
+	}
+	if err := os.Rename(tmpPath, archivePath); err != nil {
+		return "", errors.Wrap(err, "could not move archive into place")
+	}
+	if err := pruneBackups(dir, retention); err != nil {
+		return "", errors.Wrap(err, "could not delete old backups")
+	}
+	return archivePath, nil
+}
+
+// pruneBackups deletes the oldest archives in a directory beyond the retention.
+func pruneBackups(dir string, retention int) error {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	type archiveFile struct {
+		name      string
+		createdAt int64
+	}
+	archives := make([]archiveFile, 0, len(infos))
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasPrefix(name, archivePrefix) || !strings.HasSuffix(name, archiveExtension) {
+			continue
+		}
+		createdAt, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, archivePrefix), archiveExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		archives = append(archives, archiveFile{name: name, createdAt: createdAt})
+	}
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].createdAt > archives[j].createdAt
+	})
+	for i := retention; i < len(archives); i++ {
+		archivePath := filepath.Join(dir, archives[i].name)
+		if err := os.Remove(archivePath); err != nil {
+			return err
+		}
+		log.WithField("backup", archivePath).Debug("Deleted old wallet backup")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestWriteBackup_Retention(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "backups")
+	require.NoError(t, fileutil.MkdirAll(dir))
+	// Archives of previous runs, and a file which is not an archive.
+	for _, createdAt := range []int64{100, 300, 200} {
+		name := fmt.Sprintf("%s%d%s", archivePrefix, createdAt, archiveExtension)
+		require.NoError(t, fileutil.WriteFile(filepath.Join(dir, name), []byte("archive")))
+	}
+	require.NoError(t, fileutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes")))
+
+	archivePath, err := WriteBackup(context.Background(), setupWallet(t), dbtest.SetupDB(t, nil), testPassword, dir, 2)
+	require.NoError(t, err)
+
+	infos, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	assert.DeepEqual(t, []string{"notes.txt", filepath.Base(archivePath), archivePrefix + "300" + archiveExtension}, names)
+}
+
+func TestNewService_InvalidConfig(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{
+		Wallet:      setupWallet(t),
+		ValidatorDB: dbtest.SetupDB(t, nil),
+		Interval:    0,
+		Retention:   1,
+		Password:    testPassword,
+	})
+	assert.ErrorContains(t, "interval must be positive", err)
+}
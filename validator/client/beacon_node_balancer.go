@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+)
+
+// healthiestBeaconNodeBalancerName is the name of the gRPC load balancer which sends
+// every request to the healthiest beacon node, as determined by beaconNodeHealth.
+const healthiestBeaconNodeBalancerName = "healthiest_beacon_node"
+
+// healthiestBeaconNodeServiceConfig selects the healthiest beacon node load balancer
+// for a gRPC client connection.
+var healthiestBeaconNodeServiceConfig = fmt.Sprintf(
+	`{"loadBalancingConfig":[{"%s":{}}]}`, healthiestBeaconNodeBalancerName,
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(
+		healthiestBeaconNodeBalancerName,
+		&healthiestBeaconNodePickerBuilder{},
+		base.Config{HealthCheck: false},
+	))
+}
+
+type healthiestBeaconNodePickerBuilder struct{}
+
+// Build a picker over the ready connections. The beacon node health tracker is read
+// from the address attributes set by multipleEndpointsGrpcResolver.
+func (*healthiestBeaconNodePickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &healthiestBeaconNodePicker{
+		subConns: make(map[string]balancer.SubConn, len(info.ReadySCs)),
+	}
+	for sc, scInfo := range info.ReadySCs {
+		addr := scInfo.Address.Addr
+		p.subConns[addr] = sc
+		p.addrs = append(p.addrs, addr)
+		if scInfo.Address.Attributes == nil {
+			continue
+		}
+		if h, ok := scInfo.Address.Attributes.Value(beaconNodeHealthKey{}).(*beaconNodeHealth); ok {
+			p.health = h
+		}
+	}
+	sort.Strings(p.addrs)
+	return p
+}
+
+type healthiestBeaconNodePicker struct {
+	health   *beaconNodeHealth
+	addrs    []string
+	subConns map[string]balancer.SubConn
+}
+
+// Pick the ready connection to the healthiest beacon node.
+func (p *healthiestBeaconNodePicker) Pick(_ balancer.PickInfo) (balancer.PickResult, error) {
+	addr := p.addrs[0]
+	if p.health != nil {
+		addr = p.health.best(p.addrs)
+	}
+	beaconNodeRoutedCounter.WithLabelValues(addr).Inc()
+	return balancer.PickResult{SubConn: p.subConns[addr]}, nil
+}
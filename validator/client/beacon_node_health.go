@@ -0,0 +1,280 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// maxHeadSlotLag is the number of slots a beacon node head may trail the highest
+// head reported by any configured beacon node while still being considered healthy.
+const maxHeadSlotLag = types.Slot(2)
+
+// beaconNodeClients holds the gRPC clients for a single beacon node endpoint.
+type beaconNodeClients struct {
+	node      ethpb.NodeClient
+	beacon    ethpb.BeaconChainClient
+	validator ethpb.BeaconNodeValidatorClient
+}
+
+// beaconNodeStatus is the last observed state of a beacon node endpoint.
+type beaconNodeStatus struct {
+	probed   bool
+	syncing  bool
+	headSlot types.Slot
+	latency  time.Duration
+	errCount uint64
+	lastErr  error
+}
+
+// beaconNodeHealth keeps track of the sync status, head slot, latency and errors of
+// every configured beacon node. It is used to route requests to the healthiest
+// beacon node and to broadcast signed objects to all healthy ones.
+type beaconNodeHealth struct {
+	endpoints []string
+	clients   map[string]*beaconNodeClients
+	conns     []*grpc.ClientConn
+	lock      sync.RWMutex
+	statuses  map[string]*beaconNodeStatus
+	// blockRequestDeadline is how long to wait for every beacon node to build a block
+	// before choosing the best block received so far.
+	blockRequestDeadline time.Duration
+}
+
+func newBeaconNodeHealth(endpoints []string, clients map[string]*beaconNodeClients) *beaconNodeHealth {
+	statuses := make(map[string]*beaconNodeStatus, len(endpoints))
+	for _, e := range endpoints {
+		statuses[e] = &beaconNodeStatus{}
+	}
+	return &beaconNodeHealth{
+		endpoints:            endpoints,
+		clients:              clients,
+		statuses:             statuses,
+		blockRequestDeadline: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 12,
+	}
+}
+
+// dialBeaconNodes opens a dedicated connection to each endpoint, used for health
+// probes and broadcasting.
+func dialBeaconNodes(ctx context.Context, endpoints []string, dialOpts []grpc.DialOption) (*beaconNodeHealth, error) {
+	clients := make(map[string]*beaconNodeClients, len(endpoints))
+	conns := make([]*grpc.ClientConn, 0, len(endpoints))
+	for _, e := range endpoints {
+		conn, err := grpc.DialContext(ctx, e, dialOpts...)
+		if err != nil {
+			for _, c := range conns {
+				if err := c.Close(); err != nil {
+					log.WithError(err).Debug("Could not close beacon node connection")
+				}
+			}
+			return nil, errors.Wrapf(err, "could not dial endpoint %s", e)
+		}
+		conns = append(conns, conn)
+		clients[e] = &beaconNodeClients{
+			node:      ethpb.NewNodeClient(conn),
+			beacon:    ethpb.NewBeaconChainClient(conn),
+			validator: ethpb.NewBeaconNodeValidatorClient(conn),
+		}
+	}
+	h := newBeaconNodeHealth(endpoints, clients)
+	h.conns = conns
+	return h, nil
+}
+
+// run probes every beacon node once per slot until the context is canceled.
+func (h *beaconNodeHealth) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	h.probeAll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.probeAll(ctx)
+		}
+	}
+}
+
+// close releases the dedicated beacon node connections.
+func (h *beaconNodeHealth) close() error {
+	var err error
+	for _, c := range h.conns {
+		if closeErr := c.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// probeAll concurrently queries the sync status and chain head of every beacon node.
+func (h *beaconNodeHealth) probeAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range h.endpoints {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+			h.probe(ctx, endpoint)
+		}(e)
+	}
+	wg.Wait()
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	for _, e := range h.endpoints {
+		healthy := 0.0
+		if h.isHealthy(e) {
+			healthy = 1
+		}
+		beaconNodeHealthyGauge.WithLabelValues(e).Set(healthy)
+	}
+}
+
+func (h *beaconNodeHealth) probe(ctx context.Context, endpoint string) {
+	c := h.clients[endpoint]
+	start := time.Now()
+	syncStatus, err := c.node.GetSyncStatus(ctx, &ptypes.Empty{})
+	var head *ethpb.ChainHead
+	if err == nil {
+		head, err = c.beacon.GetChainHead(ctx, &ptypes.Empty{})
+	}
+	latency := time.Since(start)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	st := h.statuses[endpoint]
+	st.probed = true
+	st.lastErr = err
+	if err != nil {
+		st.errCount++
+		beaconNodeErrorsCounter.WithLabelValues(endpoint).Inc()
+		log.WithError(err).WithField("endpoint", endpoint).Debug("Beacon node health probe failed")
+		return
+	}
+	st.errCount = 0
+	st.syncing = syncStatus.Syncing
+	st.headSlot = head.HeadSlot
+	st.latency = latency
+	beaconNodeHeadSlotGauge.WithLabelValues(endpoint).Set(float64(head.HeadSlot))
+	beaconNodeLatencyGauge.WithLabelValues(endpoint).Set(latency.Seconds())
+}
+
+// highestHeadSlot returns the highest head slot reported by a responsive beacon node.
+// The caller must hold the lock.
+func (h *beaconNodeHealth) highestHeadSlot() types.Slot {
+	var highest types.Slot
+	for _, st := range h.statuses {
+		if st.probed && st.lastErr == nil && st.headSlot > highest {
+			highest = st.headSlot
+		}
+	}
+	return highest
+}
+
+// isHealthy reports whether the endpoint answered its last probe, is not syncing and
+// is close to the highest known head. The caller must hold the lock.
+func (h *beaconNodeHealth) isHealthy(endpoint string) bool {
+	st, ok := h.statuses[endpoint]
+	if !ok || !st.probed || st.lastErr != nil || st.syncing {
+		return false
+	}
+	return st.headSlot+maxHeadSlotLag >= h.highestHeadSlot()
+}
+
+// healthyEndpoints returns the healthy endpoints, ordered from the healthiest.
+func (h *beaconNodeHealth) healthyEndpoints() []string {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	healthy := make([]string, 0, len(h.endpoints))
+	for _, e := range h.endpoints {
+		if h.isHealthy(e) {
+			healthy = append(healthy, e)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return h.statuses[healthy[i]].latency < h.statuses[healthy[j]].latency
+	})
+	return healthy
+}
+
+// best returns the healthiest of the given candidate endpoints. Healthy endpoints are
+// preferred, then the ones with the highest head slot and the lowest latency. Ties,
+// and endpoints which were never probed, keep the order in which the endpoints were
+// configured.
+func (h *beaconNodeHealth) best(candidates []string) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	order := make(map[string]int, len(h.endpoints))
+	for i, e := range h.endpoints {
+		order[e] = i
+	}
+	sorted := make([]string, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		aHealthy, bHealthy := h.isHealthy(a), h.isHealthy(b)
+		if aHealthy != bHealthy {
+			return aHealthy
+		}
+		if aHealthy {
+			sa, sb := h.statuses[a], h.statuses[b]
+			if sa.headSlot != sb.headSlot {
+				return sa.headSlot > sb.headSlot
+			}
+			if sa.latency != sb.latency {
+				return sa.latency < sb.latency
+			}
+		}
+		return order[a] < order[b]
+	})
+	return sorted[0]
+}
+
+// broadcast runs fn concurrently against every healthy beacon node, or against all
+// beacon nodes if none is healthy. It succeeds if at least one beacon node accepted
+// the request.
+func (h *beaconNodeHealth) broadcast(
+	ctx context.Context, kind string, fn func(context.Context, ethpb.BeaconNodeValidatorClient) error,
+) error {
+	endpoints := h.healthyEndpoints()
+	if len(endpoints) == 0 {
+		endpoints = h.endpoints
+	}
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint string) {
+			defer wg.Done()
+			if err := fn(ctx, h.clients[endpoint].validator); err != nil {
+				errs[i] = err
+				beaconNodeBroadcastCounter.WithLabelValues(endpoint, kind, "failure").Inc()
+				log.WithError(err).WithFields(logrus.Fields{
+					"endpoint": endpoint,
+					"type":     kind,
+				}).Debug("Could not broadcast to beacon node")
+				return
+			}
+			beaconNodeBroadcastCounter.WithLabelValues(endpoint, kind, "success").Inc()
+		}(i, e)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(errs[0], "no beacon node accepted the %s", kind)
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/resolver"
+)
+
+type mockBeaconNode struct {
+	syncing  bool
+	headSlot types.Slot
+	err      error
+}
+
+func setupBeaconNodeHealth(
+	ctrl *gomock.Controller, nodes map[string]mockBeaconNode, endpoints []string,
+) (*beaconNodeHealth, map[string]*mock.MockBeaconNodeValidatorClient) {
+	clients := make(map[string]*beaconNodeClients, len(endpoints))
+	validatorClients := make(map[string]*mock.MockBeaconNodeValidatorClient, len(endpoints))
+	for _, e := range endpoints {
+		n := nodes[e]
+		nodeClient := mock.NewMockNodeClient(ctrl)
+		beaconClient := mock.NewMockBeaconChainClient(ctrl)
+		if n.err != nil {
+			nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, n.err).AnyTimes()
+		} else {
+			nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(
+				&ethpb.SyncStatus{Syncing: n.syncing}, nil,
+			).AnyTimes()
+			beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(
+				&ethpb.ChainHead{HeadSlot: n.headSlot}, nil,
+			).AnyTimes()
+		}
+		validatorClients[e] = mock.NewMockBeaconNodeValidatorClient(ctrl)
+		clients[e] = &beaconNodeClients{node: nodeClient, beacon: beaconClient, validator: validatorClients[e]}
+	}
+	return newBeaconNodeHealth(endpoints, clients), validatorClients
+}
+
+func TestBeaconNodeHealth_ProbeAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"syncing:4000", "error:4000", "lagging:4000", "healthy:4000", "behind:4000"}
+	h, _ := setupBeaconNodeHealth(ctrl, map[string]mockBeaconNode{
+		"syncing:4000": {syncing: true, headSlot: 100},
+		"error:4000":   {err: errors.New("unavailable")},
+		"lagging:4000": {headSlot: 90},
+		"healthy:4000": {headSlot: 100},
+		"behind:4000":  {headSlot: 98},
+	}, endpoints)
+	h.probeAll(context.Background())
+
+	healthy := h.healthyEndpoints()
+	sort.Strings(healthy)
+	assert.DeepEqual(t, []string{"behind:4000", "healthy:4000"}, healthy)
+	assert.Equal(t, "healthy:4000", h.best(endpoints))
+	assert.Equal(t, "behind:4000", h.best([]string{"lagging:4000", "behind:4000", "error:4000"}))
+	assert.Equal(t, uint64(1), h.statuses["error:4000"].errCount)
+}
+
+func TestBeaconNodeHealth_Best_NotProbedKeepsOrder(t *testing.T) {
+	h := newBeaconNodeHealth([]string{"a:4000", "b:4000", "c:4000"}, nil)
+	assert.Equal(t, "b:4000", h.best([]string{"c:4000", "b:4000"}))
+	assert.Equal(t, "", h.best(nil))
+}
+
+func TestBeaconNodeHealth_Broadcast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000", "c:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, map[string]mockBeaconNode{
+		"a:4000": {headSlot: 10},
+		"b:4000": {headSlot: 10},
+		"c:4000": {syncing: true},
+	}, endpoints)
+	h.probeAll(context.Background())
+	v := validator{beaconNodes: h, broadcast: true}
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 10}}
+	clients["a:4000"].EXPECT().ProposeBlock(gomock.Any(), blk).Return(nil, errors.New("bad"))
+	clients["b:4000"].EXPECT().ProposeBlock(gomock.Any(), blk).Return(&ethpb.ProposeResponse{BlockRoot: []byte("root")}, nil)
+	resp, err := v.proposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("root"), resp.BlockRoot)
+
+	att := &ethpb.Attestation{}
+	clients["a:4000"].EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("bad"))
+	clients["b:4000"].EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("worse"))
+	_, err = v.proposeAttestation(context.Background(), att)
+	assert.ErrorContains(t, "no beacon node accepted the attestation", err)
+}
+
+type fakeSubConn struct {
+	balancer.SubConn
+	addr string
+}
+
+func TestHealthiestBeaconNodePicker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000"}
+	h, _ := setupBeaconNodeHealth(ctrl, map[string]mockBeaconNode{
+		"a:4000": {syncing: true},
+		"b:4000": {headSlot: 10},
+	}, endpoints)
+	h.probeAll(context.Background())
+
+	attrs := attributes.New(beaconNodeHealthKey{}, h)
+	ready := make(map[balancer.SubConn]base.SubConnInfo)
+	for _, e := range endpoints {
+		ready[&fakeSubConn{addr: e}] = base.SubConnInfo{Address: resolver.Address{Addr: e, Attributes: attrs}}
+	}
+	picker := (&healthiestBeaconNodePickerBuilder{}).Build(base.PickerBuildInfo{ReadySCs: ready})
+	res, err := picker.Pick(balancer.PickInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "b:4000", res.SubConn.(*fakeSubConn).addr)
+
+	picker = (&healthiestBeaconNodePickerBuilder{}).Build(base.PickerBuildInfo{})
+	_, err = picker.Pick(balancer.PickInfo{})
+	assert.ErrorContains(t, balancer.ErrNoSubConnAvailable.Error(), err)
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/sirupsen/logrus"
+)
+
+// blockCandidate is the block built by a beacon node for a proposal.
+type blockCandidate struct {
+	endpoint string
+	block    *ethpb.BeaconBlock
+	err      error
+	// invalidErr is the reason why the block built by the beacon node cannot be proposed.
+	invalidErr error
+	latency    time.Duration
+}
+
+// valid returns true if the beacon node built a block which can be proposed.
+func (c *blockCandidate) valid() bool {
+	return c.err == nil && c.invalidErr == nil
+}
+
+// requestBlock requests the block to propose from the beacon node. With several beacon
+// nodes configured, every beacon node is asked to build the block in parallel and the
+// valid block with the highest attestation reward received within the block request
+// deadline is chosen. The beacon node computes the state root of the block it builds by
+// applying the block to its parent state, so a block is valid when it is for the
+// requested slot and has a state root, and an invalid block is never signed. When no
+// valid block arrives in time, the first valid block to arrive afterwards is used
+// instead, so that a slow beacon node never causes a missed proposal. With a single
+// beacon node configured, its block is returned without checks as there is no other
+// block to choose from. It returns the endpoint of the beacon node which built the
+// block, empty when a single beacon node is configured.
+func (v *validator) requestBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, string, error) {
+	if v.beaconNodes == nil {
+		b, err := v.validatorClient.GetBlock(ctx, req)
+		return b, "", err
+	}
+	return v.beaconNodes.requestBlock(ctx, req)
+}
+
+func (h *beaconNodeHealth) requestBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// Cancel the requests to the beacon nodes which did not answer once a block is chosen.
+	defer cancel()
+
+	results := make(chan *blockCandidate, len(h.endpoints))
+	start := time.Now()
+	for _, e := range h.endpoints {
+		go func(endpoint string) {
+			c := &blockCandidate{endpoint: endpoint}
+			c.block, c.err = h.clients[endpoint].validator.GetBlock(ctx, req)
+			if c.err == nil {
+				c.invalidErr = checkBlock(c.block, req.Slot)
+			}
+			c.latency = time.Since(start)
+			results <- c
+		}(e)
+	}
+
+	deadline := time.NewTimer(h.blockRequestDeadline)
+	defer deadline.Stop()
+	// Candidates are kept in the order they arrived, from the fastest.
+	candidates := make([]*blockCandidate, 0, len(h.endpoints))
+	deadlinePassed := false
+	for len(candidates) < len(h.endpoints) {
+		select {
+		case c := <-results:
+			candidates = append(candidates, c)
+			logBlockCandidate(c)
+			if deadlinePassed && c.valid() {
+				return h.chooseBlock(c, "first valid block after deadline")
+			}
+		case <-deadline.C:
+			deadlinePassed = true
+			if best := bestBlockCandidate(candidates); best != nil {
+				return h.chooseBlock(best, "highest attestation reward")
+			}
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		}
+	}
+	if best := bestBlockCandidate(candidates); best != nil {
+		return h.chooseBlock(best, "highest attestation reward")
+	}
+	// No beacon node built a valid block, report why the fastest block was invalid, if any.
+	for _, c := range candidates {
+		if c.err == nil {
+			return nil, "", errors.Wrap(c.invalidErr, "no beacon node built a valid block")
+		}
+	}
+	return nil, "", errors.Wrap(candidates[0].err, "no beacon node built a block")
+}
+
+func (h *beaconNodeHealth) chooseBlock(c *blockCandidate, reason string) (*ethpb.BeaconBlock, string, error) {
+	beaconNodeBlocksChosenCounter.WithLabelValues(c.endpoint).Inc()
+	log.WithFields(logrus.Fields{
+		"endpoint": c.endpoint,
+		"latency":  c.latency,
+		"reason":   reason,
+	}).Debug("Chose block to propose")
+	return c.block, c.endpoint, nil
+}
+
+func logBlockCandidate(c *blockCandidate) {
+	fields := logrus.Fields{
+		"endpoint": c.endpoint,
+		"latency":  c.latency,
+	}
+	if c.err != nil {
+		log.WithFields(fields).WithError(c.err).Debug("Beacon node could not build block")
+		return
+	}
+	if c.invalidErr != nil {
+		log.WithFields(fields).WithError(c.invalidErr).Debug("Received invalid block from beacon node")
+		return
+	}
+	fields["attestationReward"] = attestationReward(c.block)
+	log.WithFields(fields).Debug("Received block from beacon node")
+}
+
+// bestBlockCandidate returns the valid block with the highest attestation reward, the
+// fastest one among equals, or nil if there is no valid block.
+func bestBlockCandidate(candidates []*blockCandidate) *blockCandidate {
+	var best *blockCandidate
+	var bestReward float64
+	for _, c := range candidates {
+		if !c.valid() {
+			continue
+		}
+		if reward := attestationReward(c.block); best == nil || reward > bestReward {
+			best = c
+			bestReward = reward
+		}
+	}
+	return best
+}
+
+// checkBlock checks that the beacon node built a block for the requested slot, with a
+// state root.
+func checkBlock(b *ethpb.BeaconBlock, slot types.Slot) error {
+	if b == nil || b.Body == nil {
+		return errors.New("empty block")
+	}
+	if b.Slot != slot {
+		return errors.Errorf("block is for slot %d instead of %d", b.Slot, slot)
+	}
+	if len(b.StateRoot) != 32 || bytes.Equal(b.StateRoot, make([]byte, 32)) {
+		return errors.New("block has no state root")
+	}
+	return nil
+}
+
+// attestationReward estimates the proposer reward of the attestations packed in the
+// block. Every attester is counted once per committee, and weighted by the inclusion
+// delay reward of its attestation, which halves as the inclusion distance doubles.
+func attestationReward(b *ethpb.BeaconBlock) float64 {
+	type committee struct {
+		slot  types.Slot
+		index types.CommitteeIndex
+	}
+	attesters := make(map[committee]bitfield.Bitlist)
+	var reward float64
+	for _, att := range b.Body.Attestations {
+		if att.Data == nil || att.Data.Slot >= b.Slot {
+			continue
+		}
+		key := committee{slot: att.Data.Slot, index: att.Data.CommitteeIndex}
+		seen, ok := attesters[key]
+		if !ok || seen.Len() != att.AggregationBits.Len() {
+			seen = bitfield.NewBitlist(att.AggregationBits.Len())
+			attesters[key] = seen
+		}
+		newAttesters := 0
+		for _, i := range att.AggregationBits.BitIndices() {
+			if !seen.BitAt(uint64(i)) {
+				seen.SetBitAt(uint64(i), true)
+				newAttesters++
+			}
+		}
+		reward += float64(newAttesters) / float64(b.Slot-att.Data.Slot)
+	}
+	return reward
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testBlock returns a block for the slot with attestations from the previous slot,
+// attested by the given number of validators.
+func testBlock(slot types.Slot, attesters uint64, stateRoot []byte) *ethpb.BeaconBlock {
+	b := testutil.NewBeaconBlock().Block
+	b.Slot = slot
+	b.StateRoot = stateRoot
+	if attesters > 0 {
+		bits := bitfield.NewBitlist(64)
+		for i := uint64(0); i < attesters; i++ {
+			bits.SetBitAt(i, true)
+		}
+		b.Body.Attestations = []*ethpb.Attestation{{
+			AggregationBits: bits,
+			Data:            &ethpb.AttestationData{Slot: slot - 1},
+		}}
+	}
+	return b
+}
+
+func expectGetBlock(c *mock.MockBeaconNodeValidatorClient, delay time.Duration, b *ethpb.BeaconBlock, err error) {
+	c.EXPECT().GetBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.BlockRequest, _ ...interface{}) (*ethpb.BeaconBlock, error) {
+			select {
+			case <-time.After(delay):
+				return b, err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	)
+}
+
+func TestRequestBlock_HighestAttestationReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000", "c:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	h.blockRequestDeadline = time.Second
+	root := bytesutilPadRoot(1)
+	expectGetBlock(clients["a:4000"], 0, testBlock(10, 2, root), nil)
+	expectGetBlock(clients["b:4000"], 50*time.Millisecond, testBlock(10, 8, root), nil)
+	// A block with more attestations but no state root is ignored.
+	expectGetBlock(clients["c:4000"], 0, testBlock(10, 16, nil), nil)
+
+	v := &validator{beaconNodes: h}
+	b, endpoint, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "b:4000", endpoint)
+	assert.Equal(t, 1, len(b.Body.Attestations))
+	assert.Equal(t, uint64(8), b.Body.Attestations[0].AggregationBits.Count())
+}
+
+func TestRequestBlock_DeadlineSkipsSlowBeaconNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"fast:4000", "slow:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	h.blockRequestDeadline = 50 * time.Millisecond
+	root := bytesutilPadRoot(1)
+	expectGetBlock(clients["fast:4000"], 0, testBlock(10, 2, root), nil)
+	expectGetBlock(clients["slow:4000"], time.Minute, testBlock(10, 8, root), nil)
+
+	v := &validator{beaconNodes: h}
+	_, endpoint, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "fast:4000", endpoint)
+}
+
+func TestRequestBlock_FirstValidBlockAfterDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000", "c:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	h.blockRequestDeadline = 10 * time.Millisecond
+	root := bytesutilPadRoot(1)
+	expectGetBlock(clients["a:4000"], 0, nil, errors.New("unavailable"))
+	expectGetBlock(clients["b:4000"], 50*time.Millisecond, testBlock(10, 2, root), nil)
+	expectGetBlock(clients["c:4000"], time.Minute, testBlock(10, 8, root), nil)
+
+	v := &validator{beaconNodes: h}
+	_, endpoint, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "b:4000", endpoint)
+}
+
+func TestRequestBlock_SkipsInvalidBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000", "c:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	h.blockRequestDeadline = time.Second
+	root := bytesutilPadRoot(1)
+	expectGetBlock(clients["a:4000"], 0, testBlock(10, 8, nil), nil)
+	expectGetBlock(clients["b:4000"], 0, testBlock(9, 8, root), nil)
+	expectGetBlock(clients["c:4000"], 50*time.Millisecond, testBlock(10, 2, root), nil)
+
+	v := &validator{beaconNodes: h}
+	_, endpoint, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "c:4000", endpoint)
+}
+
+func TestRequestBlock_NoValidBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000", "c:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	h.blockRequestDeadline = time.Second
+	expectGetBlock(clients["a:4000"], 50*time.Millisecond, testBlock(10, 8, nil), nil)
+	expectGetBlock(clients["b:4000"], 0, testBlock(10, 2, nil), nil)
+	expectGetBlock(clients["c:4000"], 0, nil, errors.New("unavailable"))
+
+	v := &validator{beaconNodes: h}
+	_, _, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	assert.ErrorContains(t, "no beacon node built a valid block", err)
+}
+
+func TestRequestBlock_AllBeaconNodesFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoints := []string{"a:4000", "b:4000"}
+	h, clients := setupBeaconNodeHealth(ctrl, nil, endpoints)
+	expectGetBlock(clients["a:4000"], 0, nil, errors.New("unavailable"))
+	expectGetBlock(clients["b:4000"], 0, nil, errors.New("unavailable"))
+
+	v := &validator{beaconNodes: h}
+	_, _, err := v.requestBlock(context.Background(), &ethpb.BlockRequest{Slot: 10})
+	assert.ErrorContains(t, "no beacon node built a block", err)
+}
+
+func TestAttestationReward(t *testing.T) {
+	bits := func(indices ...uint64) bitfield.Bitlist {
+		b := bitfield.NewBitlist(8)
+		for _, i := range indices {
+			b.SetBitAt(i, true)
+		}
+		return b
+	}
+	b := testutil.NewBeaconBlock().Block
+	b.Slot = 10
+	b.Body.Attestations = []*ethpb.Attestation{
+		{AggregationBits: bits(0, 1), Data: &ethpb.AttestationData{Slot: 9}},
+		// Overlapping attesters of the same committee are only counted once.
+		{AggregationBits: bits(1, 2), Data: &ethpb.AttestationData{Slot: 9}},
+		// Attesters of another committee count in full.
+		{AggregationBits: bits(1), Data: &ethpb.AttestationData{Slot: 9, CommitteeIndex: 1}},
+		// Older attestations are worth less.
+		{AggregationBits: bits(0, 1, 2, 3), Data: &ethpb.AttestationData{Slot: 6}},
+	}
+	assert.Equal(t, float64(2+1+1+1), attestationReward(b))
+}
+
+func bytesutilPadRoot(b byte) []byte {
+	root := make([]byte, 32)
+	root[0] = b
+	return root
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// proposeBlock submits a signed block to the beacon node, or to every healthy beacon
+// node when broadcasting is enabled.
+func (v *validator) proposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	if v.beaconNodes == nil || !v.broadcast {
+		return v.validatorClient.ProposeBlock(ctx, blk)
+	}
+	var once sync.Once
+	var resp *ethpb.ProposeResponse
+	err := v.beaconNodes.broadcast(ctx, "block", func(ctx context.Context, c ethpb.BeaconNodeValidatorClient) error {
+		r, err := c.ProposeBlock(ctx, blk)
+		if err != nil {
+			return err
+		}
+		once.Do(func() { resp = r })
+		return nil
+	})
+	return resp, err
+}
+
+// proposeAttestation submits a signed attestation to the beacon node, or to every
+// healthy beacon node when broadcasting is enabled.
+func (v *validator) proposeAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	if v.beaconNodes == nil || !v.broadcast {
+		return v.validatorClient.ProposeAttestation(ctx, att)
+	}
+	var once sync.Once
+	var resp *ethpb.AttestResponse
+	err := v.beaconNodes.broadcast(ctx, "attestation", func(ctx context.Context, c ethpb.BeaconNodeValidatorClient) error {
+		r, err := c.ProposeAttestation(ctx, att)
+		if err != nil {
+			return err
+		}
+		once.Do(func() { resp = r })
+		return nil
+	})
+	return resp, err
+}
+
+// submitSignedAggregate submits a signed aggregate and proof to the beacon node, or to
+// every healthy beacon node when broadcasting is enabled.
+func (v *validator) submitSignedAggregate(
+	ctx context.Context, req *ethpb.SignedAggregateSubmitRequest,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	if v.beaconNodes == nil || !v.broadcast {
+		return v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, req)
+	}
+	var once sync.Once
+	var resp *ethpb.SignedAggregateSubmitResponse
+	err := v.beaconNodes.broadcast(ctx, "aggregate", func(ctx context.Context, c ethpb.BeaconNodeValidatorClient) error {
+		r, err := c.SubmitSignedAggregateSelectionProof(ctx, req)
+		if err != nil {
+			return err
+		}
+		once.Do(func() { resp = r })
+		return nil
+	})
+	return resp, err
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// receiveDependentRoots keeps track of the dependent roots of the duties streamed by
+// the beacon node, which change when a reorg changes the block the duties depend on,
+// until the context is canceled.
+func (v *validator) receiveDependentRoots(ctx context.Context) {
+	for {
+		err := v.streamDependentRoots(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Debug("Beacon node does not stream dependent roots, duties will only be updated at epoch start")
+			return
+		}
+		log.WithError(err).Debug("Dependent roots stream interrupted, reconnecting")
+		v.setDependentRoots(nil)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backOffPeriod):
+		}
+	}
+}
+
+func (v *validator) streamDependentRoots(ctx context.Context) error {
+	stream, err := v.dutiesClient.StreamDependentRoots(ctx, &ptypes.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"epoch":                     res.Epoch,
+			"currentEpochDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(res.CurrentEpochDependentRoot)),
+			"nextEpochDependentRoot":    fmt.Sprintf("%#x", bytesutil.Trunc(res.NextEpochDependentRoot)),
+		}).Debug("Received dependent roots of duties")
+		v.setDependentRoots(res)
+	}
+}
+
+// setDependentRoots records the dependent roots streamed by the beacon node. The dependent
+// root of the current epoch is kept through the start of the next epoch, as it decides
+// the duties prefetched for that epoch.
+func (v *validator) setDependentRoots(res *pbrpc.DependentRootsResponse) {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	prev := v.dependentRoots
+	switch {
+	case res == nil || prev == nil:
+		v.previousEpochDependentRoot = nil
+	case prev.Epoch+1 == res.Epoch:
+		v.previousEpochDependentRoot = prev.CurrentEpochDependentRoot
+	case prev.Epoch != res.Epoch:
+		v.previousEpochDependentRoot = nil
+	}
+	v.dependentRoots = res
+}
+
+// dutiesDependentRoots are the roots of the blocks on which the duties of an epoch depend.
+// Unknown roots are nil.
+type dutiesDependentRoots struct {
+	// attester is the root of the last block before the start of the previous epoch.
+	attester []byte
+	// proposer is the root of the last block before the start of the epoch.
+	proposer []byte
+}
+
+// dependentRootsOf returns the last known dependent roots of the duties of the epoch.
+func (v *validator) dependentRootsOf(epoch types.Epoch) dutiesDependentRoots {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	return v.dependentRootsOfLocked(epoch)
+}
+
+func (v *validator) dependentRootsOfLocked(epoch types.Epoch) dutiesDependentRoots {
+	var roots dutiesDependentRoots
+	roots.proposer, _ = v.dependentRootLocked(epoch)
+	if epoch == 0 {
+		roots.attester = roots.proposer
+		return roots
+	}
+	roots.attester, _ = v.dependentRootLocked(epoch - 1)
+	return roots
+}
+
+// dependentRootLocked returns the last known root of the last block before the start of
+// the epoch.
+func (v *validator) dependentRootLocked(epoch types.Epoch) ([]byte, bool) {
+	r := v.dependentRoots
+	switch {
+	case r == nil:
+		return nil, false
+	case r.Epoch == epoch+1 && v.previousEpochDependentRoot != nil:
+		return v.previousEpochDependentRoot, true
+	case r.Epoch == epoch:
+		return r.CurrentEpochDependentRoot, true
+	case r.Epoch+1 == epoch:
+		return r.NextEpochDependentRoot, true
+	default:
+		return nil, false
+	}
+}
+
+// dutiesOutdated returns true if a dependent root of the current duties changed since
+// they were fetched.
+func (v *validator) dutiesOutdated() bool {
+	roots := v.dependentRootsOf(v.dutiesEpoch)
+	return dependentRootChanged("attester", v.dutiesEpoch, v.dutiesDependentRoots.attester, roots.attester) ||
+		dependentRootChanged("proposer", v.dutiesEpoch, v.dutiesDependentRoots.proposer, roots.proposer)
+}
+
+// dependentRootChanged returns true if the dependent root of duties is known and differs
+// from the root they were fetched with.
+func dependentRootChanged(duties string, epoch types.Epoch, oldRoot, newRoot []byte) bool {
+	if newRoot == nil || bytes.Equal(oldRoot, newRoot) {
+		return false
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":            epoch,
+		"duties":           duties,
+		"oldDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(oldRoot)),
+		"newDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(newRoot)),
+	}).Info("Dependent root of duties changed, updating duties")
+	return true
+}
+
+// startDutiesPrefetch marks the duties of the next epoch as being prefetched and returns
+// their dependent roots. The attester duties of the next epoch are decided by the block at
+// the end of the previous epoch, which blocks of the current epoch do not change, so the
+// duties are prefetched once per epoch, unless a reorg changes that block. It returns
+// false if the duties are already prefetched or being prefetched, or if their attester
+// dependent root is unknown, in which case they could not be checked against reorgs.
+func (v *validator) startDutiesPrefetch(epoch types.Epoch) (dutiesDependentRoots, bool) {
+	if epoch == 0 {
+		return dutiesDependentRoots{}, false
+	}
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	roots := v.dependentRootsOfLocked(epoch)
+	if roots.attester == nil || v.prefetchingDuties {
+		return dutiesDependentRoots{}, false
+	}
+	if v.nextDuties != nil && v.nextDutiesEpoch == epoch &&
+		bytes.Equal(v.nextDutiesDependentRoots.attester, roots.attester) {
+		return dutiesDependentRoots{}, false
+	}
+	v.prefetchingDuties = true
+	return roots, true
+}
+
+// prefetchNextDuties fetches the duties of the next epoch marked as being prefetched by
+// startDutiesPrefetch, and subscribes to the subnets of their attestation committees.
+func (v *validator) prefetchNextDuties(ctx context.Context, epoch types.Epoch, roots dutiesDependentRoots) {
+	defer func() {
+		v.dutiesLock.Lock()
+		v.prefetchingDuties = false
+		v.dutiesLock.Unlock()
+	}()
+
+	ss, err := helpers.StartSlot(epoch)
+	if err != nil {
+		log.WithError(err).Debug("Could not prefetch duties")
+		return
+	}
+	ctx, cancel := context.WithDeadline(ctx, v.localDeadline(v.SlotDeadline(ss)))
+	defer cancel()
+	keys, err := v.dutiesPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not prefetch duties")
+		return
+	}
+	resp, err := v.validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+	})
+	if err != nil {
+		log.WithError(err).Debug("Could not prefetch duties")
+		return
+	}
+	v.dutiesLock.Lock()
+	v.nextDuties = resp
+	v.nextDutiesEpoch = epoch
+	v.nextDutiesDependentRoots = roots
+	v.dutiesLock.Unlock()
+
+	if err := v.subscribeToSubnets(ctx, resp); err != nil {
+		log.WithError(err).Error("Failed to subscribe to subnets")
+	}
+}
+
+// promoteNextDuties makes the prefetched duties of the epoch the current duties, if the
+// block their attester duties depend on did not change since they were fetched. The
+// proposer duties depend on the last block of the previous epoch, which was usually not
+// known yet when the duties were prefetched: the promoted duties keep the dependent roots
+// they were fetched with, so that they are refetched as outdated if that block changed.
+func (v *validator) promoteNextDuties(epoch types.Epoch) bool {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	next, nextRoots := v.nextDuties, v.nextDutiesDependentRoots
+	if next == nil || v.nextDutiesEpoch != epoch || epoch == 0 {
+		return false
+	}
+	v.nextDuties = nil
+	if !bytes.Equal(v.dependentRootsOfLocked(epoch).attester, nextRoots.attester) {
+		return false
+	}
+	v.duties = next
+	v.dutiesEpoch = epoch
+	v.dutiesDependentRoots = nextRoots
+	return true
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDutiesClient struct {
+	responses []*pbrpc.DependentRootsResponse
+	err       error
+	// reconnectErr is returned when the stream is opened again.
+	reconnectErr error
+	calls        int
+}
+
+func (c *fakeDutiesClient) StreamDependentRoots(
+	ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption,
+) (pbrpc.Duties_StreamDependentRootsClient, error) {
+	c.calls++
+	if c.calls > 1 {
+		return nil, c.reconnectErr
+	}
+	return &fakeDependentRootsStream{ctx: ctx, responses: c.responses, err: c.err}, nil
+}
+
+type fakeDependentRootsStream struct {
+	grpc.ClientStream
+	ctx       context.Context
+	responses []*pbrpc.DependentRootsResponse
+	err       error
+}
+
+func (s *fakeDependentRootsStream) Recv() (*pbrpc.DependentRootsResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, s.err
+	}
+	res := s.responses[0]
+	s.responses = s.responses[1:]
+	return res, nil
+}
+
+func dependentRootsValidator(t *testing.T, client *mock.MockBeaconNodeValidatorClient) *validator {
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := [48]byte{}
+	copy(pubKey[:], privKey.PublicKey().Marshal())
+	return &validator{
+		keyManager:      &mockKeymanager{keysMap: map[[48]byte]bls.SecretKey{pubKey: privKey}},
+		validatorClient: client,
+	}
+}
+
+func TestUpdateDuties_RefetchesWhenDependentRootChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := dependentRootsValidator(t, client)
+	v.duties = &ethpb.DutiesResponse{}
+	v.dutiesEpoch = 1
+	v.dutiesDependentRoots = dutiesDependentRoots{proposer: []byte("old")}
+	v.dependentRoots = &pbrpc.DependentRootsResponse{Epoch: 1, CurrentEpochDependentRoot: []byte("new")}
+
+	resp := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1}}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(resp, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch+1))
+	assert.DeepEqual(t, resp, v.duties)
+	assert.DeepEqual(t, []byte("new"), v.dutiesDependentRoots.proposer)
+
+	// Duties are not fetched again while the dependent root is unchanged, only the duties
+	// of the next epoch are prefetched.
+	prefetched := make(chan struct{})
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			defer close(prefetched)
+			assert.Equal(t, types.Epoch(2), req.Epoch)
+			return resp, nil
+		})
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch+2))
+	<-prefetched
+}
+
+func TestUpdateDuties_PromotesPrefetchedDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := dependentRootsValidator(t, client)
+	v.duties = &ethpb.DutiesResponse{}
+	v.dutiesEpoch = 1
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("current"),
+		NextEpochDependentRoot:    []byte("head"),
+	})
+
+	next := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1}}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			assert.Equal(t, types.Epoch(2), req.Epoch)
+			return next, nil
+		})
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	roots, ok := v.startDutiesPrefetch(2)
+	require.Equal(t, true, ok)
+	v.prefetchNextDuties(context.Background(), 2, roots)
+	// No block arrives until the next epoch starts.
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     2,
+		CurrentEpochDependentRoot: []byte("head"),
+		NextEpochDependentRoot:    []byte("head"),
+	})
+
+	require.NoError(t, v.UpdateDuties(context.Background(), 2*params.BeaconConfig().SlotsPerEpoch))
+	assert.DeepEqual(t, next, v.duties)
+	assert.DeepEqual(t, dutiesDependentRoots{attester: []byte("current"), proposer: []byte("head")}, v.dutiesDependentRoots)
+	assert.Equal(t, true, v.nextDuties == nil)
+}
+
+func TestUpdateDuties_RefetchesPromotedDutiesAfterBlockInLastSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := dependentRootsValidator(t, client)
+	v.duties = &ethpb.DutiesResponse{}
+	v.dutiesEpoch = 1
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("current"),
+		NextEpochDependentRoot:    []byte("head"),
+	})
+
+	next := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1}}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(next, nil)
+	// The subnets of the attester duties are only subscribed to when prefetching them.
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(nil, nil)
+	roots, ok := v.startDutiesPrefetch(2)
+	require.Equal(t, true, ok)
+	v.prefetchNextDuties(context.Background(), 2, roots)
+
+	// A block arrives in the last slot of the epoch, then the next epoch starts. The
+	// proposer duties of the next epoch depend on that block.
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("current"),
+		NextEpochDependentRoot:    []byte("last slot block"),
+	})
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     2,
+		CurrentEpochDependentRoot: []byte("last slot block"),
+		NextEpochDependentRoot:    []byte("last slot block"),
+	})
+
+	resp := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 2}}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			assert.Equal(t, types.Epoch(2), req.Epoch)
+			return resp, nil
+		})
+	require.NoError(t, v.UpdateDuties(context.Background(), 2*params.BeaconConfig().SlotsPerEpoch))
+	assert.DeepEqual(t, resp, v.duties)
+	assert.DeepEqual(t, dutiesDependentRoots{attester: []byte("current"), proposer: []byte("last slot block")}, v.dutiesDependentRoots)
+	assert.Equal(t, false, v.dutiesOutdated())
+}
+
+func TestStartDutiesPrefetch_OncePerEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	v := dependentRootsValidator(t, mock.NewMockBeaconNodeValidatorClient(ctrl))
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("current"),
+		NextEpochDependentRoot:    []byte("head"),
+	})
+
+	roots, ok := v.startDutiesPrefetch(2)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, dutiesDependentRoots{attester: []byte("current"), proposer: []byte("head")}, roots)
+	// The duties are not requested again while being prefetched.
+	_, ok = v.startDutiesPrefetch(2)
+	assert.Equal(t, false, ok)
+
+	v.dutiesLock.Lock()
+	v.prefetchingDuties = false
+	v.nextDuties = &ethpb.DutiesResponse{}
+	v.nextDutiesEpoch = 2
+	v.nextDutiesDependentRoots = roots
+	v.dutiesLock.Unlock()
+	// New blocks of the epoch do not change the attester duties of the next epoch.
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("current"),
+		NextEpochDependentRoot:    []byte("new head"),
+	})
+	_, ok = v.startDutiesPrefetch(2)
+	assert.Equal(t, false, ok)
+
+	// A reorg of the block at the end of the previous epoch does.
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{
+		Epoch:                     1,
+		CurrentEpochDependentRoot: []byte("reorged"),
+		NextEpochDependentRoot:    []byte("new head"),
+	})
+	roots, ok = v.startDutiesPrefetch(2)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []byte("reorged"), roots.attester)
+}
+
+func TestUpdateDuties_DiscardsOutdatedPrefetchedDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := dependentRootsValidator(t, client)
+	v.duties = &ethpb.DutiesResponse{}
+	v.nextDuties = &ethpb.DutiesResponse{}
+	v.nextDutiesEpoch = 2
+	v.nextDutiesDependentRoots = dutiesDependentRoots{attester: []byte("current")}
+	// A reorg replaced the block at the end of the previous epoch after the duties were
+	// prefetched.
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{Epoch: 1, CurrentEpochDependentRoot: []byte("reorged")})
+	v.setDependentRoots(&pbrpc.DependentRootsResponse{Epoch: 2, CurrentEpochDependentRoot: []byte("late block")})
+
+	resp := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1}}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(resp, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	require.NoError(t, v.UpdateDuties(context.Background(), 2*params.BeaconConfig().SlotsPerEpoch))
+	assert.DeepEqual(t, resp, v.duties)
+	assert.DeepEqual(t, []byte("late block"), v.dutiesDependentRoots.proposer)
+}
+
+func TestPrefetchNextDuties_UnknownDependentRoot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	v := dependentRootsValidator(t, mock.NewMockBeaconNodeValidatorClient(ctrl))
+	// Without dependent roots, prefetched duties could not be validated so none are fetched.
+	_, ok := v.startDutiesPrefetch(2)
+	assert.Equal(t, false, ok)
+}
+
+func TestReceiveDependentRoots(t *testing.T) {
+	want := &pbrpc.DependentRootsResponse{Epoch: 3, CurrentEpochDependentRoot: []byte("a"), NextEpochDependentRoot: []byte("b")}
+	v := &validator{dutiesClient: &fakeDutiesClient{
+		responses: []*pbrpc.DependentRootsResponse{{Epoch: 2}, want},
+		err:       status.Error(codes.Unimplemented, "unknown service"),
+	}}
+	v.receiveDependentRoots(context.Background())
+	assert.DeepEqual(t, want, v.dependentRoots)
+
+	assert.DeepEqual(t, dutiesDependentRoots{proposer: []byte("a")}, v.dependentRootsOf(3))
+	assert.DeepEqual(t, dutiesDependentRoots{attester: []byte("a"), proposer: []byte("b")}, v.dependentRootsOf(4))
+	assert.DeepEqual(t, dutiesDependentRoots{attester: []byte("b")}, v.dependentRootsOf(5))
+}
+
+func TestReceiveDependentRoots_ClearsRootsWhenInterrupted(t *testing.T) {
+	backOffPeriod = 10 * time.Millisecond
+	client := &fakeDutiesClient{
+		responses:    []*pbrpc.DependentRootsResponse{{Epoch: 2}},
+		err:          io.EOF,
+		reconnectErr: status.Error(codes.Unimplemented, "unknown service"),
+	}
+	v := &validator{dutiesClient: client}
+	v.receiveDependentRoots(context.Background())
+	assert.Equal(t, 2, client.calls)
+	assert.DeepEqual(t, dutiesDependentRoots{}, v.dependentRootsOf(2))
+}
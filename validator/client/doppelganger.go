@@ -0,0 +1,313 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var errAllKeysDoppelganger = errors.New("all validating keys are already live on the network")
+
+// doppelgangerCheck is the progress of the doppelganger check of a validating key. It is
+// kept across the retries of the check, so that a connection error does not restart it.
+type doppelgangerCheck struct {
+	active        bool        // The key was active when the check started, so it is watched.
+	startEpoch    types.Epoch // The epoch in which the check started, which is skipped.
+	lastEpoch     types.Epoch // The last epoch checked or skipped for the key.
+	checkedEpochs types.Epoch // The number of epochs actually checked for the key.
+	live          bool        // The key was seen live on the network.
+	verified      bool        // The key was watched for long enough without being seen live.
+}
+
+// CheckDoppelGanger watches the chain for the configured number of epochs before the
+// validator performs its first duty and looks for attestations or block proposals
+// made by any of our active keys. Since this client has not signed anything yet,
+// any such activity means the key is running somewhere else. Keys seen live are
+// excluded from all subsequent duties, and an error is returned if every active key
+// is affected.
+//
+// Epoch participation is read from the beacon node's previous epoch performance, so
+// the check for epoch N happens during the last slot of epoch N+1, once most of the
+// attestations for epoch N had a chance to be included. The epoch in which the
+// client starts is skipped as an earlier run of this same client may have signed in
+// it. Epochs whose check slot passed already, for instance after a connection error,
+// cannot be checked anymore: they are skipped and do not count towards the configured
+// number of epochs.
+//
+// Only the keys which were not verified yet are checked, so the check is run again
+// for the keys added while the client is running. Keys are given duties once verified.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelGanger")
+	defer span.End()
+	v.doppelgangerLock.Lock()
+	defer v.doppelgangerLock.Unlock()
+
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	pendingKeys := make([][48]byte, 0, len(validatingKeys))
+	v.doppelgangerChecksLock.RLock()
+	for _, pubKey := range validatingKeys {
+		if c, ok := v.doppelgangerChecks[pubKey]; !ok || (!c.live && !c.verified) {
+			pendingKeys = append(pendingKeys, pubKey)
+		}
+	}
+	v.doppelgangerChecksLock.RUnlock()
+	if len(pendingKeys) == 0 {
+		return nil
+	}
+	activeKeys, err := v.activeValidatorIndices(ctx, pendingKeys)
+	if err != nil {
+		return err
+	}
+
+	// Keys which are not active cannot have signed anything.
+	startEpoch := v.currentEpoch()
+	v.doppelgangerChecksLock.Lock()
+	if v.doppelgangerChecks == nil {
+		v.doppelgangerChecks = make(map[[48]byte]*doppelgangerCheck)
+	}
+	for _, pubKey := range pendingKeys {
+		if _, ok := v.doppelgangerChecks[pubKey]; ok {
+			continue
+		}
+		if _, ok := activeKeys[pubKey]; !ok {
+			v.doppelgangerChecks[pubKey] = &doppelgangerCheck{verified: true}
+			continue
+		}
+		v.doppelgangerChecks[pubKey] = &doppelgangerCheck{active: true, startEpoch: startEpoch, lastEpoch: startEpoch}
+	}
+	v.doppelgangerChecksLock.Unlock()
+	if len(activeKeys) == 0 {
+		log.Info("No active validating keys, skipping doppelganger protection")
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"epochs":  v.doppelgangerEpochs,
+		"numKeys": len(activeKeys),
+	}).Info("Doppelganger protection enabled, waiting before performing any duties with the keys")
+	for len(activeKeys) > 0 {
+		// Check the earliest epoch not checked yet for any of the keys.
+		v.doppelgangerChecksLock.RLock()
+		var epoch types.Epoch
+		first := true
+		for pubKey := range activeKeys {
+			if c := v.doppelgangerChecks[pubKey]; first || c.lastEpoch+1 < epoch {
+				epoch = c.lastEpoch + 1
+				first = false
+			}
+		}
+		v.doppelgangerChecksLock.RUnlock()
+
+		checkSlot, err := helpers.StartSlot(epoch + 2)
+		if err != nil {
+			return err
+		}
+		if err := v.waitForSlot(ctx, checkSlot-1); err != nil {
+			return err
+		}
+		// The performance reported by the beacon node is for its previous epoch, so the
+		// response only covers the epoch being checked during the epoch that follows it.
+		if currentEpoch := v.currentEpoch(); currentEpoch != epoch+1 {
+			v.skipDoppelgangerEpochs(activeKeys, currentEpoch)
+			continue
+		}
+		seen, err := v.liveKeysAtEpoch(ctx, epoch, activeKeys)
+		if err != nil {
+			return err
+		}
+		if currentEpoch := v.currentEpoch(); currentEpoch != epoch+1 {
+			v.skipDoppelgangerEpochs(activeKeys, currentEpoch)
+			continue
+		}
+
+		v.doppelgangerChecksLock.Lock()
+		for pubKey := range activeKeys {
+			c := v.doppelgangerChecks[pubKey]
+			if c.lastEpoch >= epoch {
+				continue
+			}
+			c.lastEpoch = epoch
+			c.checkedEpochs++
+			if seen[pubKey] {
+				c.live = true
+				log.WithFields(logrus.Fields{
+					"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+					"epoch":     epoch,
+				}).Error("Doppelganger detected: key is live on the network, it will not be used for signing. " +
+					"Make sure it is not running in another validator client before restarting")
+			} else if c.checkedEpochs >= v.doppelgangerEpochs {
+				c.verified = true
+			}
+			if c.live || c.verified {
+				delete(activeKeys, pubKey)
+			}
+		}
+		liveKeys, usableKeys := v.countDoppelgangerChecks()
+		v.doppelgangerChecksLock.Unlock()
+		if usableKeys == 0 && len(activeKeys) == 0 {
+			return errAllKeysDoppelganger
+		}
+		log.WithFields(logrus.Fields{
+			"epoch":         epoch,
+			"keysLeft":      len(activeKeys),
+			"liveKeysSoFar": liveKeys,
+		}).Info("Doppelganger check for epoch complete")
+	}
+
+	v.doppelgangerChecksLock.RLock()
+	liveKeys, _ := v.countDoppelgangerChecks()
+	v.doppelgangerChecksLock.RUnlock()
+	if liveKeys == 0 {
+		log.Info("No doppelganger found, starting to perform duties")
+	}
+	return nil
+}
+
+// skipDoppelgangerEpochs moves the checks of the keys forward to the previous epoch of
+// currentEpoch, which is the earliest epoch that can still be checked. The skipped epochs
+// are not counted as checked.
+func (v *validator) skipDoppelgangerEpochs(keys map[[48]byte]types.ValidatorIndex, currentEpoch types.Epoch) {
+	if currentEpoch < 2 {
+		return
+	}
+	v.doppelgangerChecksLock.Lock()
+	defer v.doppelgangerChecksLock.Unlock()
+	for pubKey := range keys {
+		if c := v.doppelgangerChecks[pubKey]; c.lastEpoch < currentEpoch-2 {
+			log.WithFields(logrus.Fields{
+				"publicKey":     fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+				"skippedEpochs": currentEpoch - 2 - c.lastEpoch,
+			}).Warn("Doppelganger check fell behind, skipping epochs which can no longer be checked")
+			c.lastEpoch = currentEpoch - 2
+		}
+	}
+}
+
+// countDoppelgangerChecks returns the number of keys seen live, and the number of active keys
+// verified by the doppelganger check. The caller must hold the doppelganger checks lock.
+func (v *validator) countDoppelgangerChecks() (int, int) {
+	var live, usable int
+	for _, c := range v.doppelgangerChecks {
+		if c.live {
+			live++
+		} else if c.active && c.verified {
+			usable++
+		}
+	}
+	return live, usable
+}
+
+// activeValidatorIndices maps the keys that are able to attest or propose to their
+// validator indices. Keys that are not yet active cannot have signed anything.
+func (v *validator) activeValidatorIndices(
+	ctx context.Context, pubKeys [][48]byte,
+) (map[[48]byte]types.ValidatorIndex, error) {
+	if len(pubKeys) == 0 {
+		return nil, nil
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: bytesutil.FromBytes48Array(pubKeys),
+	})
+	if err != nil {
+		return nil, errors.Wrap(errConnectionIssue, err.Error())
+	}
+	if len(resp.Statuses) != len(resp.PublicKeys) || len(resp.Indices) != len(resp.PublicKeys) {
+		return nil, errors.New("malformed validator status response")
+	}
+	active := make(map[[48]byte]types.ValidatorIndex)
+	for i, st := range resp.Statuses {
+		switch st.Status {
+		case ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_EXITING, ethpb.ValidatorStatus_SLASHING:
+			active[bytesutil.ToBytes48(resp.PublicKeys[i])] = resp.Indices[i]
+		}
+	}
+	return active, nil
+}
+
+// liveKeysAtEpoch returns the keys which attested in the given epoch, which must be
+// the beacon node's previous epoch, or proposed a block in it.
+func (v *validator) liveKeysAtEpoch(
+	ctx context.Context, epoch types.Epoch, keys map[[48]byte]types.ValidatorIndex,
+) (map[[48]byte]bool, error) {
+	pubKeys := make([][]byte, 0, len(keys))
+	byIndex := make(map[types.ValidatorIndex][48]byte, len(keys))
+	for pubKey, idx := range keys {
+		k := pubKey
+		pubKeys = append(pubKeys, k[:])
+		byIndex[idx] = pubKey
+	}
+	live := make(map[[48]byte]bool)
+
+	perf, err := v.beaconClient.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{PublicKeys: pubKeys})
+	if err != nil {
+		return nil, errors.Wrap(errConnectionIssue, err.Error())
+	}
+	if len(perf.CorrectlyVotedSource) != len(perf.PublicKeys) {
+		return nil, errors.New("malformed validator performance response")
+	}
+	for i, voted := range perf.CorrectlyVotedSource {
+		if voted {
+			live[bytesutil.ToBytes48(perf.PublicKeys[i])] = true
+		}
+	}
+
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch}}
+	for {
+		blks, err := v.beaconClient.ListBlocks(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(errConnectionIssue, err.Error())
+		}
+		for _, ctr := range blks.BlockContainers {
+			if ctr.Block == nil || ctr.Block.Block == nil {
+				continue
+			}
+			if pubKey, ok := byIndex[ctr.Block.Block.ProposerIndex]; ok {
+				live[pubKey] = true
+			}
+		}
+		if blks.NextPageToken == "" {
+			break
+		}
+		req.PageToken = blks.NextPageToken
+	}
+	return live, nil
+}
+
+// currentEpoch returns the current epoch of the validator's clock.
+func (v *validator) currentEpoch() types.Epoch {
+	sinceGenesis := v.now().Sub(time.Unix(int64(v.genesisTime), 0))
+	if sinceGenesis < 0 {
+		return 0
+	}
+	return helpers.SlotToEpoch(types.Slot(uint64(sinceGenesis.Seconds()) / params.BeaconConfig().SecondsPerSlot))
+}
+
+// waitForSlot blocks until the start of the given slot of the validator's clock.
+func (v *validator) waitForSlot(ctx context.Context, slot types.Slot) error {
+	wait := slotutil.SlotStartTime(v.genesisTime, slot).Sub(v.now())
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
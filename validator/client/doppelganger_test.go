@@ -0,0 +1,292 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCheckDoppelGanger_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	v := validator{keyManager: genMockKeymanger(2), validatorClient: client}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	assert.Equal(t, 0, len(v.doppelgangerChecks))
+}
+
+func TestCheckDoppelGanger_NoActiveKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+		Statuses: []*ethpb.ValidatorStatusResponse{
+			{Status: ethpb.ValidatorStatus_DEPOSITED},
+			{Status: ethpb.ValidatorStatus_PENDING},
+		},
+		Indices: []types.ValidatorIndex{1, 2},
+	}, nil)
+
+	v := validator{keyManager: km, validatorClient: client, doppelgangerEpochs: 2}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestLiveKeysAtEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	keys := map[[48]byte]types.ValidatorIndex{{'a'}: 1, {'b'}: 2, {'c'}: 3}
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           [][]byte{{'a'}, {'b'}, {'c'}},
+		CorrectlyVotedSource: []bool{true, false, false},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 5},
+	}).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ProposerIndex: 2}}},
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ProposerIndex: 10}}},
+		},
+		NextPageToken: "1",
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 5},
+		PageToken:   "1",
+	}).Return(&ethpb.ListBlocksResponse{}, nil)
+
+	v := validator{beaconClient: beaconClient}
+	live, err := v.liveKeysAtEpoch(context.Background(), 5, keys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[[48]byte]bool{{'a'}: true, {'b'}: true}, live)
+}
+
+func TestCheckDoppelGanger_AllKeysLive(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerEpoch = 1
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+		Indices:    []types.ValidatorIndex{7},
+	}, nil)
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           bytesutil.FromBytes48Array(keys),
+		CorrectlyVotedSource: []bool{true},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{}, nil)
+
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		beaconClient:       beaconClient,
+		genesisTime:        uint64(time.Now().Unix()) - 100,
+		doppelgangerEpochs: 1,
+	}
+	require.ErrorContains(t, errAllKeysDoppelganger.Error(), v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_ResumesAfterConnectionError(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerEpoch = 1
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Times(2).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+		Indices:    []types.ValidatorIndex{7},
+	}, nil)
+	gomock.InOrder(
+		beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused")),
+		beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			PublicKeys:           bytesutil.FromBytes48Array(keys),
+			CorrectlyVotedSource: []bool{false},
+		}, nil),
+	)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{}, nil)
+
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		beaconClient:       beaconClient,
+		genesisTime:        uint64(time.Now().Unix()) - 100,
+		doppelgangerEpochs: 1,
+	}
+	err = v.CheckDoppelGanger(context.Background())
+	require.ErrorContains(t, errConnectionIssue.Error(), err)
+	check := v.doppelgangerChecks[keys[0]]
+	require.NotNil(t, check)
+	startEpoch := check.startEpoch
+	assert.Equal(t, false, check.verified)
+
+	// The check resumes from the epoch in which it started.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	check = v.doppelgangerChecks[keys[0]]
+	assert.Equal(t, startEpoch, check.startEpoch)
+	assert.Equal(t, startEpoch+1, check.lastEpoch)
+	assert.Equal(t, types.Epoch(1), check.checkedEpochs)
+	assert.Equal(t, true, check.verified)
+	assert.Equal(t, false, check.live)
+}
+
+func TestCheckDoppelGanger_SkipsEpochsNoLongerCovered(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerEpoch = 1
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+		Indices:    []types.ValidatorIndex{7},
+	}, nil)
+	// Only the epochs covered by the node's previous epoch performance are checked.
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Times(2).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           bytesutil.FromBytes48Array(keys),
+		CorrectlyVotedSource: []bool{false},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Times(2).Return(&ethpb.ListBlocksResponse{}, nil)
+
+	genesisTime := uint64(time.Now().Unix()) - 100
+	currentEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(genesisTime), 0))
+	// The check fell several epochs behind the beacon node's head, e.g. after a long retry.
+	staleEpoch := currentEpoch - 6
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		beaconClient:       beaconClient,
+		genesisTime:        genesisTime,
+		doppelgangerEpochs: 2,
+		doppelgangerChecks: map[[48]byte]*doppelgangerCheck{
+			keys[0]: {active: true, startEpoch: staleEpoch, lastEpoch: staleEpoch},
+		},
+	}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	check := v.doppelgangerChecks[keys[0]]
+	assert.Equal(t, types.Epoch(2), check.checkedEpochs)
+	assert.Equal(t, currentEpoch, check.lastEpoch)
+	assert.Equal(t, true, check.verified)
+}
+
+func TestCheckDoppelGanger_OnlyChecksNewKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+			require.Equal(t, 1, len(req.PublicKeys))
+			assert.DeepEqual(t, keys[1][:], req.PublicKeys[0])
+			return &ethpb.MultipleValidatorStatusResponse{
+				PublicKeys: req.PublicKeys,
+				Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_PENDING}},
+				Indices:    []types.ValidatorIndex{2},
+			}, nil
+		})
+
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		doppelgangerEpochs: 2,
+		doppelgangerChecks: map[[48]byte]*doppelgangerCheck{keys[0]: {active: true, verified: true}},
+	}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	require.NotNil(t, v.doppelgangerChecks[keys[1]])
+	assert.Equal(t, true, v.doppelgangerChecks[keys[1]].verified, "Inactive keys cannot have signed anything")
+}
+
+func TestUpdateDuties_FiltersDoppelgangerKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := genMockKeymanger(4)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	// The keys seen live, not verified yet or not checked at all are not given duties.
+	v := validator{
+		keyManager:         km,
+		validatorClient:    client,
+		doppelgangerEpochs: 1,
+		doppelgangerChecks: map[[48]byte]*doppelgangerCheck{
+			keys[0]: {active: true, live: true},
+			keys[1]: {active: true, verified: true},
+			keys[2]: {active: true},
+		},
+	}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			require.Equal(t, 1, len(req.PublicKeys))
+			assert.Equal(t, keys[1], bytesutil.ToBytes48(req.PublicKeys[0]))
+			return &ethpb.DutiesResponse{}, nil
+		})
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch))
+}
+
+func TestWaitForSlot_ValidatorClock(t *testing.T) {
+	v := &validator{
+		genesisTime: uint64(time.Now().Unix()),
+		clock: func() time.Time {
+			return time.Now().Add(time.Hour)
+		},
+	}
+	// The slot starts after the deadline by the local clock, but has already started by
+	// the validator's clock.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, v.waitForSlot(ctx, 10))
+	slots := uint64(time.Hour.Seconds()) / params.BeaconConfig().SecondsPerSlot
+	assert.Equal(t, types.Epoch(slots/uint64(params.BeaconConfig().SlotsPerEpoch)), v.currentEpoch())
+}
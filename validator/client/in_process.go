@@ -0,0 +1,121 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc"
+)
+
+// InProcessConfig for a validator client driven by its caller.
+type InProcessConfig struct {
+	Conn       *grpc.ClientConn
+	KeyManager keymanager.IKeymanager
+	DB         db.Database
+	Graffiti   []byte
+	// Clock replaces the local clock of the validator client when set.
+	Clock func() time.Time
+}
+
+// InProcessValidator is a validator client which does not run the validator routine.
+// Its caller performs the duties of every slot instead, which lets simulations run
+// validator clients in step with a simulated clock.
+type InProcessValidator struct {
+	*validator
+}
+
+// NewInProcessValidator creates a validator client talking to a beacon node over an
+// existing gRPC connection.
+func NewInProcessValidator(ctx context.Context, cfg *InProcessConfig) (*InProcessValidator, error) {
+	cache, aggregatedSlotCommitteeIDCache, err := newValidatorCaches()
+	if err != nil {
+		return nil, err
+	}
+	graffitiSource, err := graffiti.NewSource("")
+	if err != nil {
+		return nil, err
+	}
+	graffitiOrderedHash := graffitiSource.Graffiti().OrderedHash()
+	graffitiOrderedIndex, err := cfg.DB.GraffitiOrderedIndex(ctx, graffitiOrderedHash)
+	if err != nil {
+		return nil, err
+	}
+	return &InProcessValidator{&validator{
+		db:                             cfg.DB,
+		validatorClient:                ethpb.NewBeaconNodeValidatorClient(cfg.Conn),
+		beaconClient:                   ethpb.NewBeaconChainClient(cfg.Conn),
+		node:                           ethpb.NewNodeClient(cfg.Conn),
+		dutiesClient:                   pbrpc.NewDutiesClient(cfg.Conn),
+		keyManager:                     cfg.KeyManager,
+		graffiti:                       cfg.Graffiti,
+		startBalances:                  make(map[[48]byte]uint64),
+		prevBalance:                    make(map[[48]byte]uint64),
+		attLogs:                        make(map[[32]byte]*attSubmitted),
+		domainDataCache:                cache,
+		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
+		voteStats:                      voteStats{startEpoch: types.Epoch(^uint64(0))},
+		walletInitializedFeed:          new(event.Feed),
+		blockFeed:                      new(event.Feed),
+		graffitiSource:                 graffitiSource,
+		graffitiOrderedHash:            graffitiOrderedHash,
+		graffitiOrderedIndex:           graffitiOrderedIndex,
+		eipImportBlacklistedPublicKeys: make(map[[48]byte]bool),
+		clock:                          cfg.Clock,
+	}}, nil
+}
+
+// ProposeBlocks proposes a block with every key assigned to propose at the slot.
+func (v *InProcessValidator) ProposeBlocks(ctx context.Context, slot types.Slot) error {
+	return v.performRole(ctx, slot, roleProposer, v.ProposeBlock)
+}
+
+// SubmitAttestations submits an attestation with every key assigned to attest at the slot.
+func (v *InProcessValidator) SubmitAttestations(ctx context.Context, slot types.Slot) error {
+	return v.performRole(ctx, slot, roleAttester, v.SubmitAttestation)
+}
+
+// SubmitAggregateAndProofs submits an aggregate with every key selected to aggregate at
+// the slot.
+func (v *InProcessValidator) SubmitAggregateAndProofs(ctx context.Context, slot types.Slot) error {
+	return v.performRole(ctx, slot, roleAggregator, v.SubmitAggregateAndProof)
+}
+
+// performRole performs the duty of every key having the role at the slot, one key after
+// the other in the order of the keys.
+func (v *InProcessValidator) performRole(
+	ctx context.Context, slot types.Slot, role ValidatorRole, perform func(context.Context, types.Slot, [48]byte),
+) error {
+	if v.duties == nil {
+		return errors.New("duties are not known yet")
+	}
+	roles, err := v.RolesAt(ctx, slot)
+	if err != nil {
+		return err
+	}
+	var pubKeys [][48]byte
+	for pubKey, keyRoles := range roles {
+		for _, r := range keyRoles {
+			if r == role {
+				pubKeys = append(pubKeys, pubKey)
+				break
+			}
+		}
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	for _, pubKey := range pubKeys {
+		perform(ctx, slot, pubKey)
+	}
+	return nil
+}
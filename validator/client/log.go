@@ -9,7 +9,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -75,7 +74,7 @@ func (v *validator) LogNextDutyTimeLeft(slot types.Slot) error {
 		if err != nil {
 			return err
 		}
-		timeLeft := time.Duration(nextDutyTime.Unix() - timeutils.Now().Unix()).Nanoseconds()
+		timeLeft := time.Duration(nextDutyTime.Unix() - v.now().Unix()).Nanoseconds()
 		// There is not much value to log if time left is less than one slot.
 		if uint64(timeLeft) >= params.BeaconConfig().SecondsPerSlot {
 			log.WithFields(
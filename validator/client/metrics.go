@@ -174,6 +174,65 @@ var (
 	)
 )
 
+var (
+	beaconNodeHealthyGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "1 if the beacon node is responsive, synced and close to the highest known head, 0 otherwise.",
+		},
+		[]string{"endpoint"},
+	)
+	beaconNodeHeadSlotGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_head_slot",
+			Help:      "Head slot last reported by the beacon node.",
+		},
+		[]string{"endpoint"},
+	)
+	beaconNodeLatencyGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_latency_seconds",
+			Help:      "Duration of the last health probe of the beacon node.",
+		},
+		[]string{"endpoint"},
+	)
+	beaconNodeErrorsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_probe_errors_total",
+			Help:      "Number of failed health probes of the beacon node.",
+		},
+		[]string{"endpoint"},
+	)
+	beaconNodeRoutedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_routed_requests_total",
+			Help:      "Number of requests routed to the beacon node.",
+		},
+		[]string{"endpoint"},
+	)
+	beaconNodeBroadcastCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_broadcasts_total",
+			Help:      "Number of signed objects broadcast to the beacon node, by type and result.",
+		},
+		[]string{"endpoint", "type", "result"},
+	)
+	beaconNodeBlocksChosenCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_blocks_chosen_total",
+			Help:      "Number of proposed blocks built by the beacon node.",
+		},
+		[]string{"endpoint"},
+	)
+)
+
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
@@ -30,6 +30,7 @@ type FakeValidator struct {
 	WaitForChainStartCalled           int
 	WaitForSyncCalled                 int
 	WaitForActivationCalled           int
+	CheckDoppelGangerCalled           int
 	CanonicalHeadSlotCalled           int
 	ReceiveBlocksCalled               int
 	RetryTillSuccess                  int
@@ -81,6 +82,12 @@ func (fv *FakeValidator) WaitForActivation(_ context.Context, _ <-chan struct{})
 	return nil
 }
 
+// CheckDoppelGanger for mocking.
+func (fv *FakeValidator) CheckDoppelGanger(_ context.Context) error {
+	fv.CheckDoppelGangerCalled++
+	return nil
+}
+
 // WaitForSync for mocking.
 func (fv *FakeValidator) WaitForSync(_ context.Context) error {
 	fv.WaitForSyncCalled++
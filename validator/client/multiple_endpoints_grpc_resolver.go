@@ -3,6 +3,7 @@ package client
 import (
 	"strings"
 
+	"google.golang.org/grpc/attributes"
 	"google.golang.org/grpc/resolver"
 )
 
@@ -12,12 +13,20 @@ import (
 // It can be used with any grpc load balancer (pick_first, round_robin). Default is pick_first.
 // Round robin can be used by adding the following option:
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
-type multipleEndpointsGrpcResolverBuilder struct{}
+// When health is set, it is attached to every address so the healthiest_beacon_node
+// load balancer can route requests to the healthiest beacon node.
+type multipleEndpointsGrpcResolverBuilder struct {
+	health *beaconNodeHealth
+}
+
+// beaconNodeHealthKey is the address attribute key of the beacon node health tracker.
+type beaconNodeHealthKey struct{}
 
-func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+func (b *multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
 	r := &multipleEndpointsGrpcResolver{
 		target: target,
 		cc:     cc,
+		health: b.health,
 	}
 	r.start()
 	return r, nil
@@ -30,13 +39,18 @@ func (*multipleEndpointsGrpcResolverBuilder) Scheme() string {
 type multipleEndpointsGrpcResolver struct {
 	target resolver.Target
 	cc     resolver.ClientConn
+	health *beaconNodeHealth
 }
 
 func (r *multipleEndpointsGrpcResolver) start() {
 	endpoints := strings.Split(r.target.Endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
-		addrs = append(addrs, resolver.Address{Addr: endpoint})
+		addr := resolver.Address{Addr: endpoint}
+		if r.health != nil {
+			addr.Attributes = attributes.New(beaconNodeHealthKey{}, r.health)
+		}
+		addrs = append(addrs, addr)
 	}
 	r.cc.UpdateState(resolver.State{Addresses: addrs})
 }
@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -18,6 +19,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -59,7 +62,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, slot)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -68,7 +71,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 
 	// Request block from beacon node
-	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
+	b, beaconNode, err := v.requestBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
 		Graffiti:     g,
@@ -119,7 +122,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 
 	// Propose and broadcast block via beacon node
-	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
+	blkResp, err := v.proposeBlock(ctx, blk)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
@@ -135,13 +138,17 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	)
 
 	blkRoot := fmt.Sprintf("%#x", bytesutil.Trunc(blkResp.BlockRoot))
-	log.WithFields(logrus.Fields{
+	fields := logrus.Fields{
 		"slot":            b.Slot,
 		"blockRoot":       blkRoot,
 		"numAttestations": len(b.Body.Attestations),
 		"numDeposits":     len(b.Body.Deposits),
 		"graffiti":        string(b.Body.Graffiti),
-	}).Info("Submitted new block")
+	}
+	if beaconNode != "" {
+		fields["beaconNode"] = beaconNode
+	}
+	log.WithFields(fields).Info("Submitted new block")
 
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
@@ -283,50 +290,123 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
-func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, error) {
+// Gets the graffiti from cli or file for the validator public key, rendering graffiti
+// templates for the proposal at the given slot.
+func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte, slot types.Slot) ([]byte, error) {
+	if v.graffitiSource == nil {
+		return nil, errors.New("graffitiSource can't be nil")
+	}
+	g := v.graffitiSource.Graffiti()
+
 	// When specified, default graffiti from the command line takes the first priority.
 	if len(v.graffiti) != 0 {
-		return v.graffiti, nil
+		return v.renderGraffiti(ctx, string(v.graffiti), pubKey, slot)
 	}
 
-	if v.graffitiStruct == nil {
-		return nil, errors.New("graffitiStruct can't be nil")
+	// When specified, individual validator specified graffiti takes the second priority,
+	// whether the validator is given by its public key or by its index.
+	if tmpl, ok := g.ForPublicKey(pubKey); ok {
+		return v.renderGraffiti(ctx, tmpl, pubKey, slot)
 	}
-
-	// When specified, individual validator specified graffiti takes the second priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
 	}
-	g, ok := v.graffitiStruct.Specific[idx.Index]
-	if ok {
-		return []byte(g), nil
+	if tmpl, ok := g.Specific[idx.Index]; ok {
+		return v.renderGraffiti(ctx, tmpl, pubKey, slot)
+	}
+
+	// The ordered list starts over whenever it changes.
+	if orderedHash := g.OrderedHash(); len(g.Ordered) != 0 && orderedHash != v.graffitiOrderedHash {
+		v.graffitiOrderedIndex, err = v.db.GraffitiOrderedIndex(ctx, orderedHash)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read graffiti ordered index")
+		}
+		v.graffitiOrderedHash = orderedHash
 	}
 
 	// When specified, a graffiti from the ordered list in the file take third priority.
-	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
-		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
+	if v.graffitiOrderedIndex < uint64(len(g.Ordered)) {
+		tmpl := g.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
 		err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to update graffiti ordered index")
 		}
-		return []byte(graffiti), nil
+		return v.renderGraffiti(ctx, tmpl, pubKey, slot)
 	}
 
 	// When specified, a graffiti from the random list in the file take fourth priority.
-	if len(v.graffitiStruct.Random) != 0 {
+	if len(g.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
-		i := r.Uint64() % uint64(len(v.graffitiStruct.Random))
-		return []byte(v.graffitiStruct.Random[i]), nil
+		i := r.Uint64() % uint64(len(g.Random))
+		return v.renderGraffiti(ctx, g.Random[i], pubKey, slot)
 	}
 
 	// Finally, default graffiti if specified in the file will be used.
-	if v.graffitiStruct.Default != "" {
-		return []byte(v.graffitiStruct.Default), nil
+	if g.Default != "" {
+		return v.renderGraffiti(ctx, g.Default, pubKey, slot)
 	}
 
 	return []byte{}, nil
 }
+
+// Renders a graffiti template for the proposal of the validator at the given slot. The
+// beacon node is only queried for the fields templates may use, and fields which cannot be
+// retrieved are left empty rather than failing the proposal.
+func (v *validator) renderGraffiti(ctx context.Context, tmpl string, pubKey [48]byte, slot types.Slot) ([]byte, error) {
+	if !graffiti.IsTemplate(tmpl) {
+		return []byte(tmpl), nil
+	}
+	data := &graffiti.TemplateData{
+		PublicKey: fmt.Sprintf("%x", bytesutil.Trunc(pubKey[:])),
+		Slot:      slot,
+		Epoch:     helpers.SlotToEpoch(slot),
+		Version:   version.SemanticVersion(),
+	}
+	if strings.Contains(tmpl, ".Index") {
+		idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+		if err != nil {
+			return nil, err
+		}
+		data.Index = idx.Index
+	}
+	if v.node != nil && strings.Contains(tmpl, ".NodeID") {
+		if host, err := v.node.GetHost(ctx, &pbtypes.Empty{}); err == nil {
+			data.NodeID = shortPeerID(host.PeerId)
+		} else {
+			log.WithError(err).Debug("Could not get beacon node identity for graffiti")
+		}
+	}
+	if v.node != nil && strings.Contains(tmpl, ".Eth1Client") {
+		if ver, err := v.node.GetVersion(ctx, &pbtypes.Empty{}); err == nil {
+			data.Eth1Client = eth1ClientName(ver.Metadata)
+		} else {
+			log.WithError(err).Debug("Could not get beacon node version for graffiti")
+		}
+	}
+	return graffiti.Render(tmpl, data)
+}
+
+// shortPeerID returns the last characters of a peer ID, which unlike its first characters
+// differ between peers.
+func shortPeerID(peerID string) string {
+	const length = 8
+	if len(peerID) > length {
+		return peerID[len(peerID)-length:]
+	}
+	return peerID
+}
+
+// eth1ClientName extracts the name of the Eth1 client from the metadata of the beacon
+// node version, such as "Geth" for "eth1_client=Geth/v1.10.1-stable/linux-amd64/go1.16".
+func eth1ClientName(metadata string) string {
+	const prefix = "eth1_client="
+	for _, field := range strings.Fields(metadata) {
+		if strings.HasPrefix(field, prefix) {
+			return strings.SplitN(strings.TrimPrefix(field, prefix), "/", 2)[0]
+		}
+	}
+	return ""
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -678,54 +682,54 @@ func TestGetGraffiti_Ok(t *testing.T) {
 		{name: "use default cli graffiti",
 			v: &validator{
 				graffiti: []byte{'b'},
-				graffitiStruct: &graffiti.Graffiti{
+				graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
 					Default: "c",
 					Random:  []string{"d", "e"},
 					Specific: map[types.ValidatorIndex]string{
 						1: "f",
 						2: "g",
 					},
-				},
+				}),
 			},
 			want: []byte{'b'},
 		},
 		{name: "use default file graffiti",
 			v: &validator{
 				validatorClient: m.validatorClient,
-				graffitiStruct: &graffiti.Graffiti{
+				graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
 					Default: "c",
-				},
+				}),
 			},
 			want: []byte{'c'},
 		},
 		{name: "use random file graffiti",
 			v: &validator{
 				validatorClient: m.validatorClient,
-				graffitiStruct: &graffiti.Graffiti{
+				graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
 					Random:  []string{"d"},
 					Default: "c",
-				},
+				}),
 			},
 			want: []byte{'d'},
 		},
 		{name: "use validator file graffiti, has validator",
 			v: &validator{
 				validatorClient: m.validatorClient,
-				graffitiStruct: &graffiti.Graffiti{
+				graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
 					Random:  []string{"d"},
 					Default: "c",
 					Specific: map[types.ValidatorIndex]string{
 						1: "f",
 						2: "g",
 					},
-				},
+				}),
 			},
 			want: []byte{'g'},
 		},
 		{name: "use validator file graffiti, none specified",
 			v: &validator{
 				validatorClient: m.validatorClient,
-				graffitiStruct:  &graffiti.Graffiti{},
+				graffitiSource:  graffiti.NewStaticSource(&graffiti.Graffiti{}),
 			},
 			want: []byte{},
 		},
@@ -738,7 +742,7 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
 			}
-			got, err := tt.v.getGraffiti(context.Background(), pubKey)
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 0)
 			require.NoError(t, err)
 			require.DeepEqual(t, tt.want, got)
 		})
@@ -760,14 +764,153 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	v := &validator{
 		db:              valDB,
 		validatorClient: m.validatorClient,
-		graffitiStruct: &graffiti.Graffiti{
+		graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
 			Ordered: []string{"a", "b", "c"},
 			Default: "d",
-		},
+		}),
 	}
 	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'d'}, {'d'}} {
-		got, err := v.getGraffiti(context.Background(), pubKey)
+		got, err := v.getGraffiti(context.Background(), pubKey, 0)
 		require.NoError(t, err)
 		require.DeepEqual(t, want, got)
 	}
 }
+
+func TestGetGraffiti_PublicKey(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	g := &graffiti.Graffiti{
+		Default:    "c",
+		Specific:   map[types.ValidatorIndex]string{2: "i"},
+		PublicKeys: map[string]string{fmt.Sprintf("%#x", pubKey): "p"},
+	}
+
+	// The graffiti of the public key takes priority over the rest of the file.
+	v := &validator{graffitiSource: graffiti.NewStaticSource(g)}
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'p'}, got)
+
+	// The graffiti from the command line takes priority over the file.
+	v.graffiti = []byte{'b'}
+	got, err = v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'b'}, got)
+}
+
+func TestGetGraffiti_Template(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [48]byte{'a'}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(2).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{Metadata: "eth1_client=Geth/v1.10.1-stable/linux-amd64/go1.16"}, nil)
+	m.nodeClient.EXPECT().
+		GetHost(gomock.Any(), gomock.Any()).
+		Return(&ethpb.HostData{PeerId: "16Uiu2HAmAbCdEfGh"}, nil)
+
+	v := &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
+			Default: "{{.Index}} {{.Epoch}} {{.NodeID}} {{.Eth1Client}}",
+		}),
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, "2 1 AbCdEfGh Geth", string(got))
+}
+
+func TestGetGraffiti_TemplateWithoutEth1Client(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{Version: "Prysm"}, nil)
+
+	v := &validator{
+		graffiti:        []byte("prysm{{.Eth1Client}}"),
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffitiSource:  graffiti.NewStaticSource(&graffiti.Graffiti{}),
+	}
+	got, err := v.getGraffiti(context.Background(), [48]byte{'a'}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "prysm", string(got))
+}
+
+func TestGetGraffitiOrdered_ResetsWhenFileChanges(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(3).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{
+			Ordered: []string{"a", "b"},
+		}),
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'a'}, got)
+	got, err = v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'b'}, got)
+
+	v.graffitiSource = graffiti.NewStaticSource(&graffiti.Graffiti{
+		Ordered: []string{"c", "d"},
+	})
+	got, err = v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'c'}, got)
+}
+
+func TestGetGraffitiOrdered_KeepsIndexWhenPublicKeyGraffitiIsSet(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(2).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("ordered:\n  - a\n  - b\n  - c\n"), os.ModePerm))
+	source, err := graffiti.NewSource(path)
+	require.NoError(t, err)
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffitiSource:  source,
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'a'}, got)
+
+	// Setting the graffiti of another validator rewrites the file but leaves the ordered
+	// list as it is.
+	require.NoError(t, source.SetPublicKeyGraffiti([48]byte{'b'}, "p"))
+	got, err = v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'b'}, got)
+}
@@ -28,6 +28,7 @@ type Validator interface {
 	WaitForChainStart(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
 	WaitForActivation(ctx context.Context, accountsChangedChan <-chan struct{}) error
+	CheckDoppelGanger(ctx context.Context) error
 	SlasherReady(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (types.Slot, error)
 	NextSlot() <-chan types.Slot
@@ -53,10 +54,11 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Check for doppelgangers, if enabled
+// 4 - Wait for the next slot start
+// 5 - Update assignments
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any
 func run(ctx context.Context, v Validator) {
 	cleanup := v.Done
 	defer cleanup()
@@ -110,6 +112,14 @@ func run(ctx context.Context, v Validator) {
 		if err != nil {
 			log.Fatalf("Could not wait for validator activation: %v", err)
 		}
+		err = v.CheckDoppelGanger(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not check for doppelgangers: %v", err)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Could not start validating: %v", err)
+		}
 		headSlot, err = v.CanonicalHeadSlot(ctx)
 		if isConnectionError(err) {
 			log.Warnf("Could not get current canonical head slot: %v", err)
@@ -245,6 +255,8 @@ func handleAccountsChanged(ctx context.Context, v Validator, accountsChangedChan
 	for {
 		select {
 		case <-validatingPubKeysChan:
+			// The keys added to the client are given duties once checked for doppelgangers.
+			go checkDoppelGangerOfNewKeys(ctx, v)
 			accountsChangedChan <- struct{}{}
 		case err := <-sub.Err():
 			log.WithError(err).Error("accounts changed subscription failed")
@@ -254,3 +266,24 @@ func handleAccountsChanged(ctx context.Context, v Validator, accountsChangedChan
 		}
 	}
 }
+
+// checkDoppelGangerOfNewKeys runs the doppelganger check for the validating keys which were not
+// checked yet, retrying on connection errors.
+func checkDoppelGangerOfNewKeys(ctx context.Context, v Validator) {
+	for {
+		err := v.CheckDoppelGanger(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not check new validating keys for doppelgangers: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backOffPeriod):
+				continue
+			}
+		}
+		if err != nil {
+			log.WithError(err).Error("Could not check new validating keys for doppelgangers")
+		}
+		return
+	}
+}
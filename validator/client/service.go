@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
@@ -67,7 +68,11 @@ type ValidatorService struct {
 	keyManager            keymanager.IKeymanager
 	grpcHeaders           []string
 	graffiti              []byte
-	graffitiStruct        *graffiti.Graffiti
+	graffitiSource        *graffiti.Source
+	doppelgangerEpochs    types.Epoch
+	broadcast             bool
+	clock                 func() time.Time
+	health                *beaconNodeHealth
 }
 
 // Config for the validator service.
@@ -89,13 +94,21 @@ type Config struct {
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
-	GraffitiStruct             *graffiti.Graffiti
+	GraffitiSource             *graffiti.Source
+	DoppelgangerEpochs         types.Epoch
+	Broadcast                  bool
+	// Clock replaces the local clock of the validator client when set.
+	Clock func() time.Time
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	graffitiSource := cfg.GraffitiSource
+	if graffitiSource == nil {
+		graffitiSource = graffiti.NewStaticSource(&graffiti.Graffiti{})
+	}
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
@@ -115,8 +128,11 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		db:                    cfg.ValDB,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
-		graffitiStruct:        cfg.GraffitiStruct,
+		graffitiSource:        graffitiSource,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
+		broadcast:             cfg.Broadcast,
+		clock:                 cfg.Clock,
 	}, nil
 }
 
@@ -141,6 +157,22 @@ func (v *ValidatorService) Start() {
 
 	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
+	// With several beacon nodes configured, track their health to route requests to the
+	// healthiest one and, optionally, broadcast signed objects to all of them.
+	if endpoints := strings.Split(v.endpoint, ","); len(endpoints) > 1 {
+		health, err := dialBeaconNodes(v.ctx, endpoints, dialOpts)
+		if err != nil {
+			log.Errorf("Could not dial beacon nodes: %v", err)
+			return
+		}
+		v.health = health
+		go v.health.run(v.ctx)
+		dialOpts = append([]grpc.DialOption{
+			grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{health: health}),
+			grpc.WithDefaultServiceConfig(healthiestBeaconNodeServiceConfig),
+		}, dialOpts...)
+	}
+
 	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
@@ -151,16 +183,7 @@ func (v *ValidatorService) Start() {
 	}
 
 	v.conn = conn
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1920, // number of keys to track.
-		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
-		BufferItems: 64,   // number of keys per Get buffer.
-	})
-	if err != nil {
-		panic(err)
-	}
-
-	aggregatedSlotCommitteeIDCache, err := lru.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
+	cache, aggregatedSlotCommitteeIDCache, err := newValidatorCaches()
 	if err != nil {
 		log.Errorf("Could not initialize cache: %v", err)
 		return
@@ -176,17 +199,19 @@ func (v *ValidatorService) Start() {
 		slashablePublicKeys[pubKey] = true
 	}
 
-	graffitiOrderedIndex, err := v.db.GraffitiOrderedIndex(v.ctx, v.graffitiStruct.Hash)
+	graffitiOrderedHash := v.graffitiSource.Graffiti().OrderedHash()
+	graffitiOrderedIndex, err := v.db.GraffitiOrderedIndex(v.ctx, graffitiOrderedHash)
 	if err != nil {
 		log.Errorf("Could not read graffiti ordered index from disk: %v", err)
 		return
 	}
 
-	v.validator = &validator{
+	val := &validator{
 		db:                             v.db,
 		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
+		dutiesClient:                   pbrpc.NewDutiesClient(v.conn),
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -201,25 +226,63 @@ func (v *ValidatorService) Start() {
 		useWeb:                         v.useWeb,
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
-		graffitiStruct:                 v.graffitiStruct,
+		graffitiSource:                 v.graffitiSource,
+		graffitiOrderedHash:            graffitiOrderedHash,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		doppelgangerEpochs:             v.doppelgangerEpochs,
+		clock:                          v.clock,
+	}
+	if v.health != nil {
+		val.beaconNodes = v.health
+		val.broadcast = v.broadcast
 	}
+	v.validator = val
+	go v.graffitiSource.Watch(v.ctx)
+	go val.receiveDependentRoots(v.ctx)
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
 
+// newValidatorCaches creates the domain data cache and the aggregated slot committee ID
+// cache of a validator.
+func newValidatorCaches() (*ristretto.Cache, *lru.Cache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1920, // number of keys to track.
+		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
+		BufferItems: 64,   // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	aggregatedSlotCommitteeIDCache, err := lru.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
+	if err != nil {
+		return nil, nil, err
+	}
+	return cache, aggregatedSlotCommitteeIDCache, nil
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.health != nil {
+		if err := v.health.close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node connections")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
 	return nil
 }
 
+// GraffitiSource returns the graffiti configuration used by the validator client.
+func (v *ValidatorService) GraffitiSource() *graffiti.Source {
+	return v.graffitiSource
+}
+
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
 	if v.conn == nil {
@@ -307,10 +370,12 @@ func ConstructDialOptions(
 			grpc_prometheus.StreamClientInterceptor,
 			grpc_retry.StreamClientInterceptor(),
 		),
-		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
 	}
 
+	// Resolvers passed in the extra options take precedence over the default one, as gRPC
+	// uses the first resolver registered for a scheme.
 	dialOpts = append(dialOpts, extraOpts...)
+	dialOpts = append(dialOpts, grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}))
 	return dialOpts
 }
 
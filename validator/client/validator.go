@@ -22,12 +22,14 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
@@ -90,14 +92,56 @@ type validator struct {
 	db                                 vdb.Database
 	graffiti                           []byte
 	voteStats                          voteStats
-	graffitiStruct                     *graffiti.Graffiti
+	graffitiSource                     *graffiti.Source
+	graffitiOrderedHash                [32]byte
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	doppelgangerEpochs                 types.Epoch
+	doppelgangerLock                   sync.Mutex
+	doppelgangerChecks                 map[[48]byte]*doppelgangerCheck
+	doppelgangerChecksLock             sync.RWMutex
+	broadcast                          bool
+	dutiesClient                       pbrpc.DutiesClient
+	dutiesEpoch                        types.Epoch
+	dutiesDependentRoots               dutiesDependentRoots
+	dutiesLock                         sync.Mutex
+	dependentRoots                     *pbrpc.DependentRootsResponse
+	previousEpochDependentRoot         []byte
+	nextDuties                         *ethpb.DutiesResponse
+	nextDutiesEpoch                    types.Epoch
+	nextDutiesDependentRoots           dutiesDependentRoots
+	prefetchingDuties                  bool
+	beaconNodes                        *beaconNodeHealth
+	clock                              func() time.Time
 }
 
 // Done cleans up the validator.
 func (v *validator) Done() {
-	v.ticker.Done()
+	if v.ticker != nil {
+		v.ticker.Done()
+	}
+}
+
+// now returns the current time of the validator's clock, which is the local clock
+// unless the validator was given its own.
+func (v *validator) now() time.Time {
+	if v.clock != nil {
+		return v.clock()
+	}
+	return timeutils.Now()
+}
+
+// localDeadline converts a time of the validator's clock into a deadline of the local
+// clock, which bounds the contexts of the requests made to the beacon node.
+func (v *validator) localDeadline(t time.Time) time.Time {
+	return time.Now().Add(t.Sub(v.now()))
+}
+
+// newSlotTicker starts a slot ticker in step with the validator's clock. The ticker
+// follows the local clock, so it is started from the genesis time of the local clock.
+func (v *validator) newSlotTicker() *slotutil.SlotTicker {
+	genesis := v.localDeadline(time.Unix(int64(v.genesisTime), 0))
+	return slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
 }
 
 // WaitForWalletInitialization checks if the validator needs to wait for
@@ -181,7 +225,7 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 
 	// Once the ChainStart log is received, we update the genesis time of the validator client
 	// and begin a slot ticker used to track the current slot the beacon node is in.
-	v.ticker = slotutil.NewSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	v.ticker = v.newSlotTicker()
 	log.WithField("genesisTime", time.Unix(int64(v.genesisTime), 0)).Info("Beacon chain started")
 	return nil
 }
@@ -358,45 +402,48 @@ func (v *validator) SlotDeadline(slot types.Slot) time.Time {
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
-		// Do nothing if not epoch start AND assignments already exist.
+	epoch := helpers.SlotToEpoch(slot)
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil && !v.dutiesOutdated() {
+		// Do nothing if not epoch start AND assignments already exist, unless a reorg
+		// changed the block the assignments depend on.
+		if roots, ok := v.startDutiesPrefetch(epoch + 1); ok {
+			go v.prefetchNextDuties(ctx, epoch+1, roots)
+		}
+		return nil
+	}
+	// Use the assignments prefetched during the previous epoch if they are still valid.
+	if slot%params.BeaconConfig().SlotsPerEpoch == 0 && v.promoteNextDuties(epoch) && !v.dutiesOutdated() {
+		v.logDuties(slot, v.duties.CurrentEpochDuties)
 		return nil
 	}
 	// Set deadline to end of epoch.
-	ss, err := helpers.StartSlot(helpers.SlotToEpoch(slot) + 1)
+	ss, err := helpers.StartSlot(epoch + 1)
 	if err != nil {
 		return err
 	}
-	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(ss))
+	ctx, cancel := context.WithDeadline(ctx, v.localDeadline(v.SlotDeadline(ss)))
 	defer cancel()
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
 
-	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	filteredKeys, err := v.dutiesPublicKeys(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Filter out the slashable public keys from the duties request.
-	filteredKeys := make([][48]byte, 0, len(validatingKeys))
-	v.slashableKeysLock.RLock()
-	for _, pubKey := range validatingKeys {
-		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
-			filteredKeys = append(filteredKeys, pubKey)
-		} else {
-			log.WithField(
-				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
-			).Warn("Not including slashable public key from slashing protection import " +
-				"in request to update validator duties")
-		}
-	}
-	v.slashableKeysLock.RUnlock()
-
 	req := &ethpb.DutiesRequest{
-		Epoch:      types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch),
+		Epoch:      epoch,
 		PublicKeys: bytesutil.FromBytes48Array(filteredKeys),
 	}
 
+	// The dependent roots are read before the request, so a head change during the
+	// request is detected as a change of the dependent roots afterwards.
+	dependentRoots := v.dependentRootsOf(epoch)
+	// The subnets of the attester duties were already subscribed to when only the
+	// proposer duties of the epoch changed.
+	subscribed := v.duties != nil && v.dutiesEpoch == epoch &&
+		bytes.Equal(v.dutiesDependentRoots.attester, dependentRoots.attester)
+
 	// If duties is nil it means we have had no prior duties and just started up.
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
@@ -406,7 +453,12 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	}
 
 	v.duties = resp
+	v.dutiesEpoch = epoch
+	v.dutiesDependentRoots = dependentRoots
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	if subscribed {
+		return nil
+	}
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
@@ -418,6 +470,45 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	return nil
 }
 
+// dutiesPublicKeys returns the validating public keys to request duties for.
+func (v *validator) dutiesPublicKeys(ctx context.Context) ([][48]byte, error) {
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter out the slashable public keys from the duties request.
+	filteredKeys := make([][48]byte, 0, len(validatingKeys))
+	v.slashableKeysLock.RLock()
+	for _, pubKey := range validatingKeys {
+		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
+			filteredKeys = append(filteredKeys, pubKey)
+		} else {
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Warn("Not including slashable public key from slashing protection import " +
+				"in request to update validator duties")
+		}
+	}
+	v.slashableKeysLock.RUnlock()
+
+	// Filter out the keys found live on the network by the doppelganger check, and
+	// the keys it has not verified yet.
+	if v.doppelgangerEpochs > 0 {
+		checkedKeys := filteredKeys
+		filteredKeys = make([][48]byte, 0, len(checkedKeys))
+		v.doppelgangerChecksLock.RLock()
+		for _, pubKey := range checkedKeys {
+			if c, ok := v.doppelgangerChecks[pubKey]; !ok || c.live || !c.verified {
+				continue
+			}
+			filteredKeys = append(filteredKeys, pubKey)
+		}
+		v.doppelgangerChecksLock.RUnlock()
+	}
+	return filteredKeys, nil
+}
+
 // subscribeToSubnets iterates through each validator duty, signs each slot, and asks beacon node
 // to eagerly subscribe to subnets so that the aggregator has attestations to aggregate.
 func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
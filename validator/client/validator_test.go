@@ -168,6 +168,18 @@ func TestWaitForChainStart_SetsGenesisInfo(t *testing.T) {
 	require.NoError(t, v.WaitForChainStart(context.Background()))
 }
 
+func TestLocalDeadline_ClockSkew(t *testing.T) {
+	skew := 30 * time.Second
+	v := &validator{clock: func() time.Time {
+		return time.Now().Add(skew)
+	}}
+	// The start of the slot ticker and the deadlines of the requests follow the local
+	// clock, which is behind the clock of the validator.
+	now := time.Now()
+	diff := v.localDeadline(now.Add(skew)).Sub(now)
+	assert.Equal(t, true, diff > -time.Second && diff < time.Second, "Clock skew not applied to the deadline")
+}
+
 func TestWaitForChainStart_SetsGenesisInfo_IncorrectSecondTry(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -3,6 +3,7 @@ package db
 import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/urfave/cli/v2"
 )
 
@@ -27,6 +28,50 @@ var DatabaseCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "check",
+			Description: "verifies the slashing protection history in the validator database has no recorded double " +
+				"or surround votes and consistent buckets and lowest signed epochs, and reports suspicious public keys",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := checkDB(cliCtx); err != nil {
+					log.Fatalf("Could not verify database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "compact",
+			Description: "rewrites the validator database file to reclaim the space freed by pruning and migrations",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := compactDB(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "prune",
+			Description: "prunes attesting history older than the current weak subjectivity period from the validator database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.DryRunFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := pruneDB(cliCtx); err != nil {
+					log.Fatalf("Could not prune database: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "migrate",
 			Category: "db",
@@ -37,6 +82,7 @@ var DatabaseCommands = &cli.Command{
 					Usage: "Runs up migrations for the validator database",
 					Flags: cmd.WrapFlags([]cli.Flag{
 						cmd.DataDirFlag,
+						flags.DryRunFlag,
 					}),
 					Before: tos.VerifyTosAcceptedOrPrompt,
 					Action: func(cliCtx *cli.Context) error {
@@ -51,6 +97,7 @@ var DatabaseCommands = &cli.Command{
 					Usage: "Runs down migrations for the validator database",
 					Flags: cmd.WrapFlags([]cli.Flag{
 						cmd.DataDirFlag,
+						flags.DryRunFlag,
 					}),
 					Before: tos.VerifyTosAcceptedOrPrompt,
 					Action: func(cliCtx *cli.Context) error {
@@ -0,0 +1,160 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Maximum size of the keys and values copied in a single transaction when compacting.
+const compactionTxMaxSize = 1 << 20
+
+// Compact rewrites the database in the directory path into a new file holding only the
+// pages in use, and replaces the database with it. Bolt never shrinks its file, so this
+// reclaims the space freed by pruning and migrations. The database must not be open.
+// It returns the size in bytes of the database file before and after compaction.
+func Compact(ctx context.Context, dirPath string) (int64, int64, error) {
+	datafile := filepath.Join(dirPath, ProtectionDbFileName)
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not find validator database")
+	}
+	sizeBefore := info.Size()
+
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	compactedFile := datafile + ".compact"
+	if err := os.RemoveAll(compactedFile); err != nil {
+		return 0, 0, err
+	}
+	dst, err := bolt.Open(compactedFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+		NoSync:  true,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := copyDatabase(ctx, dst, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(compactedFile)
+		return 0, 0, errors.Wrap(err, "could not copy database")
+	}
+	// Transactions are not synced while copying, so the copy is synced once complete.
+	if err := dst.Sync(); err != nil {
+		_ = dst.Close()
+		return 0, 0, err
+	}
+	if err := dst.Close(); err != nil {
+		return 0, 0, err
+	}
+	info, err = os.Stat(compactedFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(compactedFile, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database with its compacted copy")
+	}
+	return sizeBefore, info.Size(), nil
+}
+
+// copyDatabase copies every bucket, key and value of the source database into the
+// destination database, committing every compactionTxMaxSize bytes.
+func copyDatabase(ctx context.Context, dst, src *bolt.DB) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	var size int
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return walkDatabase(srcTx, func(path [][]byte, k, v []byte, seq uint64) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if size+len(k)+len(v) > compactionTxMaxSize {
+				if err := tx.Commit(); err != nil {
+					return err
+				}
+				if tx, err = dst.Begin(true); err != nil {
+					return err
+				}
+				size = 0
+			}
+			size += len(k) + len(v)
+
+			if len(path) == 0 {
+				bkt, err := tx.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				return bkt.SetSequence(seq)
+			}
+			parent := tx.Bucket(path[0])
+			for _, name := range path[1:] {
+				parent = parent.Bucket(name)
+			}
+			// Keys are copied in order, so pages can be filled up completely.
+			parent.FillPercent = 1.0
+			if v == nil {
+				bkt, err := parent.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				return bkt.SetSequence(seq)
+			}
+			return parent.Put(k, v)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// walkDatabase calls fn for every bucket, key and value of the database, parents first,
+// with the path of names of the bucket holding it. Buckets have a nil value and their
+// sequence, values the sequence of their bucket.
+func walkDatabase(tx *bolt.Tx, fn func(path [][]byte, k, v []byte, seq uint64) error) error {
+	return tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+		if err := fn(nil, name, nil, bkt.Sequence()); err != nil {
+			return err
+		}
+		return walkBucket(bkt, [][]byte{name}, fn)
+	})
+}
+
+func walkBucket(bkt *bolt.Bucket, path [][]byte, fn func(path [][]byte, k, v []byte, seq uint64) error) error {
+	return bkt.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return fn(path, k, v, bkt.Sequence())
+		}
+		child := bkt.Bucket(k)
+		if err := fn(path, k, nil, child.Sequence()); err != nil {
+			return err
+		}
+		childPath := make([][]byte, len(path)+1)
+		copy(childPath, path)
+		childPath[len(path)] = k
+		return walkBucket(child, childPath, fn)
+	})
+}
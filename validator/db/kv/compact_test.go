@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dirPath := t.TempDir()
+	validatorDB, err := NewKVStore(ctx, dirPath, &Config{})
+	require.NoError(t, err)
+	pubKeys := make([][48]byte, 200)
+	for i := range pubKeys {
+		pubKeys[i] = [48]byte{byte(i), byte(i >> 8)}
+		saveTestAttestations(t, validatorDB, pubKeys[i], sourceTarget{0, 1}, sourceTarget{1, 2})
+	}
+	// Deleting most of the history leaves free pages in the file.
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		for _, pubKey := range pubKeys[1:] {
+			if err := tx.Bucket(pubKeysBucket).DeleteBucket(pubKey[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, validatorDB.Close())
+
+	sizeBefore, sizeAfter, err := Compact(ctx, dirPath)
+	require.NoError(t, err)
+	assert.Equal(t, true, sizeAfter < sizeBefore, "Expected %d < %d", sizeAfter, sizeBefore)
+
+	validatorDB, err = NewKVStore(ctx, dirPath, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(history))
+	report, err := validatorDB.CheckIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Issues))
+}
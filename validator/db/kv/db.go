@@ -52,6 +52,12 @@ var blockedBuckets = [][]byte{
 type Config struct {
 	PubKeys         [][48]byte
 	InitialMMapSize int
+	// SkipPruning leaves attesting history older than the current weak subjectivity
+	// period in the database, to inspect it as it is on disk.
+	SkipPruning bool
+	// MetricsRegisterer registers the metrics of the store, the default prometheus
+	// registerer if not set.
+	MetricsRegisterer prometheus.Registerer
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -161,15 +167,21 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 
 	// Prune attesting records older than the current weak subjectivity period.
-	if err := kv.PruneAttestationsOlderThanCurrentWeakSubjectivity(ctx); err != nil {
-		return nil, errors.Wrap(err, "could not prune old attestations from DB")
+	if config == nil || !config.SkipPruning {
+		if err := kv.PruneAttestationsOlderThanCurrentWeakSubjectivity(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not prune old attestations from DB")
+		}
 	}
 
 	// Batch save attestation records for slashing protection at timed
 	// intervals to our database.
 	go kv.batchAttestationWrites(ctx)
 
-	return kv, prometheus.Register(createBoltCollector(kv.db))
+	registerer := prometheus.DefaultRegisterer
+	if config != nil && config.MetricsRegisterer != nil {
+		registerer = config.MetricsRegisterer
+	}
+	return kv, registerer.Register(createBoltCollector(kv.db))
 }
 
 // UpdatePublicKeysBuckets for a specified list of keys.
@@ -0,0 +1,242 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// pubKeyPathSegment replaces public keys in the bucket paths of a dry run report, so the
+// changes to the buckets of all public keys are aggregated.
+const pubKeyPathSegment = "<pubkey>"
+
+// BucketChanges counts the keys a dry run would add, remove or modify in a bucket.
+type BucketChanges struct {
+	Bucket     string
+	Added      int
+	Removed    int
+	Modified   int
+	PublicKeys int
+}
+
+// DryRunReport lists the changes an operation would make to the database, by bucket.
+type DryRunReport struct {
+	Changes []*BucketChanges
+}
+
+// Empty returns true if the operation would not change the database.
+func (r *DryRunReport) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+// DryRun runs an operation, such as a migration or pruning, on a copy of the database and
+// reports the changes it made to the copy, which is then deleted. The database is not modified.
+func (s *Store) DryRun(ctx context.Context, op func(context.Context, *Store) error) (*DryRunReport, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.DryRun")
+	defer span.End()
+
+	tmpDir, err := ioutil.TempDir("", "validator-db-dry-run")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			log.WithError(err).Error("Could not remove copy of database")
+		}
+	}()
+	copyFile := filepath.Join(tmpDir, ProtectionDbFileName)
+	if err := s.view(func(tx *bolt.Tx) error {
+		return tx.CopyFile(copyFile, params.BeaconIoConfig().ReadWritePermissions)
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not copy database")
+	}
+	copyDB, err := bolt.Open(copyFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+		NoSync:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := copyDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close copy of database")
+		}
+	}()
+	// The copy is not registered for metrics nor batches attestation writes, as only
+	// migrations and pruning are meant to run on it.
+	copyStore := &Store{
+		db:                           copyDB,
+		databasePath:                 tmpDir,
+		batchedAttestations:          NewQueuedAttestationRecords(),
+		batchedAttestationsChan:      make(chan *AttestationRecord, attestationBatchCapacity),
+		batchAttestationsFlushedFeed: new(event.Feed),
+	}
+	if err := op(ctx, copyStore); err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]*BucketChanges)
+	pubKeys := make(map[string]map[string]bool)
+	record := func(path []string, pubKey []byte, added, removed, modified int) {
+		name := strings.Join(path, "/")
+		c, ok := changes[name]
+		if !ok {
+			c = &BucketChanges{Bucket: name}
+			changes[name] = c
+			pubKeys[name] = make(map[string]bool)
+		}
+		c.Added += added
+		c.Removed += removed
+		c.Modified += modified
+		if pubKey != nil {
+			pubKeys[name][string(pubKey)] = true
+		}
+	}
+	err = s.view(func(before *bolt.Tx) error {
+		return copyDB.View(func(after *bolt.Tx) error {
+			return diffBuckets(before, after, nil, nil, record)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	report := &DryRunReport{Changes: make([]*BucketChanges, 0, len(changes))}
+	for name, c := range changes {
+		c.PublicKeys = len(pubKeys[name])
+		report.Changes = append(report.Changes, c)
+	}
+	sort.Slice(report.Changes, func(i, j int) bool {
+		return report.Changes[i].Bucket < report.Changes[j].Bucket
+	})
+	return report, nil
+}
+
+// bucketReader is implemented by transactions, for top level buckets, and by buckets.
+type bucketReader interface {
+	Cursor() *bolt.Cursor
+	Bucket(name []byte) *bolt.Bucket
+}
+
+// diffBuckets compares the keys of two buckets, either of which may be nil, and records
+// the differences by bucket path. Values are compared, and sub-buckets compared recursively.
+func diffBuckets(
+	before, after bucketReader,
+	path []string,
+	pubKey []byte,
+	record func(path []string, pubKey []byte, added, removed, modified int),
+) error {
+	var cBefore, cAfter *bolt.Cursor
+	var kBefore, vBefore, kAfter, vAfter []byte
+	if before != nil {
+		cBefore = before.Cursor()
+		kBefore, vBefore = cBefore.First()
+	}
+	if after != nil {
+		cAfter = after.Cursor()
+		kAfter, vAfter = cAfter.First()
+	}
+	for kBefore != nil || kAfter != nil {
+		cmp := 0
+		switch {
+		case kBefore == nil:
+			cmp = 1
+		case kAfter == nil:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(kBefore, kAfter)
+		}
+		switch {
+		case cmp < 0:
+			if err := diffEntry(before, nil, kBefore, vBefore, nil, path, pubKey, record); err != nil {
+				return err
+			}
+			kBefore, vBefore = cBefore.Next()
+		case cmp > 0:
+			if err := diffEntry(nil, after, kAfter, nil, vAfter, path, pubKey, record); err != nil {
+				return err
+			}
+			kAfter, vAfter = cAfter.Next()
+		default:
+			if err := diffEntry(before, after, kBefore, vBefore, vAfter, path, pubKey, record); err != nil {
+				return err
+			}
+			kBefore, vBefore = cBefore.Next()
+			kAfter, vAfter = cAfter.Next()
+		}
+	}
+	return nil
+}
+
+// diffEntry compares a key present in at least one of two buckets. A nil value is a
+// sub-bucket, unless the bucket holding it is nil.
+func diffEntry(
+	before, after bucketReader,
+	k, vBefore, vAfter []byte,
+	path []string,
+	pubKey []byte,
+	record func(path []string, pubKey []byte, added, removed, modified int),
+) error {
+	var subBefore, subAfter *bolt.Bucket
+	if before != nil && vBefore == nil {
+		subBefore = before.Bucket(k)
+	}
+	if after != nil && vAfter == nil {
+		subAfter = after.Bucket(k)
+	}
+	if subBefore != nil || subAfter != nil {
+		childPubKey := pubKey
+		segment := pathSegment(k)
+		if len(k) == 48 {
+			childPubKey, segment = k, pubKeyPathSegment
+		}
+		childPath := append(append(make([]string, 0, len(path)+1), path...), segment)
+		// Buckets are keys of their parent bucket, and a value replaced by a bucket, or
+		// the reverse, is a modification of the parent bucket.
+		switch {
+		case before == nil:
+			record(path, pubKey, 1, 0, 0)
+		case after == nil:
+			record(path, pubKey, 0, 1, 0)
+		case subBefore == nil || subAfter == nil:
+			record(path, pubKey, 0, 0, 1)
+		}
+		var b, a bucketReader
+		if subBefore != nil {
+			b = subBefore
+		}
+		if subAfter != nil {
+			a = subAfter
+		}
+		return diffBuckets(b, a, childPath, childPubKey, record)
+	}
+	switch {
+	case before == nil:
+		record(path, pubKey, 1, 0, 0)
+	case after == nil:
+		record(path, pubKey, 0, 1, 0)
+	case !bytes.Equal(vBefore, vAfter):
+		record(path, pubKey, 0, 0, 1)
+	}
+	return nil
+}
+
+func pathSegment(name []byte) string {
+	for _, r := range string(name) {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return fmt.Sprintf("%#x", name)
+		}
+	}
+	return string(name)
+}
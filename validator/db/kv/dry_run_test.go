@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func pruneOp(ctx context.Context, s *Store) error {
+	return s.PruneAttestationsOlderThanCurrentWeakSubjectivity(ctx)
+}
+
+func TestStore_DryRun_Pruning(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	wssPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	saveTestAttestations(t, validatorDB, pubKey, sourceTarget{0, 1}, sourceTarget{wssPeriod, wssPeriod + 1})
+
+	report, err := validatorDB.DryRun(ctx, pruneOp)
+	require.NoError(t, err)
+	assert.Equal(t, false, report.Empty())
+	changes := make(map[string]*BucketChanges)
+	for _, c := range report.Changes {
+		changes[c.Bucket] = c
+	}
+	for _, bucket := range []string{
+		"pubkeys-bucket/<pubkey>/att-source-epochs-bucket",
+		"pubkeys-bucket/<pubkey>/att-target-epochs-bucket",
+		"pubkeys-bucket/<pubkey>/att-signing-roots-bucket",
+	} {
+		c, ok := changes[bucket]
+		require.Equal(t, true, ok, "No changes to %s", bucket)
+		assert.DeepEqual(t, &BucketChanges{Bucket: bucket, Removed: 1, PublicKeys: 1}, c)
+	}
+
+	// The database itself is left untouched.
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(history))
+}
+
+func TestStore_DryRun_NoChanges(t *testing.T) {
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	saveTestAttestations(t, validatorDB, pubKey, sourceTarget{0, 1})
+
+	report, err := validatorDB.DryRun(context.Background(), pruneOp)
+	require.NoError(t, err)
+	assert.Equal(t, true, report.Empty())
+}
@@ -0,0 +1,325 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// IntegrityIssueKind categorizes the invariants of the slashing protection history.
+type IntegrityIssueKind string
+
+const (
+	// MalformedRecord is a record which cannot be decoded.
+	MalformedRecord IntegrityIssueKind = "malformed record"
+	// SourceAfterTarget is an attestation with a source epoch greater than its target epoch.
+	SourceAfterTarget IntegrityIssueKind = "source after target"
+	// RecordedDoubleVote is a pair of attestations with the same target and different sources.
+	RecordedDoubleVote IntegrityIssueKind = "double vote"
+	// RecordedSurroundVote is a pair of attestations where one surrounds the other.
+	RecordedSurroundVote IntegrityIssueKind = "surround vote"
+	// InconsistentBuckets is attesting history recorded in some buckets of a public key but not the others.
+	InconsistentBuckets IntegrityIssueKind = "inconsistent buckets"
+	// InconsistentLowestSigned is a lowest signed epoch or slot which is missing or above the history.
+	InconsistentLowestSigned IntegrityIssueKind = "inconsistent lowest signed"
+	// InconsistentHighestSigned is a highest signed slot which is missing or below the history.
+	InconsistentHighestSigned IntegrityIssueKind = "inconsistent highest signed"
+)
+
+// IntegrityIssue is a violated invariant of the slashing protection history of a public key.
+type IntegrityIssue struct {
+	PubKey  [48]byte
+	Kind    IntegrityIssueKind
+	Message string
+}
+
+// IntegrityReport is the result of an integrity check of the database.
+type IntegrityReport struct {
+	PublicKeysChecked int
+	Issues            []*IntegrityIssue
+}
+
+// SuspiciousPublicKeys returns the public keys with at least one integrity issue, in order.
+func (r *IntegrityReport) SuspiciousPublicKeys() [][48]byte {
+	seen := make(map[[48]byte]bool)
+	pubKeys := make([][48]byte, 0)
+	for _, issue := range r.Issues {
+		if !seen[issue.PubKey] {
+			seen[issue.PubKey] = true
+			pubKeys = append(pubKeys, issue.PubKey)
+		}
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	return pubKeys
+}
+
+// sourceTarget is a recorded attestation of a public key.
+type sourceTarget struct {
+	source types.Epoch
+	target types.Epoch
+}
+
+// CheckIntegrity verifies the invariants of the slashing protection history of every
+// public key in the database: no recorded double or surround votes, sources never after
+// targets, the same attestations in the source, target and signing root buckets, and
+// lowest and highest signed values bounding the history. Attesting history which may
+// be pruned, being older than the current weak subjectivity period, is allowed to be
+// missing from some buckets. The database is not modified.
+func (s *Store) CheckIntegrity(ctx context.Context) (*IntegrityReport, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.CheckIntegrity")
+	defer span.End()
+
+	report := &IntegrityReport{Issues: make([]*IntegrityIssue, 0)}
+	err := s.view(func(tx *bolt.Tx) error {
+		pubKeys := storedPublicKeys(tx)
+		report.PublicKeysChecked = len(pubKeys)
+		for _, pubKey := range pubKeys {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			report.Issues = append(report.Issues, checkAttestingHistory(tx, pubKey)...)
+			report.Issues = append(report.Issues, checkProposalHistory(tx, pubKey)...)
+		}
+		return nil
+	})
+	return report, err
+}
+
+// storedPublicKeys returns every public key with slashing protection data, in order.
+func storedPublicKeys(tx *bolt.Tx) [][48]byte {
+	seen := make(map[[48]byte]bool)
+	collect := func(bucket []byte) {
+		bkt := tx.Bucket(bucket)
+		if bkt == nil {
+			return
+		}
+		_ = bkt.ForEach(func(k, _ []byte) error {
+			if len(k) == 48 {
+				seen[bytesutil.ToBytes48(k)] = true
+			}
+			return nil
+		})
+	}
+	collect(pubKeysBucket)
+	collect(historicProposalsBucket)
+	collect(lowestSignedSourceBucket)
+	collect(lowestSignedTargetBucket)
+	collect(lowestSignedProposalsBucket)
+	collect(highestSignedProposalsBucket)
+	pubKeys := make([][48]byte, 0, len(seen))
+	for pubKey := range seen {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	return pubKeys
+}
+
+func checkAttestingHistory(tx *bolt.Tx, pubKey [48]byte) []*IntegrityIssue {
+	issues := make([]*IntegrityIssue, 0)
+	report := func(kind IntegrityIssueKind, format string, args ...interface{}) {
+		issues = append(issues, &IntegrityIssue{PubKey: pubKey, Kind: kind, Message: fmt.Sprintf(format, args...)})
+	}
+
+	// Pairs of source and target epochs, as recorded in the source epochs bucket
+	// and in the target epochs bucket.
+	bySource := make(map[sourceTarget]bool)
+	byTarget := make(map[sourceTarget]bool)
+	signingRoots := make(map[types.Epoch]bool)
+	if pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]); pkBucket != nil {
+		readEpochPairs(pkBucket.Bucket(attestationSourceEpochsBucket), func(k, v types.Epoch) {
+			bySource[sourceTarget{source: k, target: v}] = true
+		}, func(key []byte) {
+			report(MalformedRecord, "source epochs bucket has a malformed entry at key %#x", key)
+		})
+		readEpochPairs(pkBucket.Bucket(attestationTargetEpochsBucket), func(k, v types.Epoch) {
+			byTarget[sourceTarget{source: v, target: k}] = true
+		}, func(key []byte) {
+			report(MalformedRecord, "target epochs bucket has a malformed entry at key %#x", key)
+		})
+		if bkt := pkBucket.Bucket(attestationSigningRootsBucket); bkt != nil {
+			_ = bkt.ForEach(func(k, v []byte) error {
+				if len(k) != 8 || len(v) != 32 {
+					report(MalformedRecord, "signing roots bucket has a malformed entry at key %#x", k)
+					return nil
+				}
+				signingRoots[bytesutil.BytesToEpochBigEndian(k)] = true
+				return nil
+			})
+		}
+	}
+
+	all := make([]sourceTarget, 0, len(bySource)+len(byTarget))
+	var highestTarget types.Epoch
+	for att := range bySource {
+		all = append(all, att)
+	}
+	for att := range byTarget {
+		if !bySource[att] {
+			all = append(all, att)
+		}
+	}
+	for _, att := range all {
+		if att.target > highestTarget {
+			highestTarget = att.target
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].source == all[j].source {
+			return all[i].target < all[j].target
+		}
+		return all[i].source < all[j].source
+	})
+
+	// Attesting history older than the current weak subjectivity period may have been
+	// pruned from some buckets only, as pruning is not atomic across buckets.
+	prunable := func(target types.Epoch) bool {
+		return highestTarget >= params.BeaconConfig().WeakSubjectivityPeriod &&
+			olderThanCurrentWeakSubjectivityPeriod(target, highestTarget)
+	}
+	sourcesByTarget := make(map[types.Epoch]types.Epoch)
+	var maxTargetOfLowerSources types.Epoch
+	var hasLowerSources bool
+	for i, att := range all {
+		if att.source > att.target {
+			report(SourceAfterTarget, "attestation with source epoch %d after target epoch %d", att.source, att.target)
+		}
+		if source, ok := sourcesByTarget[att.target]; ok && source != att.source {
+			report(
+				RecordedDoubleVote, "attestations with target epoch %d have source epochs %d and %d",
+				att.target, source, att.source,
+			)
+		}
+		sourcesByTarget[att.target] = att.source
+		if hasLowerSources && att.target < maxTargetOfLowerSources {
+			report(
+				RecordedSurroundVote, "attestation with source epoch %d and target epoch %d is surrounded by "+
+					"an attestation with a lower source epoch and target epoch %d",
+				att.source, att.target, maxTargetOfLowerSources,
+			)
+		}
+		// Attestations with the same source do not surround each other, so the highest target
+		// is only updated once all attestations of a source epoch have been checked.
+		if i+1 == len(all) || all[i+1].source != att.source {
+			for j := i; j >= 0 && all[j].source == att.source; j-- {
+				if !hasLowerSources || all[j].target > maxTargetOfLowerSources {
+					maxTargetOfLowerSources = all[j].target
+					hasLowerSources = true
+				}
+			}
+		}
+		if prunable(att.target) {
+			continue
+		}
+		if !bySource[att] {
+			report(InconsistentBuckets, "attestation (%d, %d) is missing from the source epochs bucket", att.source, att.target)
+		}
+		if !byTarget[att] {
+			report(InconsistentBuckets, "attestation (%d, %d) is missing from the target epochs bucket", att.source, att.target)
+		}
+		if !signingRoots[att.target] {
+			report(InconsistentBuckets, "attestation (%d, %d) has no signing root", att.source, att.target)
+		}
+	}
+
+	if len(all) > 0 {
+		lowestSource, lowestTarget := all[0].source, all[0].target
+		for _, att := range all {
+			if att.target < lowestTarget {
+				lowestTarget = att.target
+			}
+		}
+		checkLowerBound(tx.Bucket(lowestSignedSourceBucket), pubKey, uint64(lowestSource), "source epoch", report)
+		checkLowerBound(tx.Bucket(lowestSignedTargetBucket), pubKey, uint64(lowestTarget), "target epoch", report)
+	}
+	return issues
+}
+
+func checkProposalHistory(tx *bolt.Tx, pubKey [48]byte) []*IntegrityIssue {
+	issues := make([]*IntegrityIssue, 0)
+	report := func(kind IntegrityIssueKind, format string, args ...interface{}) {
+		issues = append(issues, &IntegrityIssue{PubKey: pubKey, Kind: kind, Message: fmt.Sprintf(format, args...)})
+	}
+	valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey[:])
+	if valBucket == nil {
+		return issues
+	}
+	var lowest, highest types.Slot
+	var hasProposals bool
+	_ = valBucket.ForEach(func(k, _ []byte) error {
+		if len(k) != 8 {
+			report(MalformedRecord, "proposal history has a malformed entry at key %#x", k)
+			return nil
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if !hasProposals || slot < lowest {
+			lowest = slot
+		}
+		if !hasProposals || slot > highest {
+			highest = slot
+		}
+		hasProposals = true
+		return nil
+	})
+	if !hasProposals {
+		return issues
+	}
+	checkLowerBound(tx.Bucket(lowestSignedProposalsBucket), pubKey, uint64(lowest), "proposal slot", report)
+	highestBytes := tx.Bucket(highestSignedProposalsBucket).Get(pubKey[:])
+	if len(highestBytes) < 8 {
+		report(InconsistentHighestSigned, "no highest signed proposal slot for proposal history up to slot %d", highest)
+	} else if recorded := bytesutil.BytesToSlotBigEndian(highestBytes); recorded < highest {
+		report(
+			InconsistentHighestSigned, "highest signed proposal slot %d is below the proposal at slot %d",
+			recorded, highest,
+		)
+	}
+	return issues
+}
+
+// checkLowerBound verifies the lowest signed value of a public key is recorded and is not
+// above the lowest value of its history. It may be below, once the history has been pruned.
+func checkLowerBound(
+	bkt *bolt.Bucket,
+	pubKey [48]byte,
+	lowest uint64,
+	name string,
+	report func(IntegrityIssueKind, string, ...interface{}),
+) {
+	recordedBytes := bkt.Get(pubKey[:])
+	if len(recordedBytes) < 8 {
+		report(InconsistentLowestSigned, "no lowest signed %s for history from %d", name, lowest)
+		return
+	}
+	if recorded := bytesutil.BytesToUint64BigEndian(recordedBytes); recorded > lowest {
+		report(InconsistentLowestSigned, "lowest signed %s %d is above the history at %d", name, recorded, lowest)
+	}
+}
+
+// readEpochPairs decodes a bucket mapping an epoch to a list of epochs.
+func readEpochPairs(bkt *bolt.Bucket, fn func(k, v types.Epoch), malformed func(key []byte)) {
+	if bkt == nil {
+		return
+	}
+	_ = bkt.ForEach(func(k, v []byte) error {
+		if len(k) != 8 || len(v) == 0 || len(v)%8 != 0 {
+			malformed(k)
+			return nil
+		}
+		epoch := bytesutil.BytesToEpochBigEndian(k)
+		for i := 0; i < len(v); i += 8 {
+			fn(epoch, bytesutil.BytesToEpochBigEndian(v[i:i+8]))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,147 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func saveTestAttestations(t *testing.T, validatorDB *Store, pubKey [48]byte, atts ...sourceTarget) {
+	records := make([]*AttestationRecord, len(atts))
+	for i, att := range atts {
+		records[i] = &AttestationRecord{
+			PubKey:      pubKey,
+			Source:      att.source,
+			Target:      att.target,
+			SigningRoot: [32]byte{byte(att.source), byte(att.target)},
+		}
+	}
+	require.NoError(t, validatorDB.saveAttestationRecords(context.Background(), records))
+}
+
+func TestStore_CheckIntegrity_OK(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	saveTestAttestations(t, validatorDB, pubKey, sourceTarget{0, 1}, sourceTarget{1, 2}, sourceTarget{1, 3})
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 20, []byte{2}))
+
+	report, err := validatorDB.CheckIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.PublicKeysChecked)
+	assert.Equal(t, 0, len(report.Issues))
+	assert.Equal(t, 0, len(report.SuspiciousPublicKeys()))
+}
+
+func TestStore_CheckIntegrity_PrunableHistory(t *testing.T) {
+	pubKey := [48]byte{1}
+	wssPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	saveTestAttestations(t, validatorDB, pubKey, sourceTarget{0, 1}, sourceTarget{wssPeriod, wssPeriod + 1})
+	// Pruning may remove history older than the current weak subjectivity period from some buckets only.
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]).Bucket(attestationTargetEpochsBucket)
+		return bkt.Delete(bytesutil.EpochToBytesBigEndian(1))
+	}))
+
+	report, err := validatorDB.CheckIntegrity(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Issues))
+}
+
+func TestStore_CheckIntegrity_Issues(t *testing.T) {
+	tests := []struct {
+		name   string
+		atts   []sourceTarget
+		tamper func(tx *bolt.Tx, pubKey [48]byte) error
+		want   IntegrityIssueKind
+	}{
+		{
+			name: "double vote",
+			atts: []sourceTarget{{0, 2}, {1, 2}},
+			want: RecordedDoubleVote,
+		},
+		{
+			name: "surround vote",
+			atts: []sourceTarget{{1, 5}, {2, 3}},
+			want: RecordedSurroundVote,
+		},
+		{
+			name: "source after target",
+			atts: []sourceTarget{{3, 2}},
+			want: SourceAfterTarget,
+		},
+		{
+			name: "missing from target epochs bucket",
+			atts: []sourceTarget{{0, 1}, {1, 2}},
+			tamper: func(tx *bolt.Tx, pubKey [48]byte) error {
+				bkt := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]).Bucket(attestationTargetEpochsBucket)
+				return bkt.Delete(bytesutil.EpochToBytesBigEndian(2))
+			},
+			want: InconsistentBuckets,
+		},
+		{
+			name: "missing signing root",
+			atts: []sourceTarget{{0, 1}},
+			tamper: func(tx *bolt.Tx, pubKey [48]byte) error {
+				bkt := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]).Bucket(attestationSigningRootsBucket)
+				return bkt.Delete(bytesutil.EpochToBytesBigEndian(1))
+			},
+			want: InconsistentBuckets,
+		},
+		{
+			name: "malformed source epochs",
+			atts: []sourceTarget{{0, 1}},
+			tamper: func(tx *bolt.Tx, pubKey [48]byte) error {
+				bkt := tx.Bucket(pubKeysBucket).Bucket(pubKey[:]).Bucket(attestationSourceEpochsBucket)
+				return bkt.Put(bytesutil.EpochToBytesBigEndian(0), []byte{1, 2, 3})
+			},
+			want: MalformedRecord,
+		},
+		{
+			name: "lowest signed target above history",
+			atts: []sourceTarget{{0, 1}, {1, 2}},
+			tamper: func(tx *bolt.Tx, pubKey [48]byte) error {
+				return tx.Bucket(lowestSignedTargetBucket).Put(pubKey[:], bytesutil.EpochToBytesBigEndian(2))
+			},
+			want: InconsistentLowestSigned,
+		},
+		{
+			name: "highest signed proposal below history",
+			tamper: func(tx *bolt.Tx, pubKey [48]byte) error {
+				return tx.Bucket(highestSignedProposalsBucket).Put(pubKey[:], bytesutil.SlotToBytesBigEndian(5))
+			},
+			want: InconsistentHighestSigned,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [48]byte{1}
+			validatorDB := setupDB(t, [][48]byte{pubKey, {2}})
+			saveTestAttestations(t, validatorDB, pubKey, tt.atts...)
+			saveTestAttestations(t, validatorDB, [48]byte{2}, sourceTarget{0, 1}, sourceTarget{1, 2})
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, types.Slot(10), []byte{1}))
+			if tt.tamper != nil {
+				require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+					return tt.tamper(tx, pubKey)
+				}))
+			}
+
+			report, err := validatorDB.CheckIntegrity(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.PublicKeysChecked)
+			// A malformed record also makes the attestations it held missing from its bucket.
+			require.NotEqual(t, 0, len(report.Issues))
+			assert.Equal(t, tt.want, report.Issues[0].Kind)
+			assert.DeepEqual(t, [][48]byte{pubKey}, report.SuspiciousPublicKeys())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// checkDB verifies the invariants of the slashing protection history in the database,
+// as it is on disk, and logs the issues of every suspicious public key.
+func checkDB(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	validatorDB, err := openForMaintenance(ctx, cliCtx.String(cmd.DataDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer closeDB(validatorDB)
+
+	log.Info("Checking slashing protection history")
+	report, err := validatorDB.CheckIntegrity(ctx)
+	if err != nil {
+		return err
+	}
+	issuesByPubKey := make(map[[48]byte][]*kv.IntegrityIssue)
+	for _, issue := range report.Issues {
+		issuesByPubKey[issue.PubKey] = append(issuesByPubKey[issue.PubKey], issue)
+	}
+	suspicious := report.SuspiciousPublicKeys()
+	for _, pubKey := range suspicious {
+		for _, issue := range issuesByPubKey[pubKey] {
+			log.WithFields(logrus.Fields{
+				"publicKey": fmt.Sprintf("%#x", pubKey),
+				"issue":     issue.Kind,
+			}).Warn(issue.Message)
+		}
+	}
+	if len(suspicious) > 0 {
+		return fmt.Errorf(
+			"found %d issues in the slashing protection history of %d out of %d public keys",
+			len(report.Issues), len(suspicious), report.PublicKeysChecked,
+		)
+	}
+	log.WithField("publicKeys", report.PublicKeysChecked).Info("Slashing protection history passed all integrity checks")
+	return nil
+}
+
+// compactDB rewrites the database file without its free pages.
+func compactDB(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !fileutil.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return errors.New("No validator db found at path, nothing to compact")
+	}
+	log.Info("Compacting DB")
+	before, after, err := kv.Compact(context.Background(), dataDir)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": before,
+		"sizeAfter":  after,
+	}).Info("Compacted DB")
+	return nil
+}
+
+// pruneDB prunes attesting history older than the current weak subjectivity period,
+// like the validator client does when opening the database.
+func pruneDB(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	validatorDB, err := openForMaintenance(ctx, cliCtx.String(cmd.DataDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer closeDB(validatorDB)
+	if cliCtx.Bool(flags.DryRunFlag.Name) {
+		return dryRun(ctx, validatorDB, "pruning", (*kv.Store).PruneAttestationsOlderThanCurrentWeakSubjectivity)
+	}
+	log.Info("Pruning attesting history")
+	return validatorDB.PruneAttestationsOlderThanCurrentWeakSubjectivity(ctx)
+}
+
+// openForMaintenance opens the database without pruning it, to work on it as it is on disk.
+func openForMaintenance(ctx context.Context, dataDir string) (*kv.Store, error) {
+	if !fileutil.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil, errors.New("No validator db found at path")
+	}
+	log.Info("Opening DB")
+	return kv.NewKVStore(ctx, dataDir, &kv.Config{SkipPruning: true})
+}
+
+// dryRun runs an operation on a copy of the database and logs the changes it would make.
+func dryRun(ctx context.Context, validatorDB *kv.Store, name string, op func(*kv.Store, context.Context) error) error {
+	log.Infof("Dry running %s", name)
+	report, err := validatorDB.DryRun(ctx, func(ctx context.Context, s *kv.Store) error {
+		return op(s, ctx)
+	})
+	if err != nil {
+		return err
+	}
+	if report.Empty() {
+		log.Infof("Running %s would not change the database", name)
+		return nil
+	}
+	for _, c := range report.Changes {
+		bucket := c.Bucket
+		if bucket == "" {
+			bucket = "/"
+		}
+		log.WithFields(logrus.Fields{
+			"added":      c.Added,
+			"removed":    c.Removed,
+			"modified":   c.Modified,
+			"publicKeys": c.PublicKeys,
+		}).Infof("Running %s would change bucket %s", name, bucket)
+	}
+	return nil
+}
+
+func closeDB(validatorDB *kv.Store) {
+	if err := validatorDB.Close(); err != nil {
+		log.WithError(err).Error("Could not close database")
+	}
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func maintenanceCliCtx(t *testing.T, dbPath string, dryRun bool) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.Bool(flags.DryRunFlag.Name, dryRun, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	return cli.NewContext(&app, set, nil)
+}
+
+// setupSlashableDB writes a database recording a surround vote of a public key.
+func setupSlashableDB(t *testing.T) string {
+	validatorDB := dbtest.SetupDB(t, nil)
+	atts := []*ethpb.IndexedAttestation{
+		{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 1}, Target: &ethpb.Checkpoint{Epoch: 5}}},
+		{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 2}, Target: &ethpb.Checkpoint{Epoch: 3}}},
+	}
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(context.Background(), [48]byte{1}, [][32]byte{{1}, {2}}, atts))
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	return dbPath
+}
+
+func Test_checkDB_OK(t *testing.T) {
+	validatorDB := dbtest.SetupDB(t, nil)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	assert.NoError(t, checkDB(maintenanceCliCtx(t, dbPath, false)))
+}
+
+func Test_checkDB_SuspiciousHistory(t *testing.T) {
+	hook := logTest.NewGlobal()
+	err := checkDB(maintenanceCliCtx(t, setupSlashableDB(t), false))
+	assert.ErrorContains(t, "found 1 issues in the slashing protection history of 1 out of 1 public keys", err)
+	assert.LogsContain(t, hook, string(kv.RecordedSurroundVote))
+}
+
+func Test_compactDB_NoDBFound(t *testing.T) {
+	err := compactDB(maintenanceCliCtx(t, t.TempDir(), false))
+	assert.ErrorContains(t, "No validator db found at path", err)
+}
+
+func Test_compactDB_OK(t *testing.T) {
+	dbPath := setupSlashableDB(t)
+	require.NoError(t, compactDB(maintenanceCliCtx(t, dbPath, false)))
+	// The history is still there once compacted.
+	assert.ErrorContains(t, "found 1 issues", checkDB(maintenanceCliCtx(t, dbPath, false)))
+}
+
+func Test_migrateUp_DryRun(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validatorDB := dbtest.SetupDB(t, nil)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	require.NoError(t, migrateUp(maintenanceCliCtx(t, dbPath, true)))
+	assert.LogsContain(t, hook, "Running up migrations would change bucket migrations")
+
+	// Nothing was migrated, so a real run still marks the migrations as completed.
+	hook.Reset()
+	require.NoError(t, migrateUp(maintenanceCliCtx(t, dbPath, false)))
+	require.NoError(t, migrateUp(maintenanceCliCtx(t, dbPath, true)))
+	assert.LogsContain(t, hook, "Running up migrations would not change the database")
+}
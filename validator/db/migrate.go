@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/urfave/cli/v2"
 )
 
@@ -20,10 +21,16 @@ func migrateUp(cliCtx *cli.Context) error {
 
 	ctx := context.Background()
 	log.Info("Opening DB")
-	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{
+		SkipPruning: cliCtx.Bool(flags.DryRunFlag.Name),
+	})
 	if err != nil {
 		return err
 	}
+	defer closeDB(validatorDB)
+	if cliCtx.Bool(flags.DryRunFlag.Name) {
+		return dryRun(ctx, validatorDB, "up migrations", (*kv.Store).RunUpMigrations)
+	}
 	log.Info("Running migrations")
 	return validatorDB.RunUpMigrations(ctx)
 }
@@ -37,10 +44,16 @@ func migrateDown(cliCtx *cli.Context) error {
 
 	ctx := context.Background()
 	log.Info("Opening DB")
-	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{
+		SkipPruning: cliCtx.Bool(flags.DryRunFlag.Name),
+	})
 	if err != nil {
 		return err
 	}
+	defer closeDB(validatorDB)
+	if cliCtx.Bool(flags.DryRunFlag.Name) {
+		return dryRun(ctx, validatorDB, "down migrations", (*kv.Store).RunDownMigrations)
+	}
 	log.Info("Running migrations")
 	return validatorDB.RunDownMigrations(ctx)
 }
@@ -29,7 +29,7 @@ var (
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint. Several comma-separated endpoints may be given, requests are then routed to the healthiest one",
 		Value: "127.0.0.1:4000",
 	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
@@ -166,6 +166,23 @@ var (
 		Usage: "Display raw eth1 tx deposit data for validator accounts",
 		Value: false,
 	}
+	// DepositStartIndexFlag defines the first derived account index to generate deposit data for.
+	DepositStartIndexFlag = &cli.IntFlag{
+		Name:  "deposit-start-index",
+		Usage: "Index of the first HD wallet account to generate deposit data for, used with --num-accounts",
+		Value: 0,
+	}
+	// DepositAmountFlag defines the amount of each deposit in Gwei.
+	DepositAmountFlag = &cli.Uint64Flag{
+		Name:  "deposit-amount-gwei",
+		Usage: "Amount in Gwei of each generated deposit. Defaults to the maximum effective balance of a validator",
+	}
+	// DepositOutputDirFlag defines the directory deposit data bundles are written to.
+	DepositOutputDirFlag = &cli.StringFlag{
+		Name:  "deposit-output-dir",
+		Usage: "Path to a directory where the deposit_data.json bundle and the withdrawal credentials report are written to",
+		Value: filepath.Join(DefaultValidatorDir(), "deposits"),
+	}
 	// ShowPrivateKeysFlag for accounts.
 	ShowPrivateKeysFlag = &cli.BoolFlag{
 		Name:  "show-private-keys",
@@ -220,6 +237,27 @@ var (
 		Name:  "exit-all",
 		Usage: "Exit all validators. This will still require the staker to confirm a prompt for the action",
 	}
+	// DryRunFlag shows what a validator database migration or pruning would change, without modifying it.
+	DryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Reports the changes a validator database migration or pruning would make, without making them",
+	}
+	// ExitEpochFlag defines the epoch from which voluntary exits signed offline are valid.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name:  "exit-epoch",
+		Usage: "Epoch from which voluntary exits signed offline become valid and can be broadcast",
+	}
+	// ExitSigningConfigFlag defines the path to the network information used to sign voluntary exits offline.
+	ExitSigningConfigFlag = &cli.StringFlag{
+		Name: "exit-signing-config",
+		Usage: "Path to a JSON file with the fork, the genesis validators root of the target network and the " +
+			"indices of the validators, used to sign voluntary exits without a beacon node",
+	}
+	// PresignedExitsFileFlag defines the path to the file of voluntary exits signed offline.
+	PresignedExitsFileFlag = &cli.StringFlag{
+		Name:  "presigned-exits-file",
+		Usage: "Path to the JSON file of voluntary exits signed offline, written when signing and read when broadcasting",
+	}
 	// BackupPasswordFile for encrypting accounts a user wishes to back up.
 	BackupPasswordFile = &cli.StringFlag{
 		Name:  "backup-password-file",
@@ -302,8 +340,9 @@ var (
 	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
-		Name:  "graffiti-file",
-		Usage: "The path to a YAML file with graffiti values",
+		Name: "graffiti-file",
+		Usage: "The path to a YAML file with graffiti values, which may be templates such as \"{{.Index}} on {{.Eth1Client}}\". " +
+			"The file is reloaded when it changes",
 	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
@@ -311,6 +350,56 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// BeaconRPCBroadcastFlag publishes signed objects to all healthy beacon nodes.
+	BeaconRPCBroadcastFlag = &cli.BoolFlag{
+		Name: "beacon-rpc-broadcast",
+		Usage: "Publishes signed blocks, attestations and aggregates to every healthy beacon node " +
+			"listed in --beacon-rpc-provider simultaneously, instead of only the healthiest one",
+		Value: false,
+	}
+	// EnableDoppelgangerProtectionFlag delays the first duty until the chain has been checked for
+	// other instances signing with the same keys.
+	EnableDoppelgangerProtectionFlag = &cli.BoolFlag{
+		Name: "enable-doppelganger-protection",
+		Usage: "Watches the chain for attestations and proposals from the validating keys before " +
+			"performing any duty, and refuses to sign with any key found live on the network",
+		Value: false,
+	}
+	// DoppelgangerEpochsFlag sets the number of epochs watched by doppelganger protection.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-protection-epochs",
+		Usage: "Number of epochs to watch the chain for when doppelganger protection is enabled. " +
+			"Each epoch is checked near the end of the following one, so the first duty is delayed by up to this many epochs plus two",
+		Value: 2,
+	}
+	// WalletBackupIntervalFlag enables scheduled, encrypted backups of the wallet and slashing protection history.
+	WalletBackupIntervalFlag = &cli.DurationFlag{
+		Name: "wallet-backup-interval",
+		Usage: "Interval at which an encrypted archive of the keystores, the wallet configuration and the " +
+			"slashing protection history is written to --wallet-backup-dir, e.g. 6h. Disabled if not set",
+	}
+	// WalletBackupDirFlag defines the directory where scheduled wallet backups are written to.
+	WalletBackupDirFlag = &cli.StringFlag{
+		Name:  "wallet-backup-dir",
+		Usage: "Path to a directory where scheduled wallet backups are written to",
+		Value: filepath.Join(DefaultValidatorDir(), "backups"),
+	}
+	// WalletBackupRetentionFlag defines the number of scheduled wallet backups which are kept.
+	WalletBackupRetentionFlag = &cli.IntFlag{
+		Name:  "wallet-backup-retention",
+		Usage: "Number of most recent wallet backups kept in --wallet-backup-dir, older ones are deleted",
+		Value: 7,
+	}
+	// WalletBackupPasswordFileFlag for encrypting and decrypting wallet backups.
+	WalletBackupPasswordFileFlag = &cli.StringFlag{
+		Name:  "wallet-backup-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password wallet backups are encrypted with",
+	}
+	// WalletBackupFileFlag defines the path of a wallet backup to restore.
+	WalletBackupFileFlag = &cli.StringFlag{
+		Name:  "wallet-backup-file",
+		Usage: "Path to a wallet backup file to restore",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -1,19 +1,27 @@
 package graffiti
 
 import (
+	"encoding/hex"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"gopkg.in/yaml.v2"
 )
 
 type Graffiti struct {
-	Hash     [32]byte
+	Hash     [32]byte                        `yaml:"-"`
 	Default  string                          `yaml:"default,omitempty"`
 	Ordered  []string                        `yaml:"ordered,omitempty"`
 	Random   []string                        `yaml:"random,omitempty"`
 	Specific map[types.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// PublicKeys maps hex encoded validator public keys to their graffiti. Unlike validator
+	// indices, public keys are known before the validators are activated.
+	PublicKeys map[string]string `yaml:"public_keys,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -26,6 +34,82 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	if err := yaml.Unmarshal(yamlFile, g); err != nil {
 		return nil, err
 	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
 	g.Hash = hashutil.Hash(yamlFile)
 	return g, nil
 }
+
+// Validate checks all the graffiti templates and public keys of the graffiti struct.
+func (g *Graffiti) Validate() error {
+	check := func(field, graffiti string) error {
+		if err := ValidateTemplate(graffiti); err != nil {
+			return errors.Wrapf(err, "invalid %s graffiti", field)
+		}
+		return nil
+	}
+	if err := check("default", g.Default); err != nil {
+		return err
+	}
+	for i, o := range g.Ordered {
+		if err := check(fmt.Sprintf("ordered[%d]", i), o); err != nil {
+			return err
+		}
+	}
+	for i, r := range g.Random {
+		if err := check(fmt.Sprintf("random[%d]", i), r); err != nil {
+			return err
+		}
+	}
+	for idx, s := range g.Specific {
+		if err := check(fmt.Sprintf("specific[%d]", idx), s); err != nil {
+			return err
+		}
+	}
+	for key, s := range g.PublicKeys {
+		if _, err := decodePublicKey(key); err != nil {
+			return err
+		}
+		if err := check(fmt.Sprintf("public_keys[%s]", key), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OrderedHash returns the hash of the ordered graffiti list. The position of the validator
+// in the list is stored along with it, so the list only starts over when it changes, not
+// when other entries of the file do.
+func (g *Graffiti) OrderedHash() [32]byte {
+	var enc []byte
+	for _, o := range g.Ordered {
+		enc = append(enc, bytesutil.Bytes8(uint64(len(o)))...)
+		enc = append(enc, o...)
+	}
+	return hashutil.Hash(enc)
+}
+
+// ForPublicKey returns the graffiti configured for the given validator public key.
+func (g *Graffiti) ForPublicKey(pubKey [48]byte) (string, bool) {
+	for key, s := range g.PublicKeys {
+		k, err := decodePublicKey(key)
+		if err == nil && k == pubKey {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+func decodePublicKey(key string) ([48]byte, error) {
+	var pubKey [48]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return pubKey, errors.Wrapf(err, "invalid public key %s", key)
+	}
+	if len(b) != len(pubKey) {
+		return pubKey, fmt.Errorf("public key %s has wrong length", key)
+	}
+	copy(pubKey[:], b)
+	return pubKey, nil
+}
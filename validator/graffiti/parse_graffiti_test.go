@@ -157,3 +157,60 @@ specific:
 	}
 	require.DeepEqual(t, wanted, got)
 }
+
+func TestParseGraffitiFile_PublicKeys(t *testing.T) {
+	input := []byte(`default: "Mr T was here"
+public_keys:
+  "0x8b7acf9c1a85e62d04f3b89c11f5b5d03d5c6e11efbbdc2d9d4e4fbc4c4d10ac98e2b6d1d6a4c5fa4e9ba4e5e4b3c4a2": "{{.Index}} was here"`)
+
+	dirName := t.TempDir() + "somedir"
+	err := os.MkdirAll(dirName, os.ModePerm)
+	require.NoError(t, err)
+	someFileName := filepath.Join(dirName, "somefile.txt")
+	require.NoError(t, ioutil.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	var pubKey [48]byte
+	copy(pubKey[:], []byte{0x8b, 0x7a, 0xcf, 0x9c})
+	_, ok := got.ForPublicKey(pubKey)
+	require.Equal(t, false, ok)
+	key, err := decodePublicKey("0x8b7acf9c1a85e62d04f3b89c11f5b5d03d5c6e11efbbdc2d9d4e4fbc4c4d10ac98e2b6d1d6a4c5fa4e9ba4e5e4b3c4a2")
+	require.NoError(t, err)
+	g, ok := got.ForPublicKey(key)
+	require.Equal(t, true, ok)
+	require.Equal(t, "{{.Index}} was here", g)
+}
+
+func TestParseGraffitiFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "unknown template field",
+			input: `default: "{{.Unknown}}"`,
+			err:   "invalid default graffiti",
+		},
+		{
+			name:  "malformed template",
+			input: "ordered:\n  - \"{{.Index\"",
+			err:   "invalid ordered[0] graffiti",
+		},
+		{
+			name:  "short public key",
+			input: "public_keys:\n  \"0x8b7a\": \"a\"",
+			err:   "public key 0x8b7a has wrong length",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+			require.NoError(t, ioutil.WriteFile(someFileName, []byte(tt.input), os.ModePerm))
+			_, err := ParseGraffitiFile(someFileName)
+			require.ErrorContains(t, tt.err, err)
+		})
+	}
+}
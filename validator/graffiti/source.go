@@ -0,0 +1,170 @@
+package graffiti
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+// reloadDebounceInterval is how long file system events are debounced before the graffiti
+// file is reloaded, as editors usually fire several events when saving a file.
+const reloadDebounceInterval = 500 * time.Millisecond
+
+// Source provides the graffiti configuration of the validator client. It is safe for
+// concurrent use, reloads the graffiti file when it changes on disk and allows setting the
+// graffiti of a public key at runtime, which is persisted to the graffiti file if any.
+type Source struct {
+	path     string
+	lock     sync.RWMutex
+	graffiti *Graffiti
+}
+
+// NewSource loads the graffiti file at the given path. An empty path gives an empty
+// graffiti configuration which only lives in memory. When the file cannot be parsed, the
+// returned source starts with an empty configuration along with the error, and still picks
+// up the file once it is fixed.
+func NewSource(path string) (*Source, error) {
+	if path == "" {
+		return NewStaticSource(&Graffiti{}), nil
+	}
+	g, err := ParseGraffitiFile(path)
+	if err != nil {
+		return &Source{path: path, graffiti: &Graffiti{}}, err
+	}
+	return &Source{path: path, graffiti: g}, nil
+}
+
+// NewStaticSource returns a source for the given graffiti configuration, not backed by a file.
+func NewStaticSource(g *Graffiti) *Source {
+	return &Source{graffiti: g}
+}
+
+// Graffiti returns the current graffiti configuration, which must not be modified.
+func (s *Source) Graffiti() *Graffiti {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.graffiti
+}
+
+// SetPublicKeyGraffiti sets the graffiti template of a validator public key. An empty
+// graffiti removes the entry of the public key.
+func (s *Source) SetPublicKeyGraffiti(pubKey [48]byte, graffiti string) error {
+	if err := ValidateTemplate(graffiti); err != nil {
+		return errors.Wrap(err, "invalid graffiti")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	g := s.graffiti.copy()
+	for key := range g.PublicKeys {
+		if k, err := decodePublicKey(key); err == nil && k == pubKey {
+			delete(g.PublicKeys, key)
+		}
+	}
+	if graffiti != "" {
+		if g.PublicKeys == nil {
+			g.PublicKeys = make(map[string]string)
+		}
+		g.PublicKeys[fmt.Sprintf("%#x", pubKey)] = graffiti
+	}
+	if s.path != "" {
+		enc, err := yaml.Marshal(g)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(s.path, enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+			return errors.Wrap(err, "could not write graffiti file")
+		}
+		g.Hash = hashutil.Hash(enc)
+	}
+	s.graffiti = g
+	return nil
+}
+
+// Watch reloads the graffiti file whenever it changes, until the context is canceled.
+// Invalid files are reported in the logs and the previous configuration is kept.
+func (s *Source) Watch(ctx context.Context) {
+	if s.path == "" {
+		return
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize graffiti file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close graffiti file watcher")
+		}
+	}()
+	// Watch the directory rather than the file, as editors often replace files on save.
+	if err := watcher.Add(filepath.Dir(s.path)); err != nil {
+		log.WithError(err).Errorf("Could not watch graffiti file %s", s.path)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := make(chan interface{}, 100)
+	go asyncutil.Debounce(ctx, reloadDebounceInterval, changes, func(interface{}) {
+		s.reload()
+	})
+	for {
+		select {
+		case ev := <-watcher.Events:
+			if filepath.Clean(ev.Name) == filepath.Clean(s.path) {
+				changes <- ev
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch graffiti file %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Source) reload() {
+	g, err := ParseGraffitiFile(s.path)
+	if err != nil {
+		log.WithError(err).Error("Could not reload graffiti file, keeping the previous graffiti")
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if g.Hash == s.graffiti.Hash {
+		return
+	}
+	s.graffiti = g
+	log.WithField("path", s.path).Info("Reloaded graffiti file")
+}
+
+func (g *Graffiti) copy() *Graffiti {
+	c := &Graffiti{
+		Hash:    g.Hash,
+		Default: g.Default,
+		Ordered: append([]string(nil), g.Ordered...),
+		Random:  append([]string(nil), g.Random...),
+	}
+	if g.Specific != nil {
+		c.Specific = make(map[types.ValidatorIndex]string, len(g.Specific))
+		for k, v := range g.Specific {
+			c.Specific[k] = v
+		}
+	}
+	if g.PublicKeys != nil {
+		c.PublicKeys = make(map[string]string, len(g.PublicKeys))
+		for k, v := range g.PublicKeys {
+			c.PublicKeys[k] = v
+		}
+	}
+	return c
+}
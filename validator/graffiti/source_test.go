@@ -0,0 +1,90 @@
+package graffiti
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSource_SetPublicKeyGraffiti(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "Mr T was here"`), os.ModePerm))
+	s, err := NewSource(path)
+	require.NoError(t, err)
+	oldHash := s.Graffiti().Hash
+
+	pubKey := [48]byte{1, 2, 3}
+	require.NoError(t, s.SetPublicKeyGraffiti(pubKey, "{{.Index}} was here"))
+	g, ok := s.Graffiti().ForPublicKey(pubKey)
+	require.Equal(t, true, ok)
+	assert.Equal(t, "{{.Index}} was here", g)
+	assert.NotEqual(t, oldHash, s.Graffiti().Hash)
+
+	// The graffiti is persisted to the file, along with the rest of the configuration.
+	parsed, err := ParseGraffitiFile(path)
+	require.NoError(t, err)
+	require.DeepEqual(t, s.Graffiti(), parsed)
+	assert.Equal(t, "Mr T was here", parsed.Default)
+
+	require.NoError(t, s.SetPublicKeyGraffiti(pubKey, ""))
+	_, ok = s.Graffiti().ForPublicKey(pubKey)
+	assert.Equal(t, false, ok)
+
+	require.ErrorContains(t, "invalid graffiti", s.SetPublicKeyGraffiti(pubKey, "{{.Unknown}}"))
+}
+
+func TestSource_SetPublicKeyGraffiti_InMemory(t *testing.T) {
+	s, err := NewSource("")
+	require.NoError(t, err)
+	pubKey := [48]byte{1, 2, 3}
+	require.NoError(t, s.SetPublicKeyGraffiti(pubKey, "a"))
+	g, ok := s.Graffiti().ForPublicKey(pubKey)
+	require.Equal(t, true, ok)
+	assert.Equal(t, "a", g)
+}
+
+func TestSource_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "a"`), os.ModePerm))
+	s, err := NewSource(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	// Invalid files are ignored.
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "{{.Unknown}}"`), os.ModePerm))
+	time.Sleep(2 * reloadDebounceInterval)
+	assert.Equal(t, "a", s.Graffiti().Default)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "b"`), os.ModePerm))
+	require.NoError(t, waitFor(func() bool { return s.Graffiti().Default == "b" }))
+}
+
+func TestNewSource_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "{{.Unknown}}"`), os.ModePerm))
+	s, err := NewSource(path)
+	require.ErrorContains(t, "invalid default graffiti", err)
+	require.NotNil(t, s)
+	assert.Equal(t, "", s.Graffiti().Default)
+}
+
+func waitFor(cond func() bool) error {
+	for i := 0; i < 50; i++ {
+		if cond() {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return context.DeadlineExceeded
+}
@@ -0,0 +1,67 @@
+package graffiti
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// graffitiLength is the size of the graffiti field of a block.
+const graffitiLength = 32
+
+// TemplateData is the data available to graffiti templates, which use the text/template
+// syntax, such as "{{.Index}} on {{.Eth1Client}}".
+type TemplateData struct {
+	// Index of the proposing validator.
+	Index types.ValidatorIndex
+	// PublicKey of the proposing validator, as a short hex string.
+	PublicKey string
+	// Slot and Epoch of the proposal.
+	Slot  types.Slot
+	Epoch types.Epoch
+	// Version of the validator client.
+	Version string
+	// NodeID is a short identifier of the beacon node building the block.
+	NodeID string
+	// Eth1Client is the name of the Eth1 client of the beacon node, when known.
+	Eth1Client string
+}
+
+// IsTemplate returns true if the graffiti contains template actions.
+func IsTemplate(graffiti string) bool {
+	return strings.Contains(graffiti, "{{")
+}
+
+// ValidateTemplate checks that the graffiti is a valid template which only uses the fields
+// of TemplateData.
+func ValidateTemplate(graffiti string) error {
+	_, err := Render(graffiti, &TemplateData{})
+	return err
+}
+
+// Render executes the graffiti template with the given data. The result is truncated to
+// the size of the graffiti field of a block. Graffiti without template actions are
+// returned as is.
+func Render(graffiti string, data *TemplateData) ([]byte, error) {
+	if !IsTemplate(graffiti) {
+		return truncate([]byte(graffiti)), nil
+	}
+	tmpl, err := template.New("graffiti").Option("missingkey=error").Parse(graffiti)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return truncate(buf.Bytes()), nil
+}
+
+func truncate(b []byte) []byte {
+	if len(b) > graffitiLength {
+		return b[:graffitiLength]
+	}
+	return b
+}
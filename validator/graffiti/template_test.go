@@ -0,0 +1,45 @@
+package graffiti
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRender(t *testing.T) {
+	data := &TemplateData{
+		Index:      12,
+		PublicKey:  "8b7acf9c1a85",
+		Slot:       40,
+		Epoch:      1,
+		Version:    "v1.3.0",
+		NodeID:     "AbCdEfGh",
+		Eth1Client: "Geth",
+	}
+	tests := []struct {
+		graffiti string
+		want     string
+	}{
+		{graffiti: "Mr T was here", want: "Mr T was here"},
+		{graffiti: "{{.Index}} on {{.Eth1Client}}", want: "12 on Geth"},
+		{graffiti: "{{.PublicKey}}@{{.Slot}}/{{.Epoch}}", want: "8b7acf9c1a85@40/1"},
+		{graffiti: "prysm {{.Version}} {{.NodeID}}", want: "prysm v1.3.0 AbCdEfGh"},
+		{graffiti: "{{if .Eth1Client}}{{.Eth1Client}}{{else}}unknown{{end}}", want: "Geth"},
+		{graffiti: strings.Repeat("a", 40), want: strings.Repeat("a", 32)},
+		{graffiti: strings.Repeat("{{.Index}}", 20), want: strings.Repeat("12", 16)},
+	}
+	for _, tt := range tests {
+		got, err := Render(tt.graffiti, data)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, string(got))
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	require.NoError(t, ValidateTemplate(""))
+	require.NoError(t, ValidateTemplate("{{.Index}} on {{.Eth1Client}}"))
+	require.ErrorContains(t, "can't evaluate field Unknown", ValidateTemplate("{{.Unknown}}"))
+	require.ErrorContains(t, "unclosed action", ValidateTemplate("{{.Index"))
+}
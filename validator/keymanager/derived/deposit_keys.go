@@ -0,0 +1,62 @@
+package derived
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	util "github.com/wealdtech/go-eth2-util"
+)
+
+// AccountKeys are the validating and withdrawal keys of an account of an HD wallet,
+// along with their EIP-2334 derivation paths.
+type AccountKeys struct {
+	Index             int
+	ValidatingKeyPath string
+	ValidatingKey     bls.SecretKey
+	WithdrawalKeyPath string
+	WithdrawalKey     bls.SecretKey
+}
+
+// AccountKeysFromMnemonic derives the validating and withdrawal keys of the accounts
+// with indices in [startIndex, startIndex+numAccounts) from a mnemonic, without
+// needing a wallet.
+func AccountKeysFromMnemonic(mnemonic, mnemonicPassphrase string, startIndex, numAccounts int) ([]*AccountKeys, error) {
+	if startIndex < 0 || numAccounts <= 0 {
+		return nil, fmt.Errorf("invalid account range starting at %d with %d accounts", startIndex, numAccounts)
+	}
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	accounts := make([]*AccountKeys, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		index := startIndex + i
+		validatingKeyPath := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, index)
+		validatingKey, err := secretKeyFromSeedAndPath(seed, validatingKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		withdrawalKeyPath := fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, index)
+		withdrawalKey, err := secretKeyFromSeedAndPath(seed, withdrawalKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = &AccountKeys{
+			Index:             index,
+			ValidatingKeyPath: validatingKeyPath,
+			ValidatingKey:     validatingKey,
+			WithdrawalKeyPath: withdrawalKeyPath,
+			WithdrawalKey:     withdrawalKey,
+		}
+	}
+	return accounts, nil
+}
+
+func secretKeyFromSeedAndPath(seed []byte, path string) (bls.SecretKey, error) {
+	key, err := util.PrivateKeyFromSeedAndPath(seed, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not derive key at path %s", path)
+	}
+	return bls.SecretKeyFromBytes(key.Marshal())
+}
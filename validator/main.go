@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/backup"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
@@ -72,10 +73,18 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDutyCountDown,
+	flags.BeaconRPCBroadcastFlag,
+	flags.EnableDoppelgangerProtectionFlag,
+	flags.DoppelgangerEpochsFlag,
+	flags.WalletBackupIntervalFlag,
+	flags.WalletBackupDirFlag,
+	flags.WalletBackupRetentionFlag,
+	flags.WalletBackupPasswordFileFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
+	cmd.E2EClockSkewFlag,
 	cmd.VerbosityFlag,
 	cmd.DataDirFlag,
 	cmd.ClearDB,
@@ -116,6 +125,7 @@ func main() {
 		accounts.AccountCommands,
 		slashingprotection.Commands,
 		db.DatabaseCommands,
+		backup.Commands,
 	}
 
 	app.Flags = appFlags
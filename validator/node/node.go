@@ -12,8 +12,10 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -24,10 +26,12 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/backup"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/flags"
@@ -250,6 +254,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(keyManager); err != nil {
 		return err
 	}
+	if cliCtx.IsSet(flags.WalletBackupIntervalFlag.Name) {
+		if err := c.registerWalletBackupService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
 		if err := c.registerRPCService(cliCtx, keyManager); err != nil {
 			return err
@@ -339,6 +348,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(keyManager); err != nil {
 		return err
 	}
+	if cliCtx.IsSet(flags.WalletBackupIntervalFlag.Name) {
+		if err := c.registerWalletBackupService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if err := c.registerRPCService(cliCtx, keyManager); err != nil {
 		return err
 	}
@@ -392,16 +406,24 @@ func (c *ValidatorClient) registerValidatorService(
 		protector = sp
 	}
 
-	gStruct := &g.Graffiti{}
-	var err error
-	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
-		n := c.cliCtx.String(flags.GraffitiFileFlag.Name)
-		gStruct, err = g.ParseGraffitiFile(n)
-		if err != nil {
-			log.WithError(err).Warn("Could not parse graffiti file")
+	var doppelgangerEpochs types.Epoch
+	if c.cliCtx.Bool(flags.EnableDoppelgangerProtectionFlag.Name) {
+		doppelgangerEpochs = types.Epoch(c.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name))
+	}
+
+	var clock func() time.Time
+	if skew := c.cliCtx.Duration(cmd.E2EClockSkewFlag.Name); skew != 0 {
+		log.Warnf("Shifting clock by %s for end-to-end testing", skew)
+		clock = func() time.Time {
+			return time.Now().Add(skew)
 		}
 	}
 
+	gSource, err := g.NewSource(c.cliCtx.String(flags.GraffitiFileFlag.Name))
+	if err != nil {
+		log.WithError(err).Warn("Could not parse graffiti file")
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -418,8 +440,11 @@ func (c *ValidatorClient) registerValidatorService(
 		ValDB:                      c.db,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      c.walletInitialized,
-		GraffitiStruct:             gStruct,
+		GraffitiSource:             gSource,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		DoppelgangerEpochs:         doppelgangerEpochs,
+		Broadcast:                  c.cliCtx.Bool(flags.BeaconRPCBroadcastFlag.Name),
+		Clock:                      clock,
 	})
 
 	if err != nil {
@@ -427,6 +452,38 @@ func (c *ValidatorClient) registerValidatorService(
 	}
 	return c.services.RegisterService(v)
 }
+
+func (c *ValidatorClient) registerWalletBackupService(cliCtx *cli.Context) error {
+	if c.wallet == nil {
+		log.Warn("No wallet is opened, scheduled wallet backups are disabled")
+		return nil
+	}
+	if !cliCtx.IsSet(flags.WalletBackupPasswordFileFlag.Name) {
+		return fmt.Errorf("--%s is required for scheduled wallet backups", flags.WalletBackupPasswordFileFlag.Name)
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.WalletBackupPasswordFileFlag,
+		"", "", false,
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not read wallet backup password")
+	}
+	s, err := backup.NewService(c.ctx, &backup.Config{
+		Wallet:      c.wallet,
+		ValidatorDB: c.db,
+		OutputDir:   cliCtx.String(flags.WalletBackupDirFlag.Name),
+		Interval:    cliCtx.Duration(flags.WalletBackupIntervalFlag.Name),
+		Retention:   cliCtx.Int(flags.WalletBackupRetentionFlag.Name),
+		Password:    password,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize wallet backup service")
+	}
+	return c.services.RegisterService(s)
+}
+
 func (c *ValidatorClient) registerSlasherService() error {
 	endpoint := c.cliCtx.String(flags.SlasherRPCProviderFlag.Name)
 	if endpoint == "" {
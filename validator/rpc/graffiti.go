@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGraffiti returns the graffiti set for a validator public key, empty if none.
+func (s *Server) GetGraffiti(_ context.Context, req *pb.GraffitiRequest) (*pb.GraffitiResponse, error) {
+	if len(req.PublicKey) != 48 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid public key length")
+	}
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not yet initialized")
+	}
+	g, _ := s.validatorService.GraffitiSource().Graffiti().ForPublicKey(bytesutil.ToBytes48(req.PublicKey))
+	return &pb.GraffitiResponse{
+		PublicKey: req.PublicKey,
+		Graffiti:  g,
+	}, nil
+}
+
+// SetGraffiti sets the graffiti or graffiti template of a validator public key, which
+// takes priority over the rest of the graffiti file for its block proposals, but not over
+// the graffiti from the command line. The graffiti is persisted to the graffiti file when
+// the validator client uses one.
+func (s *Server) SetGraffiti(_ context.Context, req *pb.SetGraffitiRequest) (*ptypes.Empty, error) {
+	if len(req.PublicKey) != 48 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid public key length")
+	}
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not yet initialized")
+	}
+	if err := graffiti.ValidateTemplate(req.Graffiti); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid graffiti: %v", err)
+	}
+	source := s.validatorService.GraffitiSource()
+	if err := source.SetPublicKeyGraffiti(bytesutil.ToBytes48(req.PublicKey), req.Graffiti); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set graffiti: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+)
+
+func TestServer_SetGraffiti(t *testing.T) {
+	ctx := context.Background()
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		GraffitiSource: graffiti.NewStaticSource(&graffiti.Graffiti{}),
+	})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+	pubKey := make([]byte, 48)
+	pubKey[0] = 1
+
+	resp, err := s.GetGraffiti(ctx, &pb.GraffitiRequest{PublicKey: pubKey})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.Graffiti)
+
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: pubKey, Graffiti: "{{.Index}} on {{.Eth1Client}}"})
+	require.NoError(t, err)
+	resp, err = s.GetGraffiti(ctx, &pb.GraffitiRequest{PublicKey: pubKey})
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKey, resp.PublicKey)
+	assert.Equal(t, "{{.Index}} on {{.Eth1Client}}", resp.Graffiti)
+
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: pubKey, Graffiti: "{{.Unknown}}"})
+	assert.ErrorContains(t, "Invalid graffiti", err)
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: pubKey[:4], Graffiti: "a"})
+	assert.ErrorContains(t, "Invalid public key length", err)
+}
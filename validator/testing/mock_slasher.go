@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
@@ -86,3 +87,23 @@ func (ms MockSlasher) IsSlashableBlockNoUpdate(_ context.Context, _ *eth.BeaconB
 		Slashable: ms.SlashBlock,
 	}, nil
 }
+
+// ProposerSlashings will return an empty array of proposer slashings.
+func (ms MockSlasher) ProposerSlashings(_ context.Context, _ *slashpb.SlashingsRequest, _ ...grpc.CallOption) (*slashpb.ProposerSlashingResponse, error) {
+	return &slashpb.ProposerSlashingResponse{}, nil
+}
+
+// AttesterSlashings will return an empty array of attester slashings.
+func (ms MockSlasher) AttesterSlashings(_ context.Context, _ *slashpb.SlashingsRequest, _ ...grpc.CallOption) (*slashpb.AttesterSlashingResponse, error) {
+	return &slashpb.AttesterSlashingResponse{}, nil
+}
+
+// StreamProposerSlashings is not implemented by the mock.
+func (ms MockSlasher) StreamProposerSlashings(_ context.Context, _ *types.Empty, _ ...grpc.CallOption) (slashpb.Slasher_StreamProposerSlashingsClient, error) {
+	return nil, errors.New("not implemented")
+}
+
+// StreamAttesterSlashings is not implemented by the mock.
+func (ms MockSlasher) StreamAttesterSlashings(_ context.Context, _ *types.Empty, _ ...grpc.CallOption) (slashpb.Slasher_StreamAttesterSlashingsClient, error) {
+	return nil, errors.New("not implemented")
+}
@@ -46,6 +46,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.MinimalConfigFlag,
 			cmd.E2EConfigFlag,
+			cmd.E2EClockSkewFlag,
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
 			cmd.ClearDB,
@@ -106,6 +107,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
 			flags.EnableDutyCountDown,
+			flags.BeaconRPCBroadcastFlag,
+			flags.EnableDoppelgangerProtectionFlag,
+			flags.DoppelgangerEpochsFlag,
+			flags.WalletBackupIntervalFlag,
+			flags.WalletBackupDirFlag,
+			flags.WalletBackupRetentionFlag,
+			flags.WalletBackupPasswordFileFlag,
 		},
 	},
 	{